@@ -0,0 +1,8 @@
+data "squadcast_alert_sources" "example" {
+  service_id = squadcast_service.example.id
+  team_id    = "team id"
+}
+
+output "prometheus_webhook_url" {
+  value = data.squadcast_alert_sources.example.endpoints["prometheus"]
+}
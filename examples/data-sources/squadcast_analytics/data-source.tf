@@ -0,0 +1,13 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_analytics" "last_quarter" {
+  team_id = data.squadcast_team.example_team.id
+  from    = "2023-04-01T00:00:00Z"
+  till    = "2023-07-01T00:00:00Z"
+}
+
+output "mttr_minutes_by_service" {
+  value = { for s in data.squadcast_analytics.last_quarter.services : s.service_name => s.mttr / 60 }
+}
@@ -0,0 +1,15 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_default_object" "escalation_policy" {
+  type    = "escalation_policy"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_service" "example_service" {
+  name                 = "example service name"
+  team_id              = data.squadcast_team.example_team.id
+  escalation_policy_id = data.squadcast_default_object.escalation_policy.id
+  email_prefix         = "example-service-email"
+}
@@ -0,0 +1,7 @@
+data "squadcast_escalation_policies" "legacy" {
+  team_id              = "team id"
+  description_contains = "legacy"
+  tags = {
+    owner = "sre"
+  }
+}
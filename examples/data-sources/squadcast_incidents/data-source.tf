@@ -0,0 +1,10 @@
+data "squadcast_incidents" "synthetic" {
+  team_id     = "team id"
+  service_ids = ["service id"]
+  statuses    = ["triggered", "acknowledged"]
+  start_time  = "2023-07-05T00:00:00Z"
+
+  tags = {
+    synthetic = "true"
+  }
+}
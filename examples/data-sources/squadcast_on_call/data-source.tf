@@ -0,0 +1,28 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_on_call" "primary" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+  next_shifts = 3
+}
+
+data "squadcast_escalation_policy" "example_escalation_policy" {
+  name    = "example escalation policy name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_on_call" "escalation_policy" {
+  escalation_policy_id = data.squadcast_escalation_policy.example_escalation_policy.id
+  team_id              = data.squadcast_team.example_team.id
+}
+
+# e.g. to be used as the topic of the on-call Slack channel
+output "on_call_topic" {
+  value = "On-call: ${join(", ", [for p in data.squadcast_on_call.primary.on_call : p.id])}"
+}
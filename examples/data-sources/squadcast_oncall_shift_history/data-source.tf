@@ -0,0 +1,21 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_oncall_shift_history" "last_month" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+  from        = "2023-06-01T00:00:00Z"
+  till        = "2023-07-01T00:00:00Z"
+}
+
+# Hours on-call of every participant, e.g. for the on-call compensation
+output "oncall_hours" {
+  value = {
+    for p in data.squadcast_oncall_shift_history.last_month.participants : "${p.participant_type}/${p.participant_id}" => p.total_minutes / 60
+  }
+}
@@ -0,0 +1,9 @@
+data "squadcast_public_webform_submission_schema" "support" {
+  name    = "webformName"
+  team_id = "team id"
+}
+
+resource "local_file" "support_portal_schema" {
+  filename = "${path.module}/portal/webform.schema.json"
+  content  = data.squadcast_public_webform_submission_schema.support.schema_json
+}
@@ -0,0 +1,17 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_rotation_participants" "primary" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+}
+
+# e.g. to grant the on-call users access to production
+output "on_call_emails" {
+  value = data.squadcast_rotation_participants.primary.emails
+}
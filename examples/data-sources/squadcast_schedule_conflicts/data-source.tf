@@ -0,0 +1,27 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_schedule_v2" "platform_primary" {
+  name    = "platform primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_schedule_conflicts" "example" {
+  schedule_ids = [data.squadcast_schedule_v2.primary.id, data.squadcast_schedule_v2.platform_primary.id]
+  from         = "2032-06-01T00:00:00Z"
+  till         = "2032-07-01T00:00:00Z"
+}
+
+# Fail the plan when someone is on-call on both schedules at the same time
+check "no_double_booking" {
+  assert {
+    condition     = length(data.squadcast_schedule_conflicts.example.conflicts) == 0
+    error_message = "Users are double-booked: ${jsonencode(data.squadcast_schedule_conflicts.example.conflicts)}"
+  }
+}
@@ -0,0 +1,12 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_schedule_export" "primary" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+}
@@ -0,0 +1,22 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_schedule_forecast" "example" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+  from        = "2032-06-01T00:00:00Z"
+  till        = "2032-07-01T00:00:00Z"
+}
+
+# Fail the plan when a change of the rotations leaves the schedule uncovered
+check "no_gaps" {
+  assert {
+    condition     = length(data.squadcast_schedule_forecast.example.gaps) == 0
+    error_message = "No one is on-call during: ${jsonencode(data.squadcast_schedule_forecast.example.gaps)}"
+  }
+}
@@ -0,0 +1,23 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2_migration" "example" {
+  team_id             = data.squadcast_team.example_team.id
+  legacy_schedule_ids = [squadcast_schedule.primary.id]
+}
+
+# The escalation policy now targets the v2 schedule migrated from the legacy one
+resource "squadcast_escalation_policy" "example" {
+  name    = "example escalation policy"
+  team_id = data.squadcast_team.example_team.id
+
+  rules {
+    delay_minutes = 0
+
+    targets {
+      id   = data.squadcast_schedule_v2_migration.example.schedule_ids[squadcast_schedule.primary.id]
+      type = "schedulev2"
+    }
+  }
+}
@@ -4,3 +4,44 @@ provider "squadcast" {
   refresh_token = "YOUR-SQUADCAST-TOKEN"
   region        = "us"
 }
+
+# Alternatively, read the refresh token from a mounted secret (token_file) or from the output of a command (token_command)
+# so that it never goes through Terraform variables
+provider "squadcast" {
+  alias         = "vault"
+  token_command = "vault kv get -field=refresh_token secret/squadcast"
+  region        = "us"
+}
+
+# In CI pipelines, authenticate with OAuth client credentials (or a service_account_token) instead of a personal refresh token
+# client_id and client_secret can also be passed via environment variables (SQUADCAST_CLIENT_ID and SQUADCAST_CLIENT_SECRET)
+provider "squadcast" {
+  alias         = "ci"
+  client_id     = "YOUR-CLIENT-ID"
+  client_secret = "YOUR-CLIENT-SECRET"
+  region        = "us"
+}
+
+# Use provider aliases to manage organizations hosted in different regions
+provider "squadcast" {
+  alias         = "eu"
+  refresh_token = "YOUR-EU-SQUADCAST-TOKEN"
+  region        = "eu"
+}
+
+data "squadcast_team" "eu_team" {
+  provider = squadcast.eu
+  name     = "example team name"
+}
+
+# Behind a corporate egress proxy or an on-prem gateway, configure the network settings of the provider
+provider "squadcast" {
+  alias          = "proxied"
+  refresh_token  = "YOUR-SQUADCAST-TOKEN"
+  region         = "us"
+  proxy_url      = "http://proxy.example.com:3128"
+  ca_bundle_file = "/etc/ssl/certs/corporate-ca.pem"
+  headers = {
+    "X-Gateway-Key" = "YOUR-GATEWAY-KEY"
+  }
+}
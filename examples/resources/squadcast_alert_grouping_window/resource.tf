@@ -0,0 +1,22 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_alert_grouping_window" "prometheus" {
+  service_id   = data.squadcast_service.example_service.id
+  alert_source = "prometheus"
+  time_window  = 5
+  time_unit    = "minute"
+}
+
+resource "squadcast_alert_grouping_window" "cloudwatch" {
+  service_id   = data.squadcast_service.example_service.id
+  alert_source = "amazon-cloudwatch"
+  time_window  = 1
+  time_unit    = "hour"
+}
@@ -0,0 +1,51 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+resource "squadcast_alert_rules" "example_alert_rules" {
+  team_id    = data.squadcast_team.example_team.id
+  service_id = data.squadcast_service.example_service.id
+
+  # 1. tagging
+  tagging_rules {
+    is_basic   = false
+    expression = "payload[\"event_id\"] == 40"
+
+    tags {
+      key   = "severity"
+      value = "critical"
+      color = "#ff0000"
+    }
+  }
+
+  # 2. deduplication
+  deduplication_rules {
+    is_basic    = false
+    description = "dedup event 40"
+    expression  = "payload[\"event_id\"] == 40"
+  }
+
+  # 3. suppression
+  suppression_rules {
+    is_basic    = false
+    description = "suppress low priority"
+    expression  = "payload[\"priority\"] == \"low\""
+  }
+
+  # 4. routing
+  routing_rules {
+    is_basic      = false
+    expression    = "tags[\"severity\"] == \"critical\""
+    route_to_id   = data.squadcast_user.example_user.id
+    route_to_type = "user"
+  }
+}
@@ -0,0 +1,18 @@
+# Rotate the token of the webhook consumers every 30 days
+resource "time_rotating" "webhook_consumers" {
+  rotation_days = 30
+}
+
+resource "squadcast_api_token" "webhook_consumers" {
+  name            = "webhook consumers"
+  scope           = "read-only"
+  expires_in_days = 45
+
+  keepers = {
+    rotation = time_rotating.webhook_consumers.id
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,15 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_deduplication_ml_settings" "example_deduplication_ml_settings" {
+  service_id           = data.squadcast_service.example_service.id
+  similarity_threshold = 0.9
+  time_window          = 30
+  time_unit            = "minute"
+}
@@ -1,3 +1,3 @@
-# teamID:escalationPolicyID
+# teamID:escalationPolicyName
 # Use 'Get All Teams' and 'Get All Escalation Policies' APIs to get the id of the team and escalation policy name respectively
 terraform import squadcast_escalation_policy.test "62d2fe23a57381088224d726:Example Escalation Policy"
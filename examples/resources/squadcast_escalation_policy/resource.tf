@@ -31,8 +31,9 @@ resource "squadcast_escalation_policy" "example_escalaion_policy" {
     }
 
     targets {
-      id   = data.squadcast_schedule_v2.example_schedule_v2.id
-      type = "schedulev2"
+      id          = data.squadcast_schedule_v2.example_schedule_v2.id
+      type        = "schedulev2"
+      rotation_id = "1234"
     }
   }
 
@@ -40,8 +41,9 @@ resource "squadcast_escalation_policy" "example_escalaion_policy" {
     delay_minutes = 5
 
     targets {
-      id   = data.squadcast_user.example_user.id
-      type = "user"
+      id                    = data.squadcast_user.example_user.id
+      type                  = "user"
+      notification_channels = ["Email", "Push"]
     }
 
     targets {
@@ -89,4 +91,8 @@ resource "squadcast_escalation_policy" "example_escalaion_policy" {
     id  = data.squadcast_user.example_user.id
     type = "user"
   }
+
+  tags = {
+    last_reviewed = "2026-03"
+  }
 }
@@ -0,0 +1,2 @@
+# escalationPolicyID:roundRobinGroupID
+terraform import squadcast_escalation_policy_round_robin_group.test "62d2fe23a57381088224d726:62da76c088f407f9ca756ca5"
@@ -0,0 +1,35 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_escalation_policy" "example_escalation_policy" {
+  name    = "example escalation policy name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+data "squadcast_squad" "example_squad" {
+  name    = "example squad name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_escalation_policy_round_robin_group" "example_round_robin_group" {
+  escalation_policy_id = data.squadcast_escalation_policy.example_escalation_policy.id
+  rule_index           = 0
+
+  members {
+    id   = data.squadcast_user.example_user.id
+    type = "user"
+  }
+
+  members {
+    id   = data.squadcast_squad.example_squad.id
+    type = "squad"
+  }
+
+  sticky_assignment         = true
+  stickiness_window_minutes = 60
+}
@@ -0,0 +1,3 @@
+# teamID:escalationPolicyTemplateName
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_escalation_policy_template.test "62d2fe23a57381088224d726:Standard escalation"
@@ -0,0 +1,45 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_escalation_policy_template" "example_ep_template" {
+  name        = "Standard escalation"
+  description = "Page the primary on-call, then the escalation squad of the team"
+  team_id     = data.squadcast_team.example_team.id
+
+  parameters {
+    name        = "primary_schedule"
+    type        = "schedulev2"
+    description = "Primary on-call schedule of the team"
+  }
+
+  parameters {
+    name = "escalation_squad"
+    type = "squad"
+  }
+
+  rules {
+    delay_minutes = 0
+
+    targets {
+      id   = "primary_schedule"
+      type = "parameter"
+    }
+  }
+
+  rules {
+    delay_minutes = 15
+
+    targets {
+      id   = "escalation_squad"
+      type = "parameter"
+    }
+
+    notification_channels = ["Phone", "Email"]
+  }
+
+  repeat {
+    times         = 2
+    delay_minutes = 10
+  }
+}
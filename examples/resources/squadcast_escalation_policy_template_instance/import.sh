@@ -0,0 +1,3 @@
+# teamID:escalationPolicyID
+# Use 'Get All Teams' and 'Get All Escalation Policies' APIs to get the id of the team and escalation policy respectively
+terraform import squadcast_escalation_policy_template_instance.example_ep "62d2fe23a57381088224d726:61305a8eb7a2fa0e44cfd0f5"
@@ -0,0 +1,25 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_squad" "example_squad" {
+  name    = "example squad name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_schedule_v2" "example_schedule" {
+  name    = "example schedule name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_escalation_policy_template_instance" "example_ep" {
+  template_id      = squadcast_escalation_policy_template.example_ep_template.id
+  template_version = squadcast_escalation_policy_template.example_ep_template.version
+  team_id          = data.squadcast_team.example_team.id
+  name             = "example team escalation"
+
+  parameters = {
+    primary_schedule = data.squadcast_schedule_v2.example_schedule.id
+    escalation_squad = data.squadcast_squad.example_squad.id
+  }
+}
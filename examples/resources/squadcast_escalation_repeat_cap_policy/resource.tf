@@ -0,0 +1,26 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_escalation_repeat_cap_policy" "example_escalation_repeat_cap_policy" {
+  team_id          = data.squadcast_team.example_team.id
+  max_repeats      = 2
+  max_rule_repeats = 1
+  timezone         = "Asia/Kolkata"
+
+  # no repeated pages overnight
+  quiet_hours_exceptions {
+    start_hour   = 22
+    start_minute = 0
+    duration     = 480
+    max_repeats  = 0
+  }
+
+  quiet_hours_exceptions {
+    start_hour   = 0
+    start_minute = 0
+    duration     = 1440
+    day_of_week  = "sunday"
+    max_repeats  = 0
+  }
+}
@@ -11,8 +11,9 @@ resource "squadcast_ger" "ger" {
   name = "Example GER"
   description = "Example GER Description"
   team_id =   data.squadcast_team.team.id
+  # The owner can be referenced by name instead of id, users by email.
   entity_owner {
-    id = data.squadcast_team.team.id
+    name = "Example Team"
     type = "team"
   }
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+# serviceID:heartbeatName
+terraform import squadcast_heartbeat.nightly_backup "61305a8eb7a2fa0e44cfd0f5:nightly-backup"
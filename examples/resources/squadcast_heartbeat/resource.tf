@@ -0,0 +1,22 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_heartbeat" "nightly_backup" {
+  service_id   = data.squadcast_service.example_service.id
+  name         = "nightly-backup"
+  description  = "Nightly database backup, runs at 02:00 UTC."
+  interval     = 1440
+  grace_period = 60
+}
+
+# The cron job pings the URL at the end of each run, e.g. with `backup.sh && curl -fsS "$HEARTBEAT_URL"`
+output "nightly_backup_heartbeat_url" {
+  value     = squadcast_heartbeat.nightly_backup.url
+  sensitive = true
+}
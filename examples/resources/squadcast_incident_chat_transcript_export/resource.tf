@@ -0,0 +1,11 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_incident_chat_transcript_export" "example_transcript_export" {
+  team_id         = data.squadcast_team.example_team.id
+  sources         = ["slack", "msteams"]
+  destination     = "s3"
+  destination_url = "s3://incident-transcripts/squadcast"
+  retention_days  = 365
+}
@@ -0,0 +1,13 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_incident_reopen_policy" "example_reopen_policy" {
+  service_id     = data.squadcast_service.example_service.id
+  window_minutes = 60
+}
@@ -0,0 +1,3 @@
+# teamID:incidentSummaryDistributionName
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_incident_summary_distribution.test 62d2fe23a57381088224d726:"example incident summary distribution"
@@ -0,0 +1,33 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_squad" "example_squad" {
+  name    = "example squad name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_incident_summary_distribution" "example_distribution" {
+  name        = "example incident summary distribution"
+  team_id     = data.squadcast_team.example_team.id
+  service_ids = [data.squadcast_service.example_service.id]
+
+  audience {
+    type = "squad"
+    id   = data.squadcast_squad.example_squad.id
+  }
+
+  audience {
+    type = "email"
+    id   = "stakeholders@example.com"
+  }
+
+  subject       = "Incident resolved"
+  template      = "The incident has been resolved, a postmortem will follow."
+  delay_minutes = 15
+}
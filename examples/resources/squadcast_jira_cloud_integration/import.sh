@@ -0,0 +1,3 @@
+# teamID
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_jira_cloud_integration.test 62d2fe23a57381088224d726
@@ -0,0 +1,27 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_jira_cloud_integration" "example_jira_cloud_integration" {
+  team_id = data.squadcast_team.example_team.id
+
+  # The default project of the team, used for the services that are not mapped.
+  project_mapping {
+    project_key = "OPS"
+  }
+
+  project_mapping {
+    service_id  = data.squadcast_service.example_service.id
+    project_key = "PAY"
+    issue_type  = "Bug"
+  }
+
+  auto_create_issue = true
+  sync_status       = true
+  sync_comments     = true
+}
@@ -0,0 +1,2 @@
+# maintenanceCalendarName
+terraform import squadcast_maintenance_calendar.test "Change freeze"
@@ -0,0 +1,20 @@
+resource "squadcast_maintenance_calendar" "example_maintenance_calendar" {
+  name        = "Change freeze"
+  description = "Year end change freeze and weekend maintenance"
+
+  window {
+    from = "2032-12-20T00:00:00Z"
+    till = "2033-01-02T00:00:00Z"
+  }
+
+  # Every weekend until the end of the summer
+  window {
+    from             = "2032-06-05T00:00:00Z"
+    till             = "2032-06-07T00:00:00Z"
+    repeat_frequency = "week"
+    repeat_till      = "2032-09-01T00:00:00Z"
+  }
+
+  # Critical incidents still notify during the windows
+  allowed_priorities = ["P1"]
+}
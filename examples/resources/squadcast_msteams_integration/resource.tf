@@ -0,0 +1,24 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_msteams_integration" "example_msteams_integration" {
+  team_id = data.squadcast_team.example_team.id
+
+  # The default channel of the team, used for the services that are not mapped.
+  channel_mapping {
+    msteams_team_id = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+    channel_id      = "19:0123456789abcdef@thread.tacv2"
+  }
+
+  channel_mapping {
+    service_id      = data.squadcast_service.example_service.id
+    msteams_team_id = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+    channel_id      = "19:fedcba9876543210@thread.tacv2"
+  }
+}
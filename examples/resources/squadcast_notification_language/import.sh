@@ -0,0 +1,4 @@
+# teamID, or organization for the default language of the organization
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_notification_language.test 62d2fe23a57381088224d726
+terraform import squadcast_notification_language.organization organization
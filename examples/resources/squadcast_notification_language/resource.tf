@@ -0,0 +1,22 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+# default language of the organization
+resource "squadcast_notification_language" "organization" {
+  language = "en"
+}
+
+resource "squadcast_notification_language" "example_team" {
+  team_id  = data.squadcast_team.example_team.id
+  language = "de"
+
+  user_overrides {
+    user_id  = data.squadcast_user.example_user.id
+    language = "en"
+  }
+}
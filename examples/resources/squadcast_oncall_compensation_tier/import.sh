@@ -0,0 +1,3 @@
+# teamID:compensationTierName
+# Use 'Get All Teams' API to get the id of the team
+terraform import squadcast_oncall_compensation_tier.test 62d2fe23a57381088224d726:"example weekend tier"
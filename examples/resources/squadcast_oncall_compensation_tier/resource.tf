@@ -0,0 +1,27 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "example_schedule" {
+  name    = "example schedule name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_oncall_compensation_tier" "weekend" {
+  name            = "example weekend tier"
+  team_id         = data.squadcast_team.example_team.id
+  type            = "weekend"
+  schedule_id     = data.squadcast_schedule_v2.example_schedule.id
+  rate_multiplier = 2
+}
+
+resource "squadcast_oncall_compensation_tier" "night" {
+  name            = "example night tier"
+  team_id         = data.squadcast_team.example_team.id
+  type            = "night"
+  schedule_id     = data.squadcast_schedule_v2.example_schedule.id
+  rotation_ids    = ["1234"]
+  start_time      = "20:00"
+  end_time        = "08:00"
+  rate_multiplier = 1.5
+}
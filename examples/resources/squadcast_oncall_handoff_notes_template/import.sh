@@ -0,0 +1,3 @@
+# scheduleID
+# Use 'Get All Schedules' API to get the id of the schedule
+terraform import squadcast_oncall_handoff_notes_template.test 12345
@@ -0,0 +1,22 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_oncall_handoff_notes_template" "primary" {
+  schedule_id             = data.squadcast_schedule_v2.primary.id
+  require_notes           = true
+  reminder_minutes_before = 60
+
+  content = <<-EOT
+    ## Open incidents
+
+    ## Ongoing maintenances
+
+    ## Follow-ups for the next shift
+  EOT
+}
@@ -0,0 +1,2 @@
+# teamID:postmortemTemplateName
+terraform import squadcast_postmortem_template.incident_review "62d2fe23a57381088224d726:Incident review"
@@ -0,0 +1,24 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_postmortem_template" "incident_review" {
+  team_id     = data.squadcast_team.example_team.id
+  name        = "Incident review"
+  description = "Blameless review of the incidents of the team."
+  is_default  = true
+
+  body = <<-EOT
+    # {{incident.title}}
+
+    ## Summary
+
+    {{incident.description}}
+
+    ## Timeline
+
+    ## Root cause
+
+    ## Action items
+  EOT
+}
@@ -0,0 +1,2 @@
+# teamID:responsePlayName
+terraform import squadcast_response_play.sev1 "62d2fe23a57381088224d726:Sev1"
@@ -0,0 +1,48 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_squad" "example_squad" {
+  name    = "example squad name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_runbook" "example_runbook" {
+  name    = "example runbook name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_response_play" "sev1" {
+  team_id     = data.squadcast_team.example_team.id
+  name        = "Sev1"
+  description = "Escalate to the incident commanders and open the war room."
+  priority    = "P1"
+
+  responders {
+    type = "squad"
+    id   = data.squadcast_squad.example_squad.id
+  }
+
+  responders {
+    type = "escalation_policy"
+    name = "Incident commanders"
+  }
+
+  runbook_ids = [data.squadcast_runbook.example_runbook.id]
+
+  communication_channels {
+    type = "video_conference"
+    name = "War room"
+    url  = "https://meet.example.com/sev1"
+  }
+
+  communication_channels {
+    type = "chat_room"
+    name = "#sev1"
+    url  = "https://example.slack.com/archives/C0123456789"
+  }
+
+  tags = {
+    severity = "sev1"
+  }
+}
@@ -0,0 +1,3 @@
+# teamID:serviceID:ruleID
+# Use 'Get All Teams' and 'Get All Services' APIs to get the id of the team and service respectively, and 'Get Routing Rules' to get the id of the rule
+terraform import squadcast_routing_rule_v2.test 62d2fe23a57381088224d726:62da76c088f407f9ca756ca5:6389e2e2cbfd3ea0f7bf6d1b
@@ -0,0 +1,28 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+data "squadcast_squad" "example_squad" {
+  name    = "example squad name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_routing_rule_v2" "example_routing_rule" {
+  team_id    = data.squadcast_team.example_team.id
+  service_id = data.squadcast_service.example_service.id
+
+  is_basic = true
+
+  basic_expressions {
+    lhs = "payload[\"foo\"]"
+    rhs = "bar"
+  }
+
+  route_to_id   = data.squadcast_squad.example_squad.id
+  route_to_type = "squad"
+}
@@ -1,3 +1,3 @@
-# teamID:scheduleName
+# teamID:scheduleSlug, or teamID:scheduleName
 # Use 'Get All Teams' API to get the id of the team
-terraform import squadcast_schedule.test "62d2fe23a57381088224d726:Example Schedule"
+terraform import squadcast_schedule.test "62d2fe23a57381088224d726:example-schedule"
@@ -0,0 +1,16 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_schedule_v2" "primary" {
+  name    = "primary on-call"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_schedule_export" "primary" {
+  schedule_id = data.squadcast_schedule_v2.primary.id
+}
+
+output "primary_calendar_url" {
+  value = squadcast_schedule_export.primary.webcal_url
+}
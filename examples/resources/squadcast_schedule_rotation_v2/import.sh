@@ -1,2 +1,3 @@
-# teamID:scheduleName:rotationName
+# teamID:scheduleID:rotationID, or teamID:scheduleName:rotationName
 terraform import squadcast_schedule_rotation_v2.rotation "62d2fe23a57381088224d726:Example Schedule:Example Rotation"
+terraform import squadcast_schedule_rotation_v2.rotation "62d2fe23a57381088224d726:1234:5678"
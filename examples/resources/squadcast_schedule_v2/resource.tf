@@ -20,5 +20,40 @@ resource "squadcast_schedule_v2" "schedule_test" {
   tags {
     key = "testkey2"
     value = "testval2"
+    color = "#0f61dd"
+  }
+}
+
+# Rotations can also be managed inline instead of with squadcast_schedule_rotation_v2
+resource "squadcast_schedule_v2" "schedule_with_rotations" {
+  name     = "test schedule with rotations"
+  timezone = "Asia/Kolkata"
+  team_id  = data.squadcast_team.example_team.id
+  entity_owner {
+    id   = data.squadcast_user.example_user.id
+    type = "user"
+  }
+  rotations {
+    name                          = "primary"
+    start_date                    = "2032-06-01T00:00:00Z"
+    period                        = "daily"
+    change_participants_frequency = 1
+    change_participants_unit      = "rotation"
+    shift_timeslots {
+      start_hour   = 10
+      start_minute = 30
+      duration     = 720
+    }
+    participant_groups {
+      participants {
+        id   = data.squadcast_user.example_user.id
+        type = "user"
+      }
+    }
+  }
+  # Fail the plan when no rotation is on-call in some periods of the week
+  coverage_check {
+    gaps     = "error"
+    overlaps = "off"
   }
 }
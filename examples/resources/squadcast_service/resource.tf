@@ -19,6 +19,9 @@ resource "squadcast_service" "example_service" {
     id   = data.squadcast_user.example_user.id
     type = "user"
   }
+  labels = {
+    cost_center = "payments"
+  }
   tags {
     key   = "testkey"
     value = "testval"
@@ -30,3 +33,40 @@ resource "squadcast_service" "example_service" {
   alert_sources = ["example-alert-source"]
   slack_channel_id = "D0KAQDEPSH"
 }
+
+resource "squadcast_service" "example_dependent_service" {
+  name                 = "example dependent service name"
+  team_id              = data.squadcast_team.example_team.id
+  escalation_policy_id = data.squadcast_escalation_policy.example_escalaion_policy.id
+  email_prefix         = "example-dependent-service-email"
+  dependencies         = [squadcast_service.example_service.id]
+
+  # Mute the notifications during a risky release, e.g. with -var release_in_progress=true
+  muted = var.release_in_progress
+
+  # Incidents triggered at night or on weekends wait for the next working day
+  delayed_notifications {
+    timezone = "Asia/Kolkata"
+
+    business_hours {
+      days       = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      start_time = "09:00"
+      end_time   = "18:00"
+    }
+  }
+
+  # Similar alerts join the open incident for 15 minutes rather than paging again
+  intelligent_alert_grouping {
+    time_window = 15
+  }
+
+  # Alerts auto resolved within 5 minutes never page anyone
+  auto_pause_transient_alerts {
+    timeout = 5
+  }
+}
+
+variable "release_in_progress" {
+  type    = bool
+  default = false
+}
@@ -0,0 +1,33 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_service_alert_source" "prometheus" {
+  team_id      = data.squadcast_team.example_team.id
+  service_id   = data.squadcast_service.example_service.id
+  alert_source = "Prometheus"
+}
+
+resource "squadcast_service_alert_source" "email" {
+  team_id      = data.squadcast_team.example_team.id
+  service_id   = data.squadcast_service.example_service.id
+  alert_source = "Email"
+  email_prefix = "example-service"
+
+  email_config {
+    parsing_mode = "json"
+    key_field_mapping {
+      incident_field = "priority"
+      key            = "alert.severity"
+    }
+  }
+}
+
+output "alert_email" {
+  value = squadcast_service_alert_source.email.endpoint
+}
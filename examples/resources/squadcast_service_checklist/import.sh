@@ -0,0 +1,3 @@
+# teamID:serviceID
+# Use 'Get All Teams' and 'Get All Services' APIs to get the id of the team and service respectively
+terraform import squadcast_service_checklist.test 62d2fe23a57381088224d726:62da76c088f407f9ca756ca5
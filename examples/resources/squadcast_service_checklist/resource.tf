@@ -0,0 +1,31 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_service_checklist" "example_service_checklist" {
+  service_id = data.squadcast_service.example_service.id
+
+  items {
+    type = "runbook_linked"
+  }
+
+  items {
+    type = "escalation_policy_set"
+  }
+
+  items {
+    type = "dashboard_attached"
+    link = "https://grafana.example.com/d/example-service"
+  }
+
+  items {
+    type      = "custom"
+    name      = "Load test signed off"
+    completed = true
+  }
+}
@@ -0,0 +1,10 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+resource "squadcast_slack_integration" "example_slack_integration" {
+  team_id                        = data.squadcast_team.example_team.id
+  default_channel_id             = "C0123456789"
+  auto_create_incident_channel   = true
+  incident_channel_name_template = "incident-{{incident.id}}"
+}
@@ -0,0 +1,2 @@
+# organizationID, there is a single SSO configuration per organization
+terraform import squadcast_sso_configuration.okta 604592dabc35ea0008bb0584
@@ -0,0 +1,23 @@
+resource "squadcast_sso_configuration" "okta" {
+  idp_metadata_xml = file("${path.module}/okta-metadata.xml")
+
+  attribute_mapping = {
+    email      = "email"
+    first_name = "firstName"
+    last_name  = "lastName"
+  }
+
+  # Users must log in with Okta, the account owner keeps their password login to recover from a misconfigured IdP
+  enforced                   = true
+  allow_owner_password_login = true
+  auto_provision_users       = true
+}
+
+# The service provider settings to set in the Okta SAML application
+output "squadcast_sso_acs_url" {
+  value = squadcast_sso_configuration.okta.sp_acs_url
+}
+
+output "squadcast_sso_entity_id" {
+  value = squadcast_sso_configuration.okta.sp_entity_id
+}
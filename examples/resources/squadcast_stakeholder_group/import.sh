@@ -0,0 +1,3 @@
+# teamID:stakeholderGroupID
+# Use 'Get All Teams' and 'Get All Stakeholder Groups' APIs to get the id of the team and stakeholder group respectively
+terraform import squadcast_stakeholder_group.example_stakeholder_group 62d2fe23a57381088224d726:62da76c088f407f9ca756ca5
@@ -0,0 +1,14 @@
+data "squadcast_team" "example_team" {
+  name = "example test name"
+}
+
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+resource "squadcast_stakeholder_group" "example_stakeholder_group" {
+  name        = "example stakeholder group name"
+  description = "Stakeholders of the checkout product"
+  team_id     = data.squadcast_team.example_team.id
+  member_ids  = [data.squadcast_user.example_user.id]
+}
@@ -0,0 +1,2 @@
+# statusPageID
+terraform import squadcast_status_page_subscriber_import.example_subscribers 1234
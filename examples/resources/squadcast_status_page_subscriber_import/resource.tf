@@ -0,0 +1,4 @@
+resource "squadcast_status_page_subscriber_import" "example_subscribers" {
+  status_page_id = "1234"
+  emails         = split("\n", trimspace(file("${path.module}/subscribers.txt")))
+}
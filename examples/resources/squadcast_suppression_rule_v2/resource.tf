@@ -0,0 +1,32 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_suppression_rule_v2" "example_suppression_rule" {
+  team_id    = data.squadcast_team.example_team.id
+  service_id = data.squadcast_service.example_service.id
+
+  is_basic    = false
+  description = "suppress the weekly maintenance alerts"
+  expression  = "payload[\"event_id\"] == 40"
+
+  timeslots {
+    time_zone  = "Asia/Calcutta"
+    start_time = "2032-06-05T02:00:00Z"
+    end_time   = "2032-06-05T04:00:00Z"
+    ends_on    = "2032-06-05T04:00:00Z"
+    repetition = "custom"
+    ends_never = true
+
+    custom {
+      repeats             = "week"
+      repeats_count       = 1
+      repeats_on_weekdays = [6]
+    }
+  }
+}
@@ -23,7 +23,8 @@ resource "squadcast_tagging_rules" "example_tagging_rules" {
   }
 
   rules {
-    is_basic = true
+    is_basic  = true
+    overwrite = true
 
     basic_expressions {
       lhs = "payload[\"foo\"]"
@@ -39,7 +40,7 @@ resource "squadcast_tagging_rules" "example_tagging_rules" {
 
     tags {
       key   = "MyTag2"
-      value = "bar"
+      value = "{{payload.foo}}"
       color = "#f0f0f0"
     }
   }
@@ -55,3 +56,26 @@ resource "squadcast_tagging_rules" "example_tagging_rules_resource_withouttags"
     expression = "addTag(\"EventType\", payload.details.event_type_key, \"#037916\")"
   }
 }
+
+# Validate the templated tag values against the payload fields of the active alert sources
+data "squadcast_alert_sources" "example_alert_sources" {
+  team_id    = data.squadcast_team.example_team.id
+  service_id = data.squadcast_service.example_service.id
+}
+
+resource "squadcast_tagging_rules" "example_tagging_rules_validated_templates" {
+  team_id        = data.squadcast_team.example_team.id
+  service_id     = data.squadcast_service.example_service.id
+  payload_fields = data.squadcast_alert_sources.example_alert_sources.payload_fields
+
+  rules {
+    is_basic   = false
+    expression = "payload[\"labels\"][\"severity\"] != \"\""
+
+    tags {
+      key   = "severity"
+      value = "{{payload.labels.severity}}"
+      color = "#ababab"
+    }
+  }
+}
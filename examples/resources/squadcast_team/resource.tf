@@ -1,3 +1,16 @@
 resource "squadcast_team" "example_team" {
   name = "example team name"
 }
+
+data "squadcast_user" "example_user" {
+  email = "example@squadcast.com"
+}
+
+resource "squadcast_team" "example_team_with_members" {
+  name = "example team with members"
+
+  members {
+    user_id = data.squadcast_user.example_user.id
+    roles   = ["admin"]
+  }
+}
@@ -0,0 +1,28 @@
+variable "team_members" {
+  type = map(list(string))
+  default = {
+    "alice@example.com" = ["admin"]
+    "bob@example.com"   = ["user"]
+  }
+}
+
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_user" "example_users" {
+  for_each = var.team_members
+  email    = each.key
+}
+
+resource "squadcast_team_members" "example_team_members" {
+  team_id = data.squadcast_team.example_team.id
+
+  dynamic "members" {
+    for_each = var.team_members
+    content {
+      user_id = data.squadcast_user.example_users[members.key].id
+      roles   = members.value
+    }
+  }
+}
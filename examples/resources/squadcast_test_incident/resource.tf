@@ -0,0 +1,23 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "squadcast_service" "example_service" {
+  name    = "example service name"
+  team_id = data.squadcast_team.example_team.id
+}
+
+resource "squadcast_test_incident" "escalation_check" {
+  team_id     = data.squadcast_team.example_team.id
+  service_id  = data.squadcast_service.example_service.id
+  message     = "Synthetic escalation check"
+  description = "Triggered after a change of the escalation policy, resolved on destroy."
+
+  tags = {
+    synthetic = "true"
+  }
+
+  triggers = {
+    escalation_policy_id = data.squadcast_service.example_service.escalation_policy_id
+  }
+}
@@ -0,0 +1,15 @@
+data "squadcast_user" "automation" {
+  email = "sre-automation@example.com"
+}
+
+resource "squadcast_user_api_token" "sre_automation" {
+  user_id            = data.squadcast_user.automation.id
+  name               = "sre automation"
+  scopes             = ["incidents:read", "incidents:write", "services:read"]
+  expires_in_days    = 90
+  rotate_before_days = 14
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
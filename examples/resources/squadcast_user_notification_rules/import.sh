@@ -0,0 +1,3 @@
+# userID
+# Use 'Get All Users' API to get the id of the user
+terraform import squadcast_user_notification_rules.example_user_notification_rules 62d2fe23a57381088224d726
@@ -0,0 +1,27 @@
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+resource "squadcast_user_notification_rules" "example_user_notification_rules" {
+  user_id = data.squadcast_user.example_user.id
+
+  high_priority {
+    channel = "Push"
+  }
+  high_priority {
+    channel       = "SMS"
+    delay_minutes = 5
+  }
+  high_priority {
+    channel       = "Phone"
+    delay_minutes = 10
+  }
+
+  low_priority {
+    channel = "Email"
+  }
+  low_priority {
+    channel       = "Push"
+    delay_minutes = 15
+  }
+}
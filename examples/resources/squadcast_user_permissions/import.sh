@@ -0,0 +1,3 @@
+# userID
+# Use 'Get All Users' API to get the id of the user
+terraform import squadcast_user_permissions.example_user_permissions 62d2fe23a57381088224d726
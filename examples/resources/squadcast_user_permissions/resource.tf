@@ -0,0 +1,8 @@
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+resource "squadcast_user_permissions" "example_user_permissions" {
+  user_id   = data.squadcast_user.example_user.id
+  abilities = ["manage-billing", "manage-api-tokens"]
+}
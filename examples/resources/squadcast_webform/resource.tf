@@ -26,9 +26,12 @@ resource "squadcast_webform" "example_webform" {
   services {
     service_id = data.squadcast_service.example_service.id
     alias      = "example service alias"
+    group      = "example group"
+    weight     = 10
   }
   services {
     service_id = data.squadcast_service.example_service_2.id
+    default    = true
   }
   custom_domain_name = "example.com"
   header             = "formHeader"
@@ -54,6 +57,10 @@ resource "squadcast_webform" "example_webform" {
     tagKey  = "tagValue"
     tagKey2 = "tagValue2"
   }
+  success_page {
+    message          = "Thanks for reaching out, we are looking into it."
+    show_incident_id = true
+  }
 }
 
 resource "squadcast_webform" "example_webform" {
@@ -85,4 +92,8 @@ resource "squadcast_webform" "example_webform" {
     tagKey  = "tagValue"
     tagKey2 = "tagValue2"
   }
+  success_page {
+    message          = "Thanks for reaching out, we are looking into it."
+    show_incident_id = true
+  }
 }
@@ -0,0 +1,30 @@
+data "squadcast_team" "example_team" {
+  name = "example team name"
+}
+
+data "aws_route53_zone" "example_zone" {
+  name = "example.com"
+}
+
+resource "squadcast_webform" "example_webform" {
+  # ...
+  custom_domain_name = "support.example.com"
+}
+
+resource "aws_route53_record" "example_webform_records" {
+  # The keys of for_each must be known during plan, the records are only known once the webform is created.
+  for_each = toset(["TXT", "CNAME"])
+
+  zone_id = data.aws_route53_zone.example_zone.zone_id
+  type    = each.key
+  name    = one([for record in squadcast_webform.example_webform.dns_records : record.name if record.type == each.key])
+  records = [one([for record in squadcast_webform.example_webform.dns_records : record.value if record.type == each.key])]
+  ttl     = 300
+}
+
+resource "squadcast_webform_domain_verification" "example_webform_domain" {
+  team_id    = data.squadcast_team.example_team.id
+  webform_id = squadcast_webform.example_webform.id
+
+  depends_on = [aws_route53_record.example_webform_records]
+}
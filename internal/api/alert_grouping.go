@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type AlertGroupingWindow struct {
+	ServiceID     string `json:"service_id" tf:"service_id"`
+	AlertSourceID string `json:"alert_source_id" tf:"-"`
+	Enabled       bool   `json:"enabled" tf:"enabled"`
+	TimeWindow    int    `json:"time_window" tf:"time_window"`
+	TimeUnit      string `json:"time_unit" tf:"time_unit"`
+}
+
+func (w *AlertGroupingWindow) Encode() (tf.M, error) {
+	return tf.Encode(w)
+}
+
+func (client *Client) GetAlertGroupingWindow(ctx context.Context, serviceID string, alertSourceID string) (*AlertGroupingWindow, error) {
+	url := fmt.Sprintf("%s/services/%s/alert-sources/%s/grouping-window", client.BaseURLV3, serviceID, alertSourceID)
+
+	return Request[any, AlertGroupingWindow](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateAlertGroupingWindowReq struct {
+	Enabled    bool   `json:"enabled"`
+	TimeWindow int    `json:"time_window"`
+	TimeUnit   string `json:"time_unit"`
+}
+
+func (client *Client) UpdateAlertGroupingWindow(ctx context.Context, serviceID string, alertSourceID string, req *UpdateAlertGroupingWindowReq) (*AlertGroupingWindow, error) {
+	url := fmt.Sprintf("%s/services/%s/alert-sources/%s/grouping-window", client.BaseURLV3, serviceID, alertSourceID)
+
+	return Request[UpdateAlertGroupingWindowReq, AlertGroupingWindow](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteAlertGroupingWindow removes the grouping window of the alert source, whose alerts are then grouped with the window of the service.
+func (client *Client) DeleteAlertGroupingWindow(ctx context.Context, serviceID string, alertSourceID string) (*any, error) {
+	url := fmt.Sprintf("%s/services/%s/alert-sources/%s/grouping-window", client.BaseURLV3, serviceID, alertSourceID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 type AlertSource struct {
@@ -104,3 +106,54 @@ func (client *Client) ListActiveAlertSources(ctx context.Context, serviceID stri
 	url := fmt.Sprintf("%s/catalog-services/%s/alert-sources", client.BaseURLV3, serviceID)
 	return Request[any, ActiveAlertSources](http.MethodGet, url, client, ctx, nil)
 }
+
+type AlertSourcePayloadSchema struct {
+	Fields []string `json:"fields"`
+}
+
+// GetAlertSourcePayloadSchema returns the payload fields (dot separated paths) sent by an alert source.
+func (client *Client) GetAlertSourcePayloadSchema(ctx context.Context, alertSourceID string) (*AlertSourcePayloadSchema, error) {
+	url := fmt.Sprintf("%s/public/integrations/%s/payload-schema", client.BaseURLV2, alertSourceID)
+	return Request[any, AlertSourcePayloadSchema](http.MethodGet, url, client, ctx, nil)
+}
+
+// EmailAlertSourceConfig is how the emails sent to the email alert source of a service are parsed into incidents.
+type EmailAlertSourceConfig struct {
+	ParsingMode     string                 `json:"parsing_mode" tf:"parsing_mode"`
+	KeyFieldMapping []EmailKeyFieldMapping `json:"key_field_mapping" tf:"-"`
+}
+
+// EmailKeyFieldMapping sets an incident field from a key of the email body, the label of a `Key: value` line in the
+// plain mode and a dot separated path in the json mode.
+type EmailKeyFieldMapping struct {
+	IncidentField string `json:"incident_field" tf:"incident_field"`
+	Key           string `json:"key" tf:"key"`
+}
+
+func (m EmailKeyFieldMapping) Encode() (tf.M, error) {
+	return tf.Encode(m)
+}
+
+func (c *EmailAlertSourceConfig) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m["key_field_mapping"], err = tf.EncodeSlice(c.KeyFieldMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (client *Client) GetEmailAlertSourceConfig(ctx context.Context, serviceID string) (*EmailAlertSourceConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/email-alert-source-config", client.BaseURLV3, serviceID)
+	return Request[any, EmailAlertSourceConfig](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) UpdateEmailAlertSourceConfig(ctx context.Context, serviceID string, req *EmailAlertSourceConfig) (*EmailAlertSourceConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/email-alert-source-config", client.BaseURLV3, serviceID)
+	return Request[EmailAlertSourceConfig, EmailAlertSourceConfig](http.MethodPut, url, client, ctx, req)
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// Analytics are the incident metrics of an entity over the last 30 days. Times are in seconds.
+type Analytics struct {
+	IncidentCount int `json:"incident_count" tf:"incident_count"`
+	MTTA          int `json:"mtta" tf:"mtta"`
+	MTTR          int `json:"mttr" tf:"mttr"`
+}
+
+func (a *Analytics) Encode() (tf.M, error) {
+	return tf.Encode(a)
+}
+
+func (client *Client) GetServiceAnalytics(ctx context.Context, teamID, serviceID string) (*Analytics, error) {
+	url := fmt.Sprintf("%s/services/%s/analytics?owner_id=%s", client.BaseURLV3, serviceID, teamID)
+
+	return Request[any, Analytics](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetWebformAnalytics(ctx context.Context, teamID, webformID string) (*Analytics, error) {
+	url := fmt.Sprintf("%s/webform/%s/analytics?owner_id=%s", client.BaseURLV3, webformID, teamID)
+
+	return Request[any, Analytics](http.MethodGet, url, client, ctx, nil)
+}
+
+// ServiceAnalytics are the incident metrics of a service over the period of IncidentAnalytics. Times are in seconds.
+type ServiceAnalytics struct {
+	ServiceID     string `json:"service_id" tf:"service_id"`
+	ServiceName   string `json:"service_name" tf:"service_name"`
+	IncidentCount int    `json:"incident_count" tf:"incident_count"`
+	MTTA          int    `json:"mtta" tf:"mtta"`
+	MTTR          int    `json:"mttr" tf:"mttr"`
+}
+
+func (a *ServiceAnalytics) Encode() (tf.M, error) {
+	return tf.Encode(a)
+}
+
+// IncidentAnalytics are the incident metrics of the organization or of a team over a period, in total and per service.
+type IncidentAnalytics struct {
+	Analytics
+	Services []*ServiceAnalytics `json:"services"`
+}
+
+func (a *IncidentAnalytics) Encode() (tf.M, error) {
+	m, err := a.Analytics.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := tf.EncodeSlice(a.Services)
+	if err != nil {
+		return nil, err
+	}
+	m["services"] = services
+
+	return m, nil
+}
+
+// GetIncidentAnalytics returns the incident metrics between from and till, in RFC3339 format, of the team, or of the
+// whole organization if teamID is empty. The metrics are limited to the given services if any.
+func (client *Client) GetIncidentAnalytics(ctx context.Context, teamID, from, till string, serviceIDs []string) (*IncidentAnalytics, error) {
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", till)
+	if teamID != "" {
+		query.Set("owner_id", teamID)
+	}
+	if len(serviceIDs) > 0 {
+		query.Set("service_ids", strings.Join(serviceIDs, ","))
+	}
+	url := fmt.Sprintf("%s/analytics/incidents?%s", client.BaseURLV3, query.Encode())
+
+	return Request[any, IncidentAnalytics](http.MethodGet, url, client, ctx, nil)
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// APIToken is a token of the organization, not acting on behalf of a user, e.g. for the webhook consumers of the
+// automation of a team.
+type APIToken struct {
+	ID        string `json:"id" tf:"id"`
+	Name      string `json:"name" tf:"name"`
+	Scope     string `json:"scope" tf:"scope"`
+	CreatedAt string `json:"created_at" tf:"created_at"`
+	ExpiresAt string `json:"expires_at" tf:"expires_at"`
+	// Token is only returned by the creation of the token.
+	Token string `json:"token,omitempty" tf:"-"`
+}
+
+func (t *APIToken) Encode() (tf.M, error) {
+	return tf.Encode(t)
+}
+
+type CreateAPITokenReq struct {
+	Name          string `json:"name"`
+	Scope         string `json:"scope"`
+	ExpiresInDays int    `json:"expires_in_days,omitempty"`
+}
+
+func (client *Client) CreateAPIToken(ctx context.Context, req *CreateAPITokenReq) (*APIToken, error) {
+	url := fmt.Sprintf("%s/api-tokens", client.BaseURLV3)
+
+	return Request[CreateAPITokenReq, APIToken](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) GetAPITokenByID(ctx context.Context, id string) (*APIToken, error) {
+	url := fmt.Sprintf("%s/api-tokens/%s", client.BaseURLV3, id)
+
+	return Request[any, APIToken](http.MethodGet, url, client, ctx, nil)
+}
+
+// DeleteAPIToken revokes the token.
+func (client *Client) DeleteAPIToken(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/api-tokens/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -1,13 +1,19 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
+// accessTokenRenewalMargin is how long before its expiry the access token is renewed.
+const accessTokenRenewalMargin = time.Minute
+
 type AccessToken struct {
 	Type         string `json:"type"`
 	AccessToken  string `json:"access_token"`
@@ -16,17 +22,44 @@ type AccessToken struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// GetAccessToken exchanges the refresh token or the OAuth client credentials of the client for an access token.
 func (client *Client) GetAccessToken(ctx context.Context) (*AccessToken, error) {
-	path := "/oauth/access-token"
+	if client.ClientID != "" {
+		return client.getClientCredentialsAccessToken(ctx)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.AuthBaseURL+path, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.AuthBaseURL+"/oauth/access-token", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("X-Refresh-Token", client.RefreshToken)
+
+	return client.doAccessTokenRequest(req)
+}
+
+func (client *Client) getClientCredentialsAccessToken(ctx context.Context) (*AccessToken, error) {
+	body, err := json.Marshal(map[string]string{
+		"grant_type":    "client_credentials",
+		"client_id":     client.ClientID,
+		"client_secret": client.ClientSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.AuthBaseURL+"/oauth/token", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json;charset=UTF-8")
+
+	return client.doAccessTokenRequest(req)
+}
+
+func (client *Client) doAccessTokenRequest(req *http.Request) (*AccessToken, error) {
 	req.Header.Set("User-Agent", client.UserAgent)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.HTTPClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +80,39 @@ func (client *Client) GetAccessToken(ctx context.Context) (*AccessToken, error)
 	}
 
 	if resp.StatusCode > 299 {
+		if response.Meta == nil {
+			return nil, fmt.Errorf("%s %s returned %d with an unexpected error", req.Method, req.URL, resp.StatusCode)
+		}
 		return nil, errors.New(response.Meta.Meta.Message)
 	}
 
 	return &response.Data, nil
 }
+
+// EnsureAccessToken returns a valid access token, renewing it shortly before it expires.
+// A service account token is used as is, it does not expire.
+func (client *Client) EnsureAccessToken(ctx context.Context) (string, error) {
+	client.tokenMu.Lock()
+	defer client.tokenMu.Unlock()
+
+	if client.ServiceAccountToken != "" {
+		return client.ServiceAccountToken, nil
+	}
+
+	if client.AccessToken != "" && (client.accessTokenExpiresAt.IsZero() || time.Now().Add(accessTokenRenewalMargin).Before(client.accessTokenExpiresAt)) {
+		return client.AccessToken, nil
+	}
+
+	token, err := client.GetAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	client.AccessToken = token.AccessToken
+	client.accessTokenExpiresAt = time.Time{}
+	if token.ExpiresAt > 0 {
+		client.accessTokenExpiresAt = time.Unix(token.ExpiresAt, 0)
+	}
+
+	return client.AccessToken, nil
+}
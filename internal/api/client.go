@@ -8,7 +8,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/hasura/go-graphql-client"
 )
@@ -17,16 +18,107 @@ type Client struct {
 	Host   string
 	Region string
 
-	RefreshToken   string
+	// Exactly one of RefreshToken, ClientID and ClientSecret (OAuth client credentials) or ServiceAccountToken is used to authenticate.
+	RefreshToken        string
+	ClientID            string
+	ClientSecret        string
+	ServiceAccountToken string
+
 	AccessToken    string
 	OrganizationID string
 
+	tokenMu              sync.Mutex
+	accessTokenExpiresAt time.Time
+
 	UserAgent        string
 	BaseURLV2        string
 	BaseURLV3        string
 	BaseURLV4        string
 	AuthBaseURL      string
 	IngestionBaseURL string
+
+	// GraphQLClient is bound to the region and the access token of this client, so that provider aliases can target different regions.
+	GraphQLClient *graphql.Client
+
+	// SkipAnalyticsRefresh omits the computed analytics fields, e.g. the mttr of webforms, when reading resources.
+	SkipAnalyticsRefresh bool
+
+	// ValidateReferences verifies during plan that the ids referenced by resources, e.g. the participants of rotations, exist.
+	ValidateReferences bool
+
+	// AdoptExistingOnConflict adopts the existing object with the same name when the creation of a resource conflicts with it.
+	AdoptExistingOnConflict bool
+
+	// InventoryFile is the path of the JSON inventory of the resources managed by the provider, kept up to date as they are applied.
+	InventoryFile string
+
+	// InventoryRecordReads also records the resources in the inventory as they are read, e.g. by a refresh-only run.
+	InventoryRecordReads bool
+
+	// entityIDs caches the ids of the entities resolved by name, see ResolveEntityID.
+	entityIDs sync.Map
+
+	// entityNames caches the display names of the entities by type and id, see ResolveEntityNames.
+	entityNames sync.Map
+
+	// httpClient sends the requests, see HTTPClient.
+	httpClient *http.Client
+}
+
+// ClientOption customizes a Client, e.g. to target a mock server in tests.
+type ClientOption func(*Client)
+
+// WithEndpoint points all the API base URLs of the client to the given URL.
+func WithEndpoint(url string) ClientOption {
+	return func(client *Client) {
+		client.BaseURLV2 = url + "/v2"
+		client.BaseURLV3 = url + "/v3"
+		client.BaseURLV4 = url + "/v4"
+		client.AuthBaseURL = url + "/v3"
+		client.IngestionBaseURL = url
+	}
+}
+
+// WithServiceAccountToken authenticates the client with the given service account token.
+func WithServiceAccountToken(token string) ClientOption {
+	return func(client *Client) {
+		client.ServiceAccountToken = token
+	}
+}
+
+// WithSkipAnalyticsRefresh sets whether the computed analytics fields are read.
+func WithSkipAnalyticsRefresh(skip bool) ClientOption {
+	return func(client *Client) {
+		client.SkipAnalyticsRefresh = skip
+	}
+}
+
+// WithValidateReferences sets whether the referenced ids are verified during plan.
+func WithValidateReferences(validate bool) ClientOption {
+	return func(client *Client) {
+		client.ValidateReferences = validate
+	}
+}
+
+// WithAdoptExistingOnConflict sets whether the existing objects are adopted when the creation of a resource conflicts with them.
+func WithAdoptExistingOnConflict(adopt bool) ClientOption {
+	return func(client *Client) {
+		client.AdoptExistingOnConflict = adopt
+	}
+}
+
+// WithInventoryFile sets the path of the inventory of the managed resources.
+func WithInventoryFile(path string) ClientOption {
+	return func(client *Client) {
+		client.InventoryFile = path
+	}
+}
+
+// WithInventoryRecordReads sets whether the resources are recorded in the inventory as they are read.
+func WithInventoryRecordReads(record bool) ClientOption {
+	return func(client *Client) {
+		client.InventoryRecordReads = record
+	}
 }
 
 type ErrorDetails struct {
@@ -43,8 +135,6 @@ type AppError struct {
 	ErrorDetails *ErrorDetails `json:"error_details,omitempty"`
 }
 
-var GraphQLClient *graphql.Client
-
 func (err *AppError) Error() string {
 	str := fmt.Sprintf("[%d] %s", err.Status, err.Message)
 	if err.ErrorDetails != nil {
@@ -58,7 +148,16 @@ type Meta struct {
 	Meta AppError `json:"meta,omitempty"`
 }
 
+// ErrPreconditionFailed is returned when a conditional request is rejected because the resource changed in the meantime.
+var ErrPreconditionFailed = errors.New("the resource was modified concurrently")
+
 func Request[TReq any, TRes any](method string, url string, client *Client, ctx context.Context, payload *TReq) (*TRes, error) {
+	data, _, err := RequestWithHeaders[TReq, TRes](method, url, client, ctx, payload, nil)
+	return data, err
+}
+
+// RequestWithHeaders is like Request, but sends the given extra headers and also returns the response headers.
+func RequestWithHeaders[TReq any, TRes any](method string, url string, client *Client, ctx context.Context, payload *TReq, header http.Header) (*TRes, http.Header, error) {
 	var req *http.Request
 	var err error
 
@@ -69,24 +168,35 @@ func Request[TReq any, TRes any](method string, url string, client *Client, ctx
 		if payload != nil {
 			body, err := json.Marshal(payload)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			buf = bytes.NewBuffer(body)
 		}
 		req, err = http.NewRequestWithContext(ctx, method, url, buf)
+		if err != nil {
+			return nil, nil, err
+		}
 		req.Header.Set("Content-Type", "application/json;charset=UTF-8")
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.AccessToken))
+	accessToken, err := client.EnsureAccessToken(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
 	req.Header.Set("User-Agent", client.UserAgent)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := client.HTTPClient().Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var response struct {
@@ -97,30 +207,37 @@ func Request[TReq any, TRes any](method string, url string, client *Client, ctx
 	defer resp.Body.Close()
 	bytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return nil, resp.Header, fmt.Errorf("%s %s: %w", method, url, ErrPreconditionFailed)
 	}
 
 	if len(bytes) == 0 {
 		if resp.StatusCode > 299 {
-			return nil, fmt.Errorf("%s %s returned an unexpected error with no body", method, url)
+			return nil, resp.Header, newError(method, url, resp, nil)
 		} else {
-			return nil, nil
+			return nil, resp.Header, nil
 		}
 	}
 
 	if err := json.Unmarshal(bytes, &response); err != nil {
-		return nil, err
+		if resp.StatusCode > 299 {
+			return nil, resp.Header, newError(method, url, resp, &AppError{Message: string(bytes)})
+		}
+		return nil, resp.Header, err
 	}
 
 	if resp.StatusCode > 299 {
 		if response.Meta != nil {
-			return nil, fmt.Errorf("%s %s returned an error:\n%s", method, url, response.Meta.Meta.Error())
+			return nil, resp.Header, newError(method, url, resp, &response.Meta.Meta)
 		} else {
-			return nil, fmt.Errorf("%s %s returned %d with an unexpected error: %#v", method, url, resp.StatusCode, response)
+			return nil, resp.Header, newError(method, url, resp, nil)
 		}
 	}
 
-	return response.Data, nil
+	return response.Data, resp.Header, nil
 }
 
 func RequestSlice[TReq any, TRes any](method string, url string, client *Client, ctx context.Context, payload *TReq) ([]*TRes, error) {
@@ -132,25 +249,30 @@ func RequestSlice[TReq any, TRes any](method string, url string, client *Client,
 	return *data, nil
 }
 
-func IsResourceNotFoundError(e error) bool {
-	return strings.Contains(e.Error(), "[404]")
-}
-
 // GraphQLRequest is a generic function to make graphql requests
 // method values can be query/mutate
 func GraphQLRequest[TReq any](method string, client *Client, ctx context.Context, payload *TReq, variables map[string]interface{}) (*TReq, error) {
+	// Renew the access token up front, the request modifier of the graphql client can not report errors.
+	if _, err := client.EnsureAccessToken(ctx); err != nil {
+		return nil, err
+	}
+
+	var err error
 	switch method {
 	case "query":
-		if err := GraphQLClient.WithDebug(false).Query(ctx, payload, variables); err != nil {
-			return nil, err
-		}
+		err = client.GraphQLClient.WithDebug(false).Query(ctx, payload, variables)
 	case "mutate":
-		if err := GraphQLClient.WithDebug(false).Mutate(ctx, payload, variables); err != nil {
-			return nil, err
-		}
+		err = client.GraphQLClient.WithDebug(false).Mutate(ctx, payload, variables)
 	default:
 		return nil, errors.New("invalid method")
 	}
+	if err != nil {
+		var errs graphql.Errors
+		if errors.As(err, &errs) && len(errs) > 0 {
+			return nil, newGraphQLErrors(method, errs)
+		}
+		return nil, err
+	}
 
 	return payload, nil
 }
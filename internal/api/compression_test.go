@@ -0,0 +1,53 @@
+package api
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResponseCompression(t *testing.T) {
+	var names []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The request bodies are sent as is, whatever their size.
+		if encoding := r.Header.Get("Content-Encoding"); encoding != "" {
+			t.Errorf("expected the request body not to be encoded, got Content-Encoding %q", encoding)
+		}
+		var req CreateSquadReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, req.Name)
+
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Errorf("expected gzip responses to be accepted, got Accept-Encoding %q", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+
+	large := strings.Repeat("sre", 4096)
+	for _, name := range []string{"sre", large} {
+		squad, err := Request[CreateSquadReq, Squad](http.MethodPost, server.URL+"/v3/squads", client, context.Background(), &CreateSquadReq{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if squad.ID != "61305a8eb7a2fa0e44cfd0f5" {
+			t.Errorf("expected the gzip response to be decoded, got %#v", squad)
+		}
+	}
+
+	if len(names) != 2 || names[0] != "sre" || names[1] != large {
+		t.Errorf("expected the bodies to be received intact")
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type DeduplicationMLSettings struct {
+	ServiceID           string  `json:"service_id" tf:"service_id"`
+	Enabled             bool    `json:"enabled" tf:"enabled"`
+	SimilarityThreshold float64 `json:"similarity_threshold" tf:"similarity_threshold"`
+	TimeWindow          int     `json:"time_window" tf:"time_window"`
+	TimeUnit            string  `json:"time_unit" tf:"time_unit"`
+}
+
+func (s *DeduplicationMLSettings) Encode() (tf.M, error) {
+	return tf.Encode(s)
+}
+
+func (client *Client) GetDeduplicationMLSettings(ctx context.Context, serviceID string) (*DeduplicationMLSettings, error) {
+	url := fmt.Sprintf("%s/services/%s/deduplication-ml-settings", client.BaseURLV3, serviceID)
+
+	return Request[any, DeduplicationMLSettings](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateDeduplicationMLSettingsReq struct {
+	Enabled             bool    `json:"enabled"`
+	SimilarityThreshold float64 `json:"similarity_threshold"`
+	TimeWindow          int     `json:"time_window"`
+	TimeUnit            string  `json:"time_unit"`
+}
+
+func (client *Client) UpdateDeduplicationMLSettings(ctx context.Context, serviceID string, req *UpdateDeduplicationMLSettingsReq) (*DeduplicationMLSettings, error) {
+	url := fmt.Sprintf("%s/services/%s/deduplication-ml-settings", client.BaseURLV3, serviceID)
+
+	return Request[UpdateDeduplicationMLSettingsReq, DeduplicationMLSettings](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteDeduplicationMLSettings resets the ML deduplication settings of the service to the defaults of the organization.
+func (client *Client) DeleteDeduplicationMLSettings(ctx context.Context, serviceID string) (*any, error) {
+	url := fmt.Sprintf("%s/services/%s/deduplication-ml-settings", client.BaseURLV3, serviceID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
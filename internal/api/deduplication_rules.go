@@ -26,6 +26,7 @@ type DeduplicationRule struct {
 	TimeUnit                string                        `json:"time_unit" tf:"time_unit"`
 	TimeWindow              int                           `json:"time_window" tf:"time_window"`
 	BasicExpression         []*DeduplicationRuleCondition `json:"basic_expression" tf:"basic_expressions"`
+	Disabled                bool                          `json:"disabled,omitempty" tf:"disabled"`
 }
 
 func (r *DeduplicationRule) Encode() (tf.M, error) {
@@ -39,6 +40,7 @@ func (r *DeduplicationRule) Encode() (tf.M, error) {
 		return nil, err
 	}
 	m["basic_expressions"] = basicExpression
+	encodeEnabled(m)
 
 	return m, nil
 }
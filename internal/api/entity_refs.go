@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResolveEntityID returns the id of the entity of the given type with the given name, or email for users.
+// Squads, schedules and escalation policies are looked up in the team. The ids are cached for the lifetime of the client, since
+// the same owners and participants are usually referenced by many resources of a configuration.
+func (client *Client) ResolveEntityID(ctx context.Context, entityType string, teamID string, name string) (string, error) {
+	key := entityType + "/" + teamID + "/" + name
+	if id, ok := client.entityIDs.Load(key); ok {
+		return id.(string), nil
+	}
+
+	var id string
+	switch entityType {
+	case "user":
+		user, err := client.GetUserByEmail(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		id = user.ID
+	case "team":
+		team, err := client.GetTeamByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		id = team.ID
+	case "squad":
+		squad, err := client.GetSquadByName(ctx, teamID, name)
+		if err != nil {
+			return "", err
+		}
+		id = squad.ID
+	case "schedule":
+		schedule, err := client.GetScheduleByName(ctx, teamID, name)
+		if err != nil {
+			return "", err
+		}
+		id = schedule.ID
+	case "schedulev2":
+		schedules, err := client.GetScheduleV2ByName(ctx, teamID, name)
+		if err != nil {
+			return "", err
+		}
+		if len(schedules.NewSchedule) == 0 {
+			return "", fmt.Errorf("could not find a schedule with name `%s`", name)
+		}
+		id = strconv.Itoa(schedules.NewSchedule[0].ID)
+	case "escalation_policy":
+		escalationPolicy, err := client.GetEscalationPolicyByName(ctx, teamID, name)
+		if err != nil {
+			return "", err
+		}
+		id = escalationPolicy.ID
+	default:
+		return "", fmt.Errorf("entities of type %s can not be referenced by name", entityType)
+	}
+
+	client.entityIDs.Store(key, id)
+
+	return id, nil
+}
+
+// EntityRef is a reference to an entity by type and id, e.g. the target of an escalation policy.
+type EntityRef struct {
+	Type string
+	ID   string
+}
+
+// ResolveEntityNames returns the display names of the given entities, the full name for users. Squads, schedules
+// and escalation policies are looked up in the team. The entities are listed by type, once per type whose entities
+// are not cached yet, rather than read one by one. Entities that are not found, e.g. deleted ones, and entities of
+// other types are left out.
+func (client *Client) ResolveEntityNames(ctx context.Context, teamID string, refs []EntityRef) (map[EntityRef]string, error) {
+	names := make(map[EntityRef]string, len(refs))
+	missing := map[string]bool{}
+	for _, ref := range refs {
+		if name, ok := client.entityNames.Load(ref.Type + "/" + ref.ID); ok {
+			names[ref] = name.(string)
+		} else {
+			missing[ref.Type] = true
+		}
+	}
+
+	for entityType := range missing {
+		store := func(id string, name string) {
+			client.entityNames.Store(entityType+"/"+id, name)
+		}
+
+		switch entityType {
+		case "user":
+			users, err := client.ListUsers(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				store(user.ID, strings.TrimSpace(user.FirstName+" "+user.LastName))
+			}
+		case "team":
+			teams, err := client.ListTeams(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, team := range teams {
+				store(team.ID, team.Name)
+			}
+		case "squad":
+			squads, err := client.ListSquads(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+			for _, squad := range squads {
+				store(squad.ID, squad.Name)
+			}
+		case "schedule":
+			schedules, err := client.ListSchedules(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+			for _, schedule := range schedules {
+				store(schedule.ID, schedule.Name)
+			}
+		case "schedulev2":
+			schedules, err := client.ListSchedulesV2(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+			for _, schedule := range schedules {
+				store(strconv.Itoa(schedule.ID), schedule.Name)
+			}
+		case "escalation_policy":
+			escalationPolicies, err := client.ListEscalationPolicies(ctx, teamID)
+			if err != nil {
+				return nil, err
+			}
+			for _, escalationPolicy := range escalationPolicies {
+				store(escalationPolicy.ID, escalationPolicy.Name)
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		if name, ok := client.entityNames.Load(ref.Type + "/" + ref.ID); ok {
+			names[ref] = name.(string)
+		}
+	}
+
+	return names, nil
+}
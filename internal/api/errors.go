@@ -0,0 +1,189 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+// Error is returned by the requests to the Squadcast API that fail with an error response.
+type Error struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Code is the Squadcast error code of the error details, e.g. to tell a name that already exists from an invalid owner.
+	Code        string
+	Message     string
+	Description string
+	Link        string
+	// Field is the field of the request the error is about, when the API reports one, e.g. name or owner_id.
+	Field string
+	// Details are the errors of the error details, e.g. the validation errors by field.
+	Details any
+	// RequestID is the id of the request returned by the API, to be quoted to the Squadcast support.
+	RequestID string
+}
+
+func (err *Error) Error() string {
+	message := err.Message
+	if message == "" {
+		message = http.StatusText(err.StatusCode)
+	}
+
+	str := fmt.Sprintf("%s %s returned an error:\n[%d] %s", err.Method, err.URL, err.StatusCode, message)
+	if err.Code != "" {
+		str += fmt.Sprintf("\ncode: %s", err.Code)
+	}
+	if err.Field != "" {
+		str += fmt.Sprintf("\nfield: %s", err.Field)
+	}
+	if err.Description != "" {
+		str += fmt.Sprintf("\ndescription: %s", err.Description)
+	}
+	if err.RequestID != "" {
+		str += fmt.Sprintf("\nrequest id: %s", err.RequestID)
+	}
+	return str
+}
+
+// newError returns the Error of a failed request, from the meta of its response when there is one.
+func newError(method string, url string, resp *http.Response, meta *AppError) *Error {
+	err := &Error{
+		Method:     method,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(RequestIDHeader),
+	}
+	if meta == nil {
+		return err
+	}
+
+	err.Message = meta.Message
+	if meta.ErrorDetails != nil {
+		err.Code = meta.ErrorDetails.Code
+		err.Description = meta.ErrorDetails.Description
+		err.Link = meta.ErrorDetails.Link
+		err.Details = meta.ErrorDetails.Errors
+		err.Field = errorField(meta.ErrorDetails.Errors)
+	}
+	if err.Field == "" && meta.ConflictData != nil {
+		err.Field = errorField(*meta.ConflictData)
+	}
+
+	return err
+}
+
+// errorField returns the field of the errors of a response, either a single field of an object of errors by field,
+// or the first field of a list of errors.
+func errorField(errs any) string {
+	switch errs := errs.(type) {
+	case map[string]any:
+		if field, ok := errs["field"].(string); ok {
+			return field
+		}
+		if len(errs) == 1 {
+			for field := range errs {
+				return field
+			}
+		}
+	case []any:
+		fields := []string{}
+		for _, e := range errs {
+			if field := errorField(e); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) > 0 {
+			sort.Strings(fields)
+			return fields[0]
+		}
+	}
+
+	return ""
+}
+
+// GraphQLError is an error of the response of a GraphQL request, e.g. a validation error of the schedules API.
+type GraphQLError struct {
+	Message string
+	// Code is the code of the extensions of the error, e.g. BAD_USER_INPUT.
+	Code string
+	// Field is the field of the input the error is about, e.g. shiftTimeSlots[0].duration, when the API reports it in
+	// the extensions of the error.
+	Field string
+}
+
+func (err *GraphQLError) Error() string {
+	str := err.Message
+	if err.Code != "" {
+		str += fmt.Sprintf("\ncode: %s", err.Code)
+	}
+	if err.Field != "" {
+		str += fmt.Sprintf("\nfield: %s", err.Field)
+	}
+	return str
+}
+
+// GraphQLErrors are returned by the GraphQL requests whose response has errors.
+type GraphQLErrors struct {
+	// Operation is the GraphQL operation of the request, query or mutate.
+	Operation string
+	Errors    []*GraphQLError
+}
+
+func (errs *GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(errs.Errors))
+	for _, err := range errs.Errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("graphql %s returned an error:\n%s", errs.Operation, strings.Join(messages, "\n\n"))
+}
+
+// newGraphQLErrors returns the GraphQLErrors of the errors of a response, the field of an error is read from the
+// field extension, or from the path extension when it is a string.
+func newGraphQLErrors(operation string, errs graphql.Errors) *GraphQLErrors {
+	gqlErrs := &GraphQLErrors{Operation: operation}
+	for _, e := range errs {
+		gqlErr := &GraphQLError{Message: e.Message}
+		if code, ok := e.Extensions["code"].(string); ok {
+			gqlErr.Code = code
+		}
+		if field, ok := e.Extensions["field"].(string); ok {
+			gqlErr.Field = field
+		} else if path, ok := e.Extensions["path"].(string); ok {
+			gqlErr.Field = path
+		}
+		gqlErrs.Errors = append(gqlErrs.Errors, gqlErr)
+	}
+
+	return gqlErrs
+}
+
+// ErrorStatusCode returns the status code of an Error of the API, or 0.
+func ErrorStatusCode(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsResourceNotFoundError reports whether the error is a 404 of the API, or a GraphQL error whose errors are all NOT_FOUND.
+func IsResourceNotFoundError(e error) bool {
+	if ErrorStatusCode(e) == http.StatusNotFound {
+		return true
+	}
+	var gqlErrs *GraphQLErrors
+	if errors.As(e, &gqlErrs) && len(gqlErrs.Errors) > 0 {
+		for _, gqlErr := range gqlErrs.Errors {
+			if gqlErr.Code != "NOT_FOUND" {
+				return false
+			}
+		}
+		return true
+	}
+	return strings.Contains(e.Error(), "[404]")
+}
@@ -9,9 +9,12 @@ import (
 )
 
 type EscalationPolicyTarget struct {
-	ID   string `json:"id,omitempty"`
-	Type string `json:"type"`
-	PID  int    `json:"pid,omitempty"`
+	ID   string   `json:"id,omitempty"`
+	Type string   `json:"type"`
+	PID  int      `json:"pid,omitempty"`
+	Via  []string `json:"via,omitempty"`
+	// RotationID restricts a schedulev2 target to a single rotation (layer) of the schedule.
+	RotationID int `json:"rotationID,omitempty"`
 }
 
 func (t *EscalationPolicyTarget) Encode() (tf.M, error) {
@@ -21,10 +24,22 @@ func (t *EscalationPolicyTarget) Encode() (tf.M, error) {
 	} else {
 		ID = t.ID
 	}
-	return tf.M{
-		"id":   ID,
-		"type": t.Type,
-	}, nil
+	m := tf.M{
+		"id":          ID,
+		"type":        t.Type,
+		"rotation_id": "",
+	}
+	if t.RotationID != 0 {
+		m["rotation_id"] = fmt.Sprintf("%d", t.RotationID)
+	}
+
+	if len(t.Via) == 0 {
+		m["notification_channels"] = []string{}
+	} else {
+		m["notification_channels"] = t.Via
+	}
+
+	return m, nil
 }
 
 type EscalationPolicyRule struct {
@@ -91,6 +106,7 @@ type EscalationPolicy struct {
 	Slug               string                  `json:"slug"`
 	Owner              OwnerRef                `json:"owner"`
 	EntityOwner        *EntityOwner            `json:"entity_owner"`
+	Tags               []ServiceTag            `json:"tags,omitempty"`
 }
 
 func (ep *EscalationPolicy) Encode() (tf.M, error) {
@@ -160,12 +176,16 @@ type CreateUpdateEscalationPolicyReq struct {
 	Rules              []EscalationPolicyRule `json:"rules"`
 	IsUsingNewFields   bool                   `json:"is_using_new_fields"`
 	EntityOwner        *EntityOwner           `json:"entity_owner"`
+	// Tags are left unchanged when nil.
+	Tags *[]ServiceTag `json:"tags,omitempty"`
 }
 
 func (client *Client) CreateEscalationPolicy(ctx context.Context, req *CreateUpdateEscalationPolicyReq) (*EscalationPolicy, error) {
 	url := fmt.Sprintf("%s/escalation-policies", client.BaseURLV3)
 
-	return Request[CreateUpdateEscalationPolicyReq, EscalationPolicy](http.MethodPost, url, client, ctx, req)
+	return createIdempotently(ctx, client, url, req, func() (*EscalationPolicy, error) {
+		return client.GetEscalationPolicyByName(ctx, req.TeamID, req.Name)
+	})
 }
 
 func (client *Client) UpdateEscalationPolicy(ctx context.Context, id string, req *CreateUpdateEscalationPolicyReq) (*EscalationPolicy, error) {
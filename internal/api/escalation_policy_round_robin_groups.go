@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type RoundRobinGroupMember struct {
+	ID   string `json:"id" tf:"id"`
+	Type string `json:"type" tf:"type"`
+}
+
+func (m *RoundRobinGroupMember) Encode() (tf.M, error) {
+	return tf.Encode(m)
+}
+
+type EscalationPolicyRoundRobinGroup struct {
+	ID                      string                   `json:"id" tf:"id"`
+	EscalationPolicyID      string                   `json:"escalation_policy_id" tf:"escalation_policy_id"`
+	RuleIndex               int                      `json:"rule_index" tf:"rule_index"`
+	Members                 []*RoundRobinGroupMember `json:"members" tf:"-"`
+	StickyAssignment        bool                     `json:"sticky_assignment" tf:"sticky_assignment"`
+	StickinessWindowMinutes int                      `json:"stickiness_window" tf:"stickiness_window_minutes"`
+}
+
+func (g *EscalationPolicyRoundRobinGroup) Encode() (tf.M, error) {
+	m, err := tf.Encode(g)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := tf.EncodeSlice(g.Members)
+	if err != nil {
+		return nil, err
+	}
+	m["members"] = members
+
+	return m, nil
+}
+
+func (client *Client) GetEscalationPolicyRoundRobinGroupById(ctx context.Context, escalationPolicyID string, id string) (*EscalationPolicyRoundRobinGroup, error) {
+	url := fmt.Sprintf("%s/escalation-policies/%s/round-robin-groups/%s", client.BaseURLV3, escalationPolicyID, id)
+
+	return Request[any, EscalationPolicyRoundRobinGroup](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateEscalationPolicyRoundRobinGroupReq struct {
+	RuleIndex               int                     `json:"rule_index"`
+	Members                 []RoundRobinGroupMember `json:"members"`
+	StickyAssignment        bool                    `json:"sticky_assignment"`
+	StickinessWindowMinutes int                     `json:"stickiness_window"`
+}
+
+func (client *Client) CreateEscalationPolicyRoundRobinGroup(ctx context.Context, escalationPolicyID string, req *CreateUpdateEscalationPolicyRoundRobinGroupReq) (*EscalationPolicyRoundRobinGroup, error) {
+	url := fmt.Sprintf("%s/escalation-policies/%s/round-robin-groups", client.BaseURLV3, escalationPolicyID)
+
+	return Request[CreateUpdateEscalationPolicyRoundRobinGroupReq, EscalationPolicyRoundRobinGroup](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateEscalationPolicyRoundRobinGroup(ctx context.Context, escalationPolicyID string, id string, req *CreateUpdateEscalationPolicyRoundRobinGroupReq) (*EscalationPolicyRoundRobinGroup, error) {
+	url := fmt.Sprintf("%s/escalation-policies/%s/round-robin-groups/%s", client.BaseURLV3, escalationPolicyID, id)
+
+	return Request[CreateUpdateEscalationPolicyRoundRobinGroupReq, EscalationPolicyRoundRobinGroup](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteEscalationPolicyRoundRobinGroup(ctx context.Context, escalationPolicyID string, id string) (*any, error) {
+	url := fmt.Sprintf("%s/escalation-policies/%s/round-robin-groups/%s", client.BaseURLV3, escalationPolicyID, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// EscalationPolicyTemplateParameter is a placeholder of an escalation policy template, its targets of type parameter
+// are replaced by the value of the parameter when the template is instantiated for a team.
+type EscalationPolicyTemplateParameter struct {
+	Name        string `json:"name" tf:"name"`
+	Type        string `json:"type" tf:"type"`
+	Description string `json:"description" tf:"description"`
+}
+
+func (p *EscalationPolicyTemplateParameter) Encode() (tf.M, error) {
+	return tf.Encode(p)
+}
+
+// EscalationPolicyTemplate is an escalation policy design shared by the teams of the organization. Version is
+// incremented on every update of the template.
+type EscalationPolicyTemplate struct {
+	ID                 string                               `json:"id"`
+	Name               string                               `json:"name"`
+	Description        string                               `json:"description"`
+	Owner              OwnerRef                             `json:"owner"`
+	Parameters         []*EscalationPolicyTemplateParameter `json:"parameters"`
+	RepeatTimes        int                                  `json:"repetition"`
+	RepeatAfterMinutes int                                  `json:"repeat_after"`
+	Rules              []*EscalationPolicyRule              `json:"rules"`
+	Version            int                                  `json:"version"`
+}
+
+func (t *EscalationPolicyTemplate) Encode() (tf.M, error) {
+	m := tf.M{
+		"id":          t.ID,
+		"name":        t.Name,
+		"description": t.Description,
+		"team_id":     t.Owner.ID,
+		"version":     t.Version,
+	}
+
+	parameters, err := tf.EncodeSlice(t.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	m["parameters"] = parameters
+
+	if t.RepeatTimes != 0 || t.RepeatAfterMinutes != 0 {
+		m["repeat"] = tf.List(tf.M{
+			"times":         t.RepeatTimes,
+			"delay_minutes": t.RepeatAfterMinutes,
+		})
+	}
+
+	rules, err := tf.EncodeSlice(t.Rules)
+	if err != nil {
+		return nil, err
+	}
+	m["rules"] = rules
+
+	return m, nil
+}
+
+func (client *Client) GetEscalationPolicyTemplateById(ctx context.Context, id string) (*EscalationPolicyTemplate, error) {
+	url := fmt.Sprintf("%s/escalation-policy-templates/%s", client.BaseURLV3, id)
+
+	return Request[any, EscalationPolicyTemplate](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetEscalationPolicyTemplateByName(ctx context.Context, teamID string, name string) (*EscalationPolicyTemplate, error) {
+	templates, err := client.ListEscalationPolicyTemplates(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find an escalation policy template with name `%s`", name)
+}
+
+func (client *Client) ListEscalationPolicyTemplates(ctx context.Context, teamID string) ([]*EscalationPolicyTemplate, error) {
+	url := fmt.Sprintf("%s/escalation-policy-templates?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, EscalationPolicyTemplate](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateEscalationPolicyTemplateReq struct {
+	TeamID             string                               `json:"owner_id"`
+	Name               string                               `json:"name"`
+	Description        string                               `json:"description"`
+	Parameters         []*EscalationPolicyTemplateParameter `json:"parameters"`
+	RepeatTimes        int                                  `json:"repetition"`
+	RepeatAfterMinutes int                                  `json:"repeat_after"`
+	Rules              []EscalationPolicyRule               `json:"rules"`
+}
+
+func (client *Client) CreateEscalationPolicyTemplate(ctx context.Context, req *CreateUpdateEscalationPolicyTemplateReq) (*EscalationPolicyTemplate, error) {
+	url := fmt.Sprintf("%s/escalation-policy-templates", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*EscalationPolicyTemplate, error) {
+		return client.GetEscalationPolicyTemplateByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdateEscalationPolicyTemplate(ctx context.Context, id string, req *CreateUpdateEscalationPolicyTemplateReq) (*EscalationPolicyTemplate, error) {
+	url := fmt.Sprintf("%s/escalation-policy-templates/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateEscalationPolicyTemplateReq, EscalationPolicyTemplate](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteEscalationPolicyTemplate(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/escalation-policy-templates/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type EscalationRepeatCapQuietHoursException struct {
+	StartHour   int    `json:"start_hour" tf:"start_hour"`
+	StartMinute int    `json:"start_minute" tf:"start_minute"`
+	Duration    int    `json:"duration" tf:"duration"`
+	DayOfWeek   string `json:"day_of_week,omitempty" tf:"day_of_week"`
+	MaxRepeats  int    `json:"max_repeats" tf:"max_repeats"`
+}
+
+func (e *EscalationRepeatCapQuietHoursException) Encode() (tf.M, error) {
+	return tf.Encode(e)
+}
+
+type EscalationRepeatCapPolicy struct {
+	OwnerID              string                                    `json:"owner_id" tf:"team_id"`
+	MaxRepeats           int                                       `json:"max_repeats" tf:"max_repeats"`
+	MaxRuleRepeats       int                                       `json:"max_rule_repeats" tf:"max_rule_repeats"`
+	Timezone             string                                    `json:"timezone" tf:"timezone"`
+	QuietHoursExceptions []*EscalationRepeatCapQuietHoursException `json:"quiet_hours_exceptions" tf:"-"`
+}
+
+func (p *EscalationRepeatCapPolicy) Encode() (tf.M, error) {
+	m, err := tf.Encode(p)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := tf.EncodeSlice(p.QuietHoursExceptions)
+	if err != nil {
+		return nil, err
+	}
+	m["quiet_hours_exceptions"] = exceptions
+
+	return m, nil
+}
+
+func (client *Client) GetEscalationRepeatCapPolicy(ctx context.Context, teamID string) (*EscalationRepeatCapPolicy, error) {
+	url := fmt.Sprintf("%s/teams/%s/escalation-repeat-cap-policy", client.BaseURLV3, teamID)
+
+	return Request[any, EscalationRepeatCapPolicy](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateEscalationRepeatCapPolicyReq struct {
+	MaxRepeats           int                                      `json:"max_repeats"`
+	MaxRuleRepeats       int                                      `json:"max_rule_repeats"`
+	Timezone             string                                   `json:"timezone"`
+	QuietHoursExceptions []EscalationRepeatCapQuietHoursException `json:"quiet_hours_exceptions"`
+}
+
+func (client *Client) UpdateEscalationRepeatCapPolicy(ctx context.Context, teamID string, req *UpdateEscalationRepeatCapPolicyReq) (*EscalationRepeatCapPolicy, error) {
+	url := fmt.Sprintf("%s/teams/%s/escalation-repeat-cap-policy", client.BaseURLV3, teamID)
+
+	return Request[UpdateEscalationRepeatCapPolicyReq, EscalationRepeatCapPolicy](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteEscalationRepeatCapPolicy removes the caps of the team, the escalation policies then repeat as configured.
+func (client *Client) DeleteEscalationRepeatCapPolicy(ctx context.Context, teamID string) (*any, error) {
+	url := fmt.Sprintf("%s/teams/%s/escalation-repeat-cap-policy", client.BaseURLV3, teamID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// extensionConfigURL returns the url of the configuration of an extension (slack, msteams, jira-cloud...) of the team.
+func extensionConfigURL(client *Client, extension string, teamID string) string {
+	return fmt.Sprintf("%s/extensions/%s/config?owner_id=%s", client.BaseURLV3, extension, teamID)
+}
+
+type SlackIntegration struct {
+	TeamID                      string `json:"owner_id" tf:"team_id"`
+	WorkspaceID                 string `json:"workspace_id" tf:"workspace_id"`
+	WorkspaceName               string `json:"workspace_name" tf:"workspace_name"`
+	DefaultChannelID            string `json:"default_channel_id" tf:"default_channel_id"`
+	AutoCreateIncidentChannel   bool   `json:"auto_create_incident_channel" tf:"auto_create_incident_channel"`
+	IncidentChannelNameTemplate string `json:"incident_channel_name_template" tf:"incident_channel_name_template"`
+}
+
+func (s *SlackIntegration) Encode() (tf.M, error) {
+	return tf.Encode(s)
+}
+
+// GetSlackIntegration returns the Slack integration of the team, the API responds with a not found error
+// until a Slack workspace has been connected to the team.
+func (client *Client) GetSlackIntegration(ctx context.Context, teamID string) (*SlackIntegration, error) {
+	return Request[any, SlackIntegration](http.MethodGet, extensionConfigURL(client, "slack", teamID), client, ctx, nil)
+}
+
+type UpdateSlackIntegrationReq struct {
+	DefaultChannelID            string `json:"default_channel_id"`
+	AutoCreateIncidentChannel   bool   `json:"auto_create_incident_channel"`
+	IncidentChannelNameTemplate string `json:"incident_channel_name_template,omitempty"`
+}
+
+func (client *Client) UpdateSlackIntegration(ctx context.Context, teamID string, req *UpdateSlackIntegrationReq) (*SlackIntegration, error) {
+	return Request[UpdateSlackIntegrationReq, SlackIntegration](http.MethodPut, extensionConfigURL(client, "slack", teamID), client, ctx, req)
+}
+
+// DeleteSlackIntegration resets the configuration of the Slack integration of the team, the workspace stays connected.
+func (client *Client) DeleteSlackIntegration(ctx context.Context, teamID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, extensionConfigURL(client, "slack", teamID), client, ctx, nil)
+}
+
+type MSTeamsChannelMapping struct {
+	ServiceID     string `json:"service_id" tf:"service_id"`
+	MSTeamsTeamID string `json:"msteams_team_id" tf:"msteams_team_id"`
+	ChannelID     string `json:"channel_id" tf:"channel_id"`
+}
+
+func (m MSTeamsChannelMapping) Encode() (tf.M, error) {
+	return tf.Encode(m)
+}
+
+type MSTeamsIntegration struct {
+	TeamID          string                   `json:"owner_id" tf:"team_id"`
+	TenantID        string                   `json:"tenant_id" tf:"tenant_id"`
+	ChannelMappings []*MSTeamsChannelMapping `json:"channel_mappings" tf:"-"`
+}
+
+func (s *MSTeamsIntegration) Encode() (tf.M, error) {
+	m, err := tf.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := tf.EncodeSlice(s.ChannelMappings)
+	if err != nil {
+		return nil, err
+	}
+	m["channel_mapping"] = mappings
+
+	return m, nil
+}
+
+// GetMSTeamsIntegration returns the Microsoft Teams integration of the team, the API responds with a not found error
+// until the Squadcast app has been installed in a Microsoft Teams tenant for the team.
+func (client *Client) GetMSTeamsIntegration(ctx context.Context, teamID string) (*MSTeamsIntegration, error) {
+	return Request[any, MSTeamsIntegration](http.MethodGet, extensionConfigURL(client, "msteams", teamID), client, ctx, nil)
+}
+
+type UpdateMSTeamsIntegrationReq struct {
+	ChannelMappings []MSTeamsChannelMapping `json:"channel_mappings"`
+}
+
+func (client *Client) UpdateMSTeamsIntegration(ctx context.Context, teamID string, req *UpdateMSTeamsIntegrationReq) (*MSTeamsIntegration, error) {
+	return Request[UpdateMSTeamsIntegrationReq, MSTeamsIntegration](http.MethodPut, extensionConfigURL(client, "msteams", teamID), client, ctx, req)
+}
+
+// DeleteMSTeamsIntegration removes the channel mappings of the team, the tenant stays connected.
+func (client *Client) DeleteMSTeamsIntegration(ctx context.Context, teamID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, extensionConfigURL(client, "msteams", teamID), client, ctx, nil)
+}
+
+type JiraCloudProjectMapping struct {
+	ServiceID  string `json:"service_id" tf:"service_id"`
+	ProjectKey string `json:"project_key" tf:"project_key"`
+	IssueType  string `json:"issue_type" tf:"issue_type"`
+}
+
+func (m JiraCloudProjectMapping) Encode() (tf.M, error) {
+	return tf.Encode(m)
+}
+
+type JiraCloudIntegration struct {
+	TeamID          string                     `json:"owner_id" tf:"team_id"`
+	AccountURL      string                     `json:"account_url" tf:"account_url"`
+	ProjectMappings []*JiraCloudProjectMapping `json:"project_mappings" tf:"-"`
+	AutoCreateIssue bool                       `json:"auto_create_issue" tf:"auto_create_issue"`
+	SyncStatus      bool                       `json:"sync_status" tf:"sync_status"`
+	SyncComments    bool                       `json:"sync_comments" tf:"sync_comments"`
+}
+
+func (s *JiraCloudIntegration) Encode() (tf.M, error) {
+	m, err := tf.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := tf.EncodeSlice(s.ProjectMappings)
+	if err != nil {
+		return nil, err
+	}
+	m["project_mapping"] = mappings
+
+	return m, nil
+}
+
+// GetJiraCloudIntegration returns the Jira Cloud integration of the team, the API responds with a not found error
+// until a Jira Cloud account has been connected to the team.
+func (client *Client) GetJiraCloudIntegration(ctx context.Context, teamID string) (*JiraCloudIntegration, error) {
+	return Request[any, JiraCloudIntegration](http.MethodGet, extensionConfigURL(client, "jira-cloud", teamID), client, ctx, nil)
+}
+
+type UpdateJiraCloudIntegrationReq struct {
+	ProjectMappings []JiraCloudProjectMapping `json:"project_mappings"`
+	AutoCreateIssue bool                      `json:"auto_create_issue"`
+	SyncStatus      bool                      `json:"sync_status"`
+	SyncComments    bool                      `json:"sync_comments"`
+}
+
+func (client *Client) UpdateJiraCloudIntegration(ctx context.Context, teamID string, req *UpdateJiraCloudIntegrationReq) (*JiraCloudIntegration, error) {
+	return Request[UpdateJiraCloudIntegrationReq, JiraCloudIntegration](http.MethodPut, extensionConfigURL(client, "jira-cloud", teamID), client, ctx, req)
+}
+
+// DeleteJiraCloudIntegration resets the configuration of the Jira Cloud integration of the team, the account stays connected.
+func (client *Client) DeleteJiraCloudIntegration(ctx context.Context, teamID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, extensionConfigURL(client, "jira-cloud", teamID), client, ctx, nil)
+}
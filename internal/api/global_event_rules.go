@@ -35,6 +35,7 @@ type GER_Ruleset_Rules struct {
 	Description string            `json:"description,omitempty" tf:"description"`
 	Expression  string            `json:"expression,omitempty" tf:"expression"`
 	Action      map[string]string `json:"action" tf:"action"`
+	Disabled    bool              `json:"disabled" tf:"disabled"`
 }
 
 type GERAlertSource struct {
@@ -99,6 +100,7 @@ func (ger *GER_Ruleset_Rules) Encode() (map[string]interface{}, error) {
 		return nil, err
 	}
 	m["action"] = action
+	encodeEnabled(m)
 
 	return m, nil
 }
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type HandoffNotesTemplate struct {
+	ScheduleID            int    `graphql:"scheduleID" json:"scheduleID" tf:"-"`
+	Content               string `graphql:"content" json:"content" tf:"content"`
+	RequireNotes          bool   `graphql:"requireNotes" json:"requireNotes" tf:"require_notes"`
+	ReminderMinutesBefore int    `graphql:"reminderMinutesBefore" json:"reminderMinutesBefore" tf:"reminder_minutes_before"`
+}
+
+func (t *HandoffNotesTemplate) Encode() (tf.M, error) {
+	m, err := tf.Encode(t)
+	if err != nil {
+		return nil, err
+	}
+
+	m["schedule_id"] = strconv.Itoa(t.ScheduleID)
+
+	return m, nil
+}
+
+type HandoffNotesTemplateInput struct {
+	Content               string `graphql:"content" json:"content"`
+	RequireNotes          bool   `graphql:"requireNotes" json:"requireNotes"`
+	ReminderMinutesBefore int    `graphql:"reminderMinutesBefore" json:"reminderMinutesBefore"`
+}
+
+// GraphQL query structs
+type HandoffNotesTemplateQueryStruct struct {
+	HandoffNotesTemplate `graphql:"handoffNotesTemplate(scheduleID: $scheduleID)"`
+}
+
+type UpdateHandoffNotesTemplateMutateStruct struct {
+	HandoffNotesTemplate `graphql:"updateHandoffNotesTemplate(scheduleID: $scheduleID, input: $input)"`
+}
+
+type DeleteHandoffNotesTemplateMutateStruct struct {
+	HandoffNotesTemplate struct {
+		ScheduleID int `graphql:"scheduleID"`
+	} `graphql:"deleteHandoffNotesTemplate(scheduleID: $scheduleID)"`
+}
+
+func (client *Client) GetHandoffNotesTemplate(ctx context.Context, scheduleID int) (*HandoffNotesTemplateQueryStruct, error) {
+	var m HandoffNotesTemplateQueryStruct
+
+	variables := map[string]interface{}{
+		"scheduleID": scheduleID,
+	}
+
+	return GraphQLRequest[HandoffNotesTemplateQueryStruct]("query", client, ctx, &m, variables)
+}
+
+func (client *Client) UpdateHandoffNotesTemplate(ctx context.Context, scheduleID int, payload HandoffNotesTemplateInput) (*UpdateHandoffNotesTemplateMutateStruct, error) {
+	var m UpdateHandoffNotesTemplateMutateStruct
+
+	variables := map[string]interface{}{
+		"input":      payload,
+		"scheduleID": scheduleID,
+	}
+
+	return GraphQLRequest[UpdateHandoffNotesTemplateMutateStruct]("mutate", client, ctx, &m, variables)
+}
+
+func (client *Client) DeleteHandoffNotesTemplate(ctx context.Context, scheduleID int) (*DeleteHandoffNotesTemplateMutateStruct, error) {
+	var m DeleteHandoffNotesTemplateMutateStruct
+
+	variables := map[string]interface{}{
+		"scheduleID": scheduleID,
+	}
+
+	return GraphQLRequest[DeleteHandoffNotesTemplateMutateStruct]("mutate", client, ctx, &m, variables)
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// Heartbeat is a dead man's switch of a service: an incident is triggered on the service when the heartbeat URL is
+// not pinged within the interval and the grace period.
+type Heartbeat struct {
+	ID          string `json:"id" tf:"id"`
+	ServiceID   string `json:"service_id" tf:"service_id"`
+	Name        string `json:"name" tf:"name"`
+	Description string `json:"description" tf:"description"`
+	Interval    int    `json:"interval" tf:"interval"`
+	GracePeriod int    `json:"grace_period" tf:"grace_period"`
+	Enabled     bool   `json:"enabled" tf:"enabled"`
+	URL         string `json:"url" tf:"url"`
+	Status      string `json:"status" tf:"status"`
+}
+
+func (h *Heartbeat) Encode() (tf.M, error) {
+	return tf.Encode(h)
+}
+
+func (client *Client) GetHeartbeatById(ctx context.Context, id string) (*Heartbeat, error) {
+	url := fmt.Sprintf("%s/heartbeats/%s", client.BaseURLV3, id)
+
+	return Request[any, Heartbeat](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetHeartbeatByName(ctx context.Context, serviceID string, name string) (*Heartbeat, error) {
+	heartbeats, err := client.ListHeartbeats(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range heartbeats {
+		if h.Name == name {
+			return h, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a heartbeat with name `%s`", name)
+}
+
+func (client *Client) ListHeartbeats(ctx context.Context, serviceID string) ([]*Heartbeat, error) {
+	url := fmt.Sprintf("%s/heartbeats?service_id=%s", client.BaseURLV3, serviceID)
+
+	return RequestSlice[any, Heartbeat](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateHeartbeatReq struct {
+	ServiceID   string `json:"service_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Interval    int    `json:"interval"`
+	GracePeriod int    `json:"grace_period"`
+	Enabled     bool   `json:"enabled"`
+}
+
+func (client *Client) CreateHeartbeat(ctx context.Context, req *CreateUpdateHeartbeatReq) (*Heartbeat, error) {
+	url := fmt.Sprintf("%s/heartbeats", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*Heartbeat, error) {
+		return client.GetHeartbeatByName(ctx, req.ServiceID, req.Name)
+	})
+}
+
+func (client *Client) UpdateHeartbeat(ctx context.Context, id string, req *CreateUpdateHeartbeatReq) (*Heartbeat, error) {
+	url := fmt.Sprintf("%s/heartbeats/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateHeartbeatReq, Heartbeat](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteHeartbeat(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/heartbeats/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
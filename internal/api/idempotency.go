@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// IdempotencyKeyHeader is sent with the creation requests, so that a creation retried after its response was lost
+// is not applied twice by the API.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// createRetries is the number of times a creation request is retried after a network error or a gateway error.
+const createRetries = 2
+
+// createRetryDelay is the delay before the first retry of a creation request, it grows with every retry.
+var createRetryDelay = 500 * time.Millisecond
+
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// createIdempotently sends a creation request with an idempotency key and retries it with the same key after
+// network errors and gateway errors, whose request may have reached the API. Before each retry, the object created
+// by a previous attempt is looked up with lookup, e.g. by name, in case the API does not honor the idempotency key.
+func createIdempotently[TReq any, TRes any](ctx context.Context, client *Client, url string, payload *TReq, lookup func() (*TRes, error)) (*TRes, error) {
+	header := http.Header{}
+	if key := newIdempotencyKey(); key != "" {
+		header.Set(IdempotencyKeyHeader, key)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && lookup != nil {
+			if existing, err := lookup(); err == nil && existing != nil {
+				return existing, nil
+			}
+		}
+
+		data, _, err := RequestWithHeaders[TReq, TRes](http.MethodPost, url, client, ctx, payload, header)
+		if err == nil || attempt == createRetries || !isRetryableCreateError(ctx, err) {
+			return data, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(createRetryDelay * time.Duration(attempt+1)):
+		}
+	}
+}
+
+// isRetryableCreateError returns whether a creation request failed in a way that it may have been applied or that
+// is transient, i.e. a network error or a gateway error.
+func isRetryableCreateError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch ErrorStatusCode(err) {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
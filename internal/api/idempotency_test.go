@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateIdempotently(t *testing.T) {
+	defer func(delay time.Duration) { createRetryDelay = delay }(createRetryDelay)
+	createRetryDelay = time.Millisecond
+
+	tests := []struct {
+		name     string
+		statuses []int
+		existing *Squad
+		keys     int
+		lookups  int
+		wantErr  bool
+	}{
+		{name: "created", statuses: []int{http.StatusOK}, keys: 1},
+		{name: "retried after a gateway error", statuses: []int{http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusOK}, keys: 3, lookups: 2},
+		{name: "found by the lookup", statuses: []int{http.StatusGatewayTimeout}, existing: &Squad{ID: "61305a8eb7a2fa0e44cfd0f5"}, keys: 1, lookups: 1},
+		{name: "retries exhausted", statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}, keys: 3, lookups: 2, wantErr: true},
+		{name: "not retried after a client error", statuses: []int{http.StatusUnprocessableEntity}, keys: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keys []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+				if status := tt.statuses[len(keys)-1]; status != http.StatusOK {
+					w.WriteHeader(status)
+					return
+				}
+				w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+			}))
+			defer server.Close()
+
+			client := &Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+			lookups := 0
+			squad, err := createIdempotently[CreateSquadReq, Squad](context.Background(), client, server.URL+"/v3/squads", &CreateSquadReq{Name: "sre"}, func() (*Squad, error) {
+				lookups++
+				if tt.existing == nil {
+					return nil, &Error{StatusCode: http.StatusNotFound}
+				}
+				return tt.existing, nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !tt.wantErr && squad.ID != "61305a8eb7a2fa0e44cfd0f5" {
+				t.Errorf("expected the created squad, got %#v", squad)
+			}
+
+			if len(keys) != tt.keys || lookups != tt.lookups {
+				t.Errorf("expected %d requests and %d lookups, got %d requests and %d lookups", tt.keys, tt.lookups, len(keys), lookups)
+			}
+			for _, key := range keys {
+				if key == "" || key != keys[0] {
+					t.Errorf("expected every attempt to send the same idempotency key, got %q", keys)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type IncidentChatTranscriptExport struct {
+	OwnerID        string   `json:"owner_id" tf:"team_id"`
+	Enabled        bool     `json:"enabled" tf:"enabled"`
+	Sources        []string `json:"sources" tf:"sources"`
+	Destination    string   `json:"destination" tf:"destination"`
+	DestinationURL string   `json:"destination_url" tf:"destination_url"`
+	RetentionDays  int      `json:"retention_days" tf:"retention_days"`
+}
+
+func (e *IncidentChatTranscriptExport) Encode() (tf.M, error) {
+	return tf.Encode(e)
+}
+
+func (client *Client) GetIncidentChatTranscriptExport(ctx context.Context, teamID string) (*IncidentChatTranscriptExport, error) {
+	url := fmt.Sprintf("%s/teams/%s/incident-chat-transcript-export", client.BaseURLV3, teamID)
+
+	return Request[any, IncidentChatTranscriptExport](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateIncidentChatTranscriptExportReq struct {
+	Enabled        bool     `json:"enabled"`
+	Sources        []string `json:"sources"`
+	Destination    string   `json:"destination"`
+	DestinationURL string   `json:"destination_url,omitempty"`
+	RetentionDays  int      `json:"retention_days"`
+}
+
+func (client *Client) UpdateIncidentChatTranscriptExport(ctx context.Context, teamID string, req *UpdateIncidentChatTranscriptExportReq) (*IncidentChatTranscriptExport, error) {
+	url := fmt.Sprintf("%s/teams/%s/incident-chat-transcript-export", client.BaseURLV3, teamID)
+
+	return Request[UpdateIncidentChatTranscriptExportReq, IncidentChatTranscriptExport](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteIncidentChatTranscriptExport stops exporting the chat transcripts of the incidents of the team.
+func (client *Client) DeleteIncidentChatTranscriptExport(ctx context.Context, teamID string) (*any, error) {
+	url := fmt.Sprintf("%s/teams/%s/incident-chat-transcript-export", client.BaseURLV3, teamID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
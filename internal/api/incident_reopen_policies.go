@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type IncidentReopenPolicy struct {
+	ServiceID     string `json:"service_id" tf:"service_id"`
+	Enabled       bool   `json:"enabled" tf:"enabled"`
+	WindowMinutes int    `json:"window_minutes" tf:"window_minutes"`
+}
+
+func (p *IncidentReopenPolicy) Encode() (tf.M, error) {
+	return tf.Encode(p)
+}
+
+func (client *Client) GetIncidentReopenPolicy(ctx context.Context, serviceID string) (*IncidentReopenPolicy, error) {
+	url := fmt.Sprintf("%s/services/%s/incident-reopen-policy", client.BaseURLV3, serviceID)
+
+	return Request[any, IncidentReopenPolicy](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateIncidentReopenPolicyReq struct {
+	Enabled       bool `json:"enabled"`
+	WindowMinutes int  `json:"window_minutes"`
+}
+
+func (client *Client) UpdateIncidentReopenPolicy(ctx context.Context, serviceID string, req *UpdateIncidentReopenPolicyReq) (*IncidentReopenPolicy, error) {
+	url := fmt.Sprintf("%s/services/%s/incident-reopen-policy", client.BaseURLV3, serviceID)
+
+	return Request[UpdateIncidentReopenPolicyReq, IncidentReopenPolicy](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteIncidentReopenPolicy resets the reopen policy of the service to the default of the organization.
+func (client *Client) DeleteIncidentReopenPolicy(ctx context.Context, serviceID string) (*any, error) {
+	url := fmt.Sprintf("%s/services/%s/incident-reopen-policy", client.BaseURLV3, serviceID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
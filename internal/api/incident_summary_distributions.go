@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type IncidentSummaryAudience struct {
+	Type string `json:"type" tf:"type"`
+	ID   string `json:"id" tf:"id"`
+}
+
+func (a *IncidentSummaryAudience) Encode() (tf.M, error) {
+	return tf.Encode(a)
+}
+
+type IncidentSummaryDistribution struct {
+	ID           string                     `json:"id" tf:"id"`
+	Name         string                     `json:"name" tf:"name"`
+	OwnerID      string                     `json:"owner_id" tf:"team_id"`
+	ServiceIDs   []string                   `json:"service_ids" tf:"service_ids"`
+	Audience     []*IncidentSummaryAudience `json:"audience" tf:"-"`
+	Subject      string                     `json:"subject" tf:"subject"`
+	Template     string                     `json:"template" tf:"template"`
+	DelayMinutes int                        `json:"delay_minutes" tf:"delay_minutes"`
+	Enabled      bool                       `json:"enabled" tf:"enabled"`
+}
+
+func (isd *IncidentSummaryDistribution) Encode() (tf.M, error) {
+	m, err := tf.Encode(isd)
+	if err != nil {
+		return nil, err
+	}
+
+	audience, err := tf.EncodeSlice(isd.Audience)
+	if err != nil {
+		return nil, err
+	}
+	m["audience"] = audience
+
+	return m, nil
+}
+
+func (client *Client) GetIncidentSummaryDistributionById(ctx context.Context, teamID string, id string) (*IncidentSummaryDistribution, error) {
+	url := fmt.Sprintf("%s/incident-summary-distributions/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, IncidentSummaryDistribution](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetIncidentSummaryDistributionByName(ctx context.Context, teamID string, name string) (*IncidentSummaryDistribution, error) {
+	distributions, err := client.ListIncidentSummaryDistributions(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range distributions {
+		if d.Name == name {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find an incident summary distribution with name `%s`", name)
+}
+
+func (client *Client) ListIncidentSummaryDistributions(ctx context.Context, teamID string) ([]*IncidentSummaryDistribution, error) {
+	url := fmt.Sprintf("%s/incident-summary-distributions?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, IncidentSummaryDistribution](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateIncidentSummaryDistributionReq struct {
+	Name         string                     `json:"name"`
+	TeamID       string                     `json:"owner_id"`
+	ServiceIDs   []string                   `json:"service_ids"`
+	Audience     []*IncidentSummaryAudience `json:"audience"`
+	Subject      string                     `json:"subject"`
+	Template     string                     `json:"template"`
+	DelayMinutes int                        `json:"delay_minutes"`
+	Enabled      bool                       `json:"enabled"`
+}
+
+func (client *Client) CreateIncidentSummaryDistribution(ctx context.Context, req *CreateUpdateIncidentSummaryDistributionReq) (*IncidentSummaryDistribution, error) {
+	url := fmt.Sprintf("%s/incident-summary-distributions", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*IncidentSummaryDistribution, error) {
+		return client.GetIncidentSummaryDistributionByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdateIncidentSummaryDistribution(ctx context.Context, id string, req *CreateUpdateIncidentSummaryDistributionReq) (*IncidentSummaryDistribution, error) {
+	url := fmt.Sprintf("%s/incident-summary-distributions/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateIncidentSummaryDistributionReq, IncidentSummaryDistribution](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteIncidentSummaryDistribution(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/incident-summary-distributions/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
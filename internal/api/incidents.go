@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type Incident struct {
+	ID          string            `json:"id" tf:"id"`
+	TeamID      string            `json:"owner_id" tf:"-"`
+	ServiceID   string            `json:"service_id" tf:"service_id"`
+	Message     string            `json:"message" tf:"message"`
+	Description string            `json:"description" tf:"description"`
+	Status      string            `json:"status" tf:"status"`
+	Tags        map[string]string `json:"tags" tf:"tags"`
+	IsTest      bool              `json:"is_test" tf:"-"`
+	CreatedAt   string            `json:"created_at" tf:"created_at"`
+	ResolvedAt  string            `json:"resolved_at" tf:"resolved_at"`
+}
+
+func (incident *Incident) Encode() (tf.M, error) {
+	m, err := tf.Encode(incident)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := tf.M{}
+	for key, value := range incident.Tags {
+		tags[key] = value
+	}
+	m["tags"] = tags
+
+	return m, nil
+}
+
+// IncidentFilter filters the incidents of a team, the zero values do not filter.
+type IncidentFilter struct {
+	ServiceIDs []string
+	Statuses   []string
+	// StartTime and EndTime bound the creation time of the incidents, in RFC 3339.
+	StartTime string
+	EndTime   string
+	Tags      map[string]string
+	Limit     int
+}
+
+func (filter *IncidentFilter) query(teamID string) url.Values {
+	query := url.Values{}
+	query.Set("owner_id", teamID)
+	for _, serviceID := range filter.ServiceIDs {
+		query.Add("service_id", serviceID)
+	}
+	for _, status := range filter.Statuses {
+		query.Add("status", status)
+	}
+	if filter.StartTime != "" {
+		query.Set("start_time", filter.StartTime)
+	}
+	if filter.EndTime != "" {
+		query.Set("end_time", filter.EndTime)
+	}
+
+	keys := make([]string, 0, len(filter.Tags))
+	for key := range filter.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		query.Add("tags", key+":"+filter.Tags[key])
+	}
+
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+
+	return query
+}
+
+func (client *Client) ListIncidents(ctx context.Context, teamID string, filter *IncidentFilter) ([]*Incident, error) {
+	url := fmt.Sprintf("%s/incidents?%s", client.BaseURLV3, filter.query(teamID).Encode())
+
+	return RequestSlice[any, Incident](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetIncidentById(ctx context.Context, id string) (*Incident, error) {
+	url := fmt.Sprintf("%s/incidents/%s", client.BaseURLV3, id)
+
+	return Request[any, Incident](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateTestIncidentReq struct {
+	TeamID      string            `json:"owner_id"`
+	ServiceID   string            `json:"service_id"`
+	Message     string            `json:"message"`
+	Description string            `json:"description"`
+	Tags        map[string]string `json:"tags"`
+	IsTest      bool              `json:"is_test"`
+}
+
+// CreateTestIncident triggers an incident on a service that is marked as a test incident, it escalates like any
+// other incident of the service.
+func (client *Client) CreateTestIncident(ctx context.Context, req *CreateTestIncidentReq) (*Incident, error) {
+	url := fmt.Sprintf("%s/incidents", client.BaseURLV3)
+	req.IsTest = true
+
+	return createIdempotently[CreateTestIncidentReq, Incident](ctx, client, url, req, nil)
+}
+
+type ResolveIncidentReq struct {
+	ResolutionReason string `json:"resolution_reason"`
+}
+
+func (client *Client) ResolveIncident(ctx context.Context, id string, req *ResolveIncidentReq) (*any, error) {
+	url := fmt.Sprintf("%s/incidents/%s/resolve", client.BaseURLV3, id)
+
+	return Request[ResolveIncidentReq, any](http.MethodPost, url, client, ctx, req)
+}
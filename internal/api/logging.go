@@ -0,0 +1,152 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// RequestIDHeader is the header of the responses of the API with the id of the request, to be quoted to the support.
+const RequestIDHeader = "X-Request-Id"
+
+// maxLoggedBodySize is the size after which the logged bodies are truncated.
+const maxLoggedBodySize = 16 << 10
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers whose values are redacted from the logs.
+var sensitiveHeaders = []string{"Authorization", "X-Refresh-Token", "Cookie", "Set-Cookie"}
+
+// isSensitiveField reports whether the values of a JSON field are redacted from the logs, e.g. access_token,
+// client_secret or password.
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range []string{"token", "secret", "password"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactBody returns the body with the values of the sensitive fields redacted if it is JSON, or as is otherwise.
+func redactBody(body []byte) string {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return truncateBody(string(body))
+	}
+
+	var redact func(v any) any
+	redact = func(v any) any {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, e := range v {
+				if _, ok := e.(string); ok && isSensitiveField(k) {
+					v[k] = redacted
+				} else {
+					v[k] = redact(e)
+				}
+			}
+		case []any:
+			for i, e := range v {
+				v[i] = redact(e)
+			}
+		}
+		return v
+	}
+
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return redacted
+	}
+
+	return truncateBody(string(b))
+}
+
+func truncateBody(body string) string {
+	if len(body) > maxLoggedBodySize {
+		return body[:maxLoggedBodySize] + "... (truncated)"
+	}
+
+	return body
+}
+
+func redactHeader(header http.Header, extraSensitiveHeaders []string) map[string]string {
+	m := make(map[string]string, len(header))
+	for k := range header {
+		m[k] = header.Get(k)
+	}
+	for _, k := range append(sensitiveHeaders, extraSensitiveHeaders...) {
+		if _, ok := m[http.CanonicalHeaderKey(k)]; ok {
+			m[http.CanonicalHeaderKey(k)] = redacted
+		}
+	}
+
+	return m
+}
+
+// loggingTransport logs the requests to the API and their responses at the debug level, i.e. with TF_LOG=DEBUG, with
+// the credentials redacted.
+type loggingTransport struct {
+	base http.RoundTripper
+
+	// sensitiveHeaders are redacted in addition to the credentials of the API, e.g. the custom headers of a gateway.
+	sensitiveHeaders []string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := tf.M{
+		"method":          req.Method,
+		"url":             req.URL.Redacted(),
+		"request_headers": redactHeader(req.Header, t.sensitiveHeaders),
+	}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			if req.Header.Get("Content-Encoding") == "" {
+				fields["request_body"] = redactBody(b)
+			} else {
+				fields["request_body"] = fmt.Sprintf("(%s, %d bytes)", req.Header.Get("Content-Encoding"), len(b))
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Squadcast API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["request_id"] = resp.Header.Get(RequestIDHeader)
+	fields["response_headers"] = redactHeader(resp.Header, nil)
+
+	// The body is read to be logged, and replaced for the caller.
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Squadcast API request failed", fields)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	fields["response_body"] = redactBody(b)
+
+	tflog.Debug(ctx, "Squadcast API request", fields)
+
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-123")
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre","webhook_token":"wh-secret"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"meta":{"status":422,"error_message":"invalid squad"}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "sa-secret"}
+
+	if _, err := Request[any, Squad](http.MethodGet, server.URL+"/v3/squads/61305a8eb7a2fa0e44cfd0f5", client, ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Request[CreateSquadReq, Squad](http.MethodPost, server.URL+"/v3/squads", client, ctx, &CreateSquadReq{Name: "sre"})
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "req-123" {
+		t.Fatalf("expected an API error with the request id, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "request id: req-123") {
+		t.Errorf("expected the request id in the error, got %q", err)
+	}
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected a log entry per request, got %d", len(entries))
+	}
+	for i, want := range []struct {
+		method string
+		status float64
+	}{{http.MethodGet, 200}, {http.MethodPost, 422}} {
+		entry := entries[i]
+		if entry["@level"] != "debug" || entry["method"] != want.method || entry["status"] != want.status || entry["request_id"] != "req-123" {
+			t.Errorf("unexpected log entry %v", entry)
+		}
+		if _, ok := entry["duration_ms"]; !ok {
+			t.Errorf("expected the duration of the request to be logged, got %v", entry)
+		}
+	}
+	if body, _ := entries[1]["request_body"].(string); !strings.Contains(body, `"name":"sre"`) {
+		t.Errorf("expected the request body to be logged, got %q", body)
+	}
+
+	if !strings.Contains(logs, "[REDACTED]") || strings.Contains(logs, "sa-secret") || strings.Contains(logs, "wh-secret") {
+		t.Errorf("expected the credentials to be redacted, got %s", logs)
+	}
+}
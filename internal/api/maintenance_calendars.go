@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type MaintenanceCalendarWindow struct {
+	From            string `json:"from" tf:"from"`
+	Till            string `json:"till" tf:"till"`
+	RepeatFrequency string `json:"repeat_frequency" tf:"repeat_frequency"`
+	RepeatTill      string `json:"repeat_till" tf:"repeat_till"`
+}
+
+func (w MaintenanceCalendarWindow) Encode() (tf.M, error) {
+	return tf.Encode(w)
+}
+
+// MaintenanceCalendar is an organization wide freeze window, during which only the incidents with one of the
+// allowed priorities notify, on every service but the excluded ones.
+type MaintenanceCalendar struct {
+	ID                 string                       `json:"id" tf:"id"`
+	Name               string                       `json:"name" tf:"name"`
+	Description        string                       `json:"description" tf:"description"`
+	Windows            []*MaintenanceCalendarWindow `json:"windows" tf:"-"`
+	AllowedPriorities  []string                     `json:"allowed_priorities" tf:"allowed_priorities"`
+	ExcludedServiceIDs []string                     `json:"excluded_service_ids" tf:"excluded_service_ids"`
+}
+
+func (c *MaintenanceCalendar) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := tf.EncodeSlice(c.Windows)
+	if err != nil {
+		return nil, err
+	}
+	m["window"] = windows
+
+	return m, nil
+}
+
+func (client *Client) GetMaintenanceCalendarById(ctx context.Context, id string) (*MaintenanceCalendar, error) {
+	url := fmt.Sprintf("%s/maintenance-calendars/%s", client.BaseURLV3, id)
+
+	return Request[any, MaintenanceCalendar](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetMaintenanceCalendarByName(ctx context.Context, name string) (*MaintenanceCalendar, error) {
+	calendars, err := client.ListMaintenanceCalendars(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range calendars {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a maintenance calendar with name `%s`", name)
+}
+
+func (client *Client) ListMaintenanceCalendars(ctx context.Context) ([]*MaintenanceCalendar, error) {
+	url := fmt.Sprintf("%s/maintenance-calendars", client.BaseURLV3)
+
+	return RequestSlice[any, MaintenanceCalendar](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateMaintenanceCalendarReq struct {
+	Name               string                      `json:"name"`
+	Description        string                      `json:"description"`
+	Windows            []MaintenanceCalendarWindow `json:"windows"`
+	AllowedPriorities  []string                    `json:"allowed_priorities"`
+	ExcludedServiceIDs []string                    `json:"excluded_service_ids"`
+}
+
+func (client *Client) CreateMaintenanceCalendar(ctx context.Context, req *CreateUpdateMaintenanceCalendarReq) (*MaintenanceCalendar, error) {
+	url := fmt.Sprintf("%s/maintenance-calendars", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*MaintenanceCalendar, error) {
+		return client.GetMaintenanceCalendarByName(ctx, req.Name)
+	})
+}
+
+func (client *Client) UpdateMaintenanceCalendar(ctx context.Context, id string, req *CreateUpdateMaintenanceCalendarReq) (*MaintenanceCalendar, error) {
+	url := fmt.Sprintf("%s/maintenance-calendars/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateMaintenanceCalendarReq, MaintenanceCalendar](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteMaintenanceCalendar(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/maintenance-calendars/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,16 @@
+package api
+
+import "strings"
+
+// FilterByNamePrefix returns the items whose name starts with prefix, e.g. to find the objects left behind by
+// acceptance tests, which are all named with a common prefix.
+func FilterByNamePrefix[T any](items []T, prefix string, name func(T) string) []T {
+	var filtered []T
+	for _, item := range items {
+		if strings.HasPrefix(name(item), prefix) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
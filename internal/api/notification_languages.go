@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type UserNotificationLanguage struct {
+	UserID   string `json:"user_id" tf:"user_id"`
+	Language string `json:"language" tf:"language"`
+}
+
+func (l *UserNotificationLanguage) Encode() (tf.M, error) {
+	return tf.Encode(l)
+}
+
+type NotificationLanguage struct {
+	OwnerID       string                      `json:"owner_id" tf:"-"`
+	Language      string                      `json:"language" tf:"language"`
+	UserOverrides []*UserNotificationLanguage `json:"user_overrides" tf:"-"`
+}
+
+func (l *NotificationLanguage) Encode() (tf.M, error) {
+	m, err := tf.Encode(l)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := tf.EncodeSlice(l.UserOverrides)
+	if err != nil {
+		return nil, err
+	}
+	m["user_overrides"] = overrides
+
+	return m, nil
+}
+
+// notificationLanguageURL returns the url of the notification language of the team, or of the organization if teamID is empty.
+func notificationLanguageURL(client *Client, teamID string) string {
+	if teamID == "" {
+		return fmt.Sprintf("%s/organization/notification-language", client.BaseURLV3)
+	}
+
+	return fmt.Sprintf("%s/teams/%s/notification-language", client.BaseURLV3, teamID)
+}
+
+func (client *Client) GetNotificationLanguage(ctx context.Context, teamID string) (*NotificationLanguage, error) {
+	return Request[any, NotificationLanguage](http.MethodGet, notificationLanguageURL(client, teamID), client, ctx, nil)
+}
+
+type UpdateNotificationLanguageReq struct {
+	Language      string                     `json:"language"`
+	UserOverrides []UserNotificationLanguage `json:"user_overrides"`
+}
+
+func (client *Client) UpdateNotificationLanguage(ctx context.Context, teamID string, req *UpdateNotificationLanguageReq) (*NotificationLanguage, error) {
+	return Request[UpdateNotificationLanguageReq, NotificationLanguage](http.MethodPut, notificationLanguageURL(client, teamID), client, ctx, req)
+}
+
+// DeleteNotificationLanguage resets the notification language, a team then falls back to the organization default and the organization to English.
+func (client *Client) DeleteNotificationLanguage(ctx context.Context, teamID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, notificationLanguageURL(client, teamID), client, ctx, nil)
+}
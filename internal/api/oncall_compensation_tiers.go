@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// OncallCompensationTier maps the on-call shifts of a schedule, or of some of its rotations, to a pay rate of the
+// on-call analytics payroll export.
+type OncallCompensationTier struct {
+	ID             string  `json:"id" tf:"id"`
+	Name           string  `json:"name" tf:"name"`
+	OwnerID        string  `json:"owner_id" tf:"team_id"`
+	Description    string  `json:"description" tf:"description"`
+	Type           string  `json:"type" tf:"type"`
+	ScheduleID     int     `json:"schedule_id" tf:"-"`
+	RotationIDs    []int   `json:"rotation_ids" tf:"-"`
+	StartTime      string  `json:"start_time" tf:"start_time"`
+	EndTime        string  `json:"end_time" tf:"end_time"`
+	RateMultiplier float64 `json:"rate_multiplier" tf:"rate_multiplier"`
+}
+
+func (t *OncallCompensationTier) Encode() (tf.M, error) {
+	m, err := tf.Encode(t)
+	if err != nil {
+		return nil, err
+	}
+
+	m["schedule_id"] = strconv.Itoa(t.ScheduleID)
+
+	rotationIDs := make([]string, len(t.RotationIDs))
+	for i, id := range t.RotationIDs {
+		rotationIDs[i] = strconv.Itoa(id)
+	}
+	m["rotation_ids"] = rotationIDs
+
+	return m, nil
+}
+
+func (client *Client) GetOncallCompensationTierById(ctx context.Context, teamID string, id string) (*OncallCompensationTier, error) {
+	url := fmt.Sprintf("%s/analytics/oncall-compensation-tiers/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, OncallCompensationTier](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetOncallCompensationTierByName(ctx context.Context, teamID string, name string) (*OncallCompensationTier, error) {
+	tiers, err := client.ListOncallCompensationTiers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tiers {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find an on-call compensation tier with name `%s`", name)
+}
+
+func (client *Client) ListOncallCompensationTiers(ctx context.Context, teamID string) ([]*OncallCompensationTier, error) {
+	url := fmt.Sprintf("%s/analytics/oncall-compensation-tiers?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, OncallCompensationTier](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateOncallCompensationTierReq struct {
+	Name           string  `json:"name"`
+	TeamID         string  `json:"owner_id"`
+	Description    string  `json:"description"`
+	Type           string  `json:"type"`
+	ScheduleID     int     `json:"schedule_id"`
+	RotationIDs    []int   `json:"rotation_ids"`
+	StartTime      string  `json:"start_time,omitempty"`
+	EndTime        string  `json:"end_time,omitempty"`
+	RateMultiplier float64 `json:"rate_multiplier"`
+}
+
+func (client *Client) CreateOncallCompensationTier(ctx context.Context, req *CreateUpdateOncallCompensationTierReq) (*OncallCompensationTier, error) {
+	url := fmt.Sprintf("%s/analytics/oncall-compensation-tiers", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*OncallCompensationTier, error) {
+		return client.GetOncallCompensationTierByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdateOncallCompensationTier(ctx context.Context, id string, req *CreateUpdateOncallCompensationTierReq) (*OncallCompensationTier, error) {
+	url := fmt.Sprintf("%s/analytics/oncall-compensation-tiers/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateOncallCompensationTierReq, OncallCompensationTier](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteOncallCompensationTier(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/analytics/oncall-compensation-tiers/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
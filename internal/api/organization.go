@@ -17,3 +17,24 @@ func (client *Client) GetCurrentOrganization(ctx context.Context) (*Organization
 
 	return Request[any, Organization](http.MethodGet, url, client, ctx, nil)
 }
+
+type OrganizationPlan struct {
+	Name     string                   `json:"plan_name"`
+	Features OrganizationPlanFeatures `json:"features"`
+}
+
+type OrganizationPlanFeatures struct {
+	// SMS and Phone are whether the notification channels can be used in escalation policies, nil when the plan
+	// does not restrict them.
+	SMS   *bool `json:"sms"`
+	Phone *bool `json:"phone"`
+	// ScheduleTeamParticipants is whether teams can be participants of the schedule rotations, nil when the plan
+	// does not restrict them.
+	ScheduleTeamParticipants *bool `json:"schedule_team_participants"`
+}
+
+func (client *Client) GetCurrentOrganizationPlan(ctx context.Context) (*OrganizationPlan, error) {
+	url := fmt.Sprintf("%s/organization/plan", client.BaseURLV3)
+
+	return Request[any, OrganizationPlan](http.MethodGet, url, client, ctx, nil)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type PostmortemTemplate struct {
+	ID          string `json:"id" tf:"id"`
+	TeamID      string `json:"owner_id" tf:"team_id"`
+	Name        string `json:"name" tf:"name"`
+	Description string `json:"description" tf:"description"`
+	Template    string `json:"template" tf:"body"`
+	IsDefault   bool   `json:"is_default" tf:"is_default"`
+}
+
+func (pt *PostmortemTemplate) Encode() (tf.M, error) {
+	return tf.Encode(pt)
+}
+
+func (client *Client) GetPostmortemTemplateById(ctx context.Context, teamID string, id string) (*PostmortemTemplate, error) {
+	url := fmt.Sprintf("%s/postmortem-templates/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, PostmortemTemplate](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetPostmortemTemplateByName(ctx context.Context, teamID string, name string) (*PostmortemTemplate, error) {
+	templates, err := client.ListPostmortemTemplates(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a postmortem template with name `%s`", name)
+}
+
+func (client *Client) ListPostmortemTemplates(ctx context.Context, teamID string) ([]*PostmortemTemplate, error) {
+	url := fmt.Sprintf("%s/postmortem-templates?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, PostmortemTemplate](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdatePostmortemTemplateReq struct {
+	TeamID      string `json:"owner_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Template    string `json:"template"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+func (client *Client) CreatePostmortemTemplate(ctx context.Context, req *CreateUpdatePostmortemTemplateReq) (*PostmortemTemplate, error) {
+	url := fmt.Sprintf("%s/postmortem-templates", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*PostmortemTemplate, error) {
+		return client.GetPostmortemTemplateByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdatePostmortemTemplate(ctx context.Context, id string, req *CreateUpdatePostmortemTemplateReq) (*PostmortemTemplate, error) {
+	url := fmt.Sprintf("%s/postmortem-templates/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdatePostmortemTemplateReq, PostmortemTemplate](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeletePostmortemTemplate(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/postmortem-templates/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// ResponsePlayResponder is a user, squad or escalation policy added as responder to the incidents a response play is
+// run on.
+type ResponsePlayResponder struct {
+	Type string `json:"type" tf:"type"`
+	ID   string `json:"id" tf:"id"`
+}
+
+func (r *ResponsePlayResponder) Encode() (tf.M, error) {
+	return tf.Encode(r)
+}
+
+// ResponsePlayChannel is a communication channel attached to the incidents a response play is run on, e.g. the video
+// conference of the war room.
+type ResponsePlayChannel struct {
+	Type string `json:"type" tf:"type"`
+	Name string `json:"name" tf:"name"`
+	URL  string `json:"url" tf:"url"`
+}
+
+func (c *ResponsePlayChannel) Encode() (tf.M, error) {
+	return tf.Encode(c)
+}
+
+type ResponsePlay struct {
+	ID          string                   `json:"id" tf:"id"`
+	TeamID      string                   `json:"owner_id" tf:"team_id"`
+	Name        string                   `json:"name" tf:"name"`
+	Description string                   `json:"description" tf:"description"`
+	Priority    string                   `json:"priority" tf:"priority"`
+	Responders  []*ResponsePlayResponder `json:"responders" tf:"-"`
+	RunbookIDs  []string                 `json:"runbook_ids" tf:"runbook_ids"`
+	Channels    []*ResponsePlayChannel   `json:"communication_channels" tf:"-"`
+	Tags        map[string]string        `json:"tags" tf:"tags"`
+}
+
+func (rp *ResponsePlay) Encode() (tf.M, error) {
+	m, err := tf.Encode(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	responders, err := tf.EncodeSlice(rp.Responders)
+	if err != nil {
+		return nil, err
+	}
+	m["responders"] = responders
+
+	channels, err := tf.EncodeSlice(rp.Channels)
+	if err != nil {
+		return nil, err
+	}
+	m["communication_channels"] = channels
+
+	return m, nil
+}
+
+func (client *Client) GetResponsePlayById(ctx context.Context, teamID string, id string) (*ResponsePlay, error) {
+	url := fmt.Sprintf("%s/response-plays/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, ResponsePlay](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetResponsePlayByName(ctx context.Context, teamID string, name string) (*ResponsePlay, error) {
+	responsePlays, err := client.ListResponsePlays(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rp := range responsePlays {
+		if rp.Name == name {
+			return rp, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a response play with name `%s`", name)
+}
+
+func (client *Client) ListResponsePlays(ctx context.Context, teamID string) ([]*ResponsePlay, error) {
+	url := fmt.Sprintf("%s/response-plays?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, ResponsePlay](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateResponsePlayReq struct {
+	TeamID      string                  `json:"owner_id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Priority    string                  `json:"priority"`
+	Responders  []ResponsePlayResponder `json:"responders"`
+	RunbookIDs  []string                `json:"runbook_ids"`
+	Channels    []ResponsePlayChannel   `json:"communication_channels"`
+	Tags        map[string]string       `json:"tags"`
+}
+
+func (client *Client) CreateResponsePlay(ctx context.Context, req *CreateUpdateResponsePlayReq) (*ResponsePlay, error) {
+	url := fmt.Sprintf("%s/response-plays", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*ResponsePlay, error) {
+		return client.GetResponsePlayByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdateResponsePlay(ctx context.Context, id string, req *CreateUpdateResponsePlayReq) (*ResponsePlay, error) {
+	url := fmt.Sprintf("%s/response-plays/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateResponsePlayReq, ResponsePlay](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteResponsePlay(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/response-plays/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -21,6 +21,7 @@ type NewRotation struct {
 	ChangeParticipantsUnit      string             `graphql:"changeParticipantsUnit" json:"changeParticipantsUnit" tf:"change_participants_unit"`
 	EndDate                     string             `graphql:"endDate" json:"endDate,omitempty" tf:"end_date"`
 	EndsAfterIterations         int                `graphql:"endsAfterIterations" json:"endsAfterIterations,omitempty" tf:"ends_after_iterations"`
+	Color                       string             `graphql:"color" json:"color,omitempty" tf:"color"`
 }
 
 type ParticipantGroup struct {
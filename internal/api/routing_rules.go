@@ -27,6 +27,7 @@ type RoutingRule struct {
 	Expression      string                  `json:"expression" tf:"expression"`
 	BasicExpression []*RoutingRuleCondition `json:"basic_expression" tf:"basic_expressions"`
 	RouteTo         RouteTo                 `json:"route_to" tf:"route_to,squash"`
+	Disabled        bool                    `json:"disabled,omitempty" tf:"disabled"`
 }
 
 func (r *RoutingRule) Encode() (tf.M, error) {
@@ -40,6 +41,7 @@ func (r *RoutingRule) Encode() (tf.M, error) {
 		return nil, err
 	}
 	m["basic_expressions"] = basicExpression
+	encodeEnabled(m)
 
 	return m, nil
 }
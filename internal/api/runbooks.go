@@ -90,7 +90,9 @@ type CreateUpdateRunbookReq struct {
 func (client *Client) CreateRunbook(ctx context.Context, req *CreateUpdateRunbookReq) (*Runbook, error) {
 	url := fmt.Sprintf("%s/runbooks", client.BaseURLV3)
 
-	return Request[CreateUpdateRunbookReq, Runbook](http.MethodPost, url, client, ctx, req)
+	return createIdempotently(ctx, client, url, req, func() (*Runbook, error) {
+		return client.GetRunbookByName(ctx, req.TeamID, req.Name)
+	})
 }
 
 func (client *Client) UpdateRunbook(ctx context.Context, id string, req *CreateUpdateRunbookReq) (*Runbook, error) {
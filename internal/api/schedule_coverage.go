@@ -0,0 +1,129 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+const minutesPerDay = 24 * 60
+const minutesPerWeek = 7 * minutesPerDay
+
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// CoverageInterval is a period of the simulated week, in minutes since monday 00:00 in the timezone of the schedule.
+// An interval may wrap around the end of the week, in which case End is lower than Start.
+type CoverageInterval struct {
+	Start int
+	End   int
+	// Rotations are the names of the rotations on-call during an overlap.
+	Rotations []string
+}
+
+func (i CoverageInterval) String() string {
+	format := func(minute int) string {
+		minute %= minutesPerWeek
+		return fmt.Sprintf("%s %02d:%02d", weekdays[minute/minutesPerDay], minute%minutesPerDay/60, minute%60)
+	}
+	s := fmt.Sprintf("%s - %s", format(i.Start), format(i.End))
+	if i.Start == i.End {
+		s = "the whole week"
+	}
+	if len(i.Rotations) > 0 {
+		s += fmt.Sprintf(" (%s)", strings.Join(i.Rotations, ", "))
+	}
+
+	return s
+}
+
+// ScheduleCoverage is the result of the simulation of a week of the rotations of a schedule.
+type ScheduleCoverage struct {
+	// Gaps are the periods no rotation is on-call in.
+	Gaps []CoverageInterval
+	// Overlaps are the periods two or more rotations are on-call in.
+	Overlaps []CoverageInterval
+}
+
+// SimulateScheduleWeek lays the shift timeslots of the rotations of a schedule on a week to find the gaps and the
+// overlaps between them. Timeslots without a day of week are repeated every day, and shifts may run into the next
+// day. Rotations without participants are not on-call, and the start and end dates of the rotations are ignored.
+func SimulateScheduleWeek(rotations []NewRotation) *ScheduleCoverage {
+	oncall := make([][]int, minutesPerWeek)
+	for r, rotation := range rotations {
+		if !hasParticipants(rotation) {
+			continue
+		}
+
+		for _, slot := range rotation.ShiftTimeSlots {
+			for day, weekday := range weekdays {
+				if slot.DayOfWeek != "" && !strings.EqualFold(slot.DayOfWeek, weekday) {
+					continue
+				}
+				start := day*minutesPerDay + slot.StartHour*60 + slot.StartMinute
+				for m := start; m < start+slot.Duration; m++ {
+					minute := m % minutesPerWeek
+					if n := len(oncall[minute]); n == 0 || oncall[minute][n-1] != r {
+						oncall[minute] = append(oncall[minute], r)
+					}
+				}
+			}
+		}
+	}
+
+	coverage := &ScheduleCoverage{
+		Gaps:     []CoverageInterval{},
+		Overlaps: []CoverageInterval{},
+	}
+	// Intervals are cut where the set of rotations on-call changes, starting from such a change so that an interval
+	// wrapping around the end of the week is not split in two.
+	first := 0
+	for m := 1; m < minutesPerWeek; m++ {
+		if !sameRotations(oncall[m], oncall[m-1]) {
+			first = m
+			break
+		}
+	}
+	start := first
+	for i := 1; i <= minutesPerWeek; i++ {
+		m := (first + i) % minutesPerWeek
+		if i < minutesPerWeek && sameRotations(oncall[m], oncall[start]) {
+			continue
+		}
+
+		switch n := len(oncall[start]); {
+		case n == 0:
+			coverage.Gaps = append(coverage.Gaps, CoverageInterval{Start: start, End: m})
+		case n > 1:
+			names := make([]string, n)
+			for j, r := range oncall[start] {
+				names[j] = rotations[r].Name
+			}
+			coverage.Overlaps = append(coverage.Overlaps, CoverageInterval{Start: start, End: m, Rotations: names})
+		}
+		start = m
+	}
+
+	return coverage
+}
+
+func hasParticipants(rotation NewRotation) bool {
+	for _, group := range rotation.ParticipantGroups {
+		if len(group.Participants) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameRotations(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
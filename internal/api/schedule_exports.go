@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type ScheduleExport struct {
+	ScheduleID int    `graphql:"scheduleID" json:"scheduleID" tf:"-"`
+	Enabled    bool   `graphql:"enabled" json:"enabled" tf:"enabled"`
+	ICSURL     string `graphql:"icsURL" json:"icsURL" tf:"ics_url"`
+}
+
+func (e *ScheduleExport) Encode() (tf.M, error) {
+	m, err := tf.Encode(e)
+	if err != nil {
+		return nil, err
+	}
+
+	m["schedule_id"] = strconv.Itoa(e.ScheduleID)
+	m["webcal_url"] = ""
+	if e.ICSURL != "" {
+		m["webcal_url"] = "webcal://" + strings.TrimPrefix(strings.TrimPrefix(e.ICSURL, "https://"), "http://")
+	}
+
+	return m, nil
+}
+
+type ScheduleExportInput struct {
+	Enabled bool `graphql:"enabled" json:"enabled"`
+}
+
+// GraphQL query structs
+type ScheduleExportQueryStruct struct {
+	ScheduleExport `graphql:"scheduleExport(scheduleID: $scheduleID)"`
+}
+
+type UpdateScheduleExportMutateStruct struct {
+	ScheduleExport `graphql:"updateScheduleExport(scheduleID: $scheduleID, input: $input)"`
+}
+
+func (client *Client) GetScheduleExport(ctx context.Context, scheduleID int) (*ScheduleExportQueryStruct, error) {
+	var m ScheduleExportQueryStruct
+
+	variables := map[string]interface{}{
+		"scheduleID": scheduleID,
+	}
+
+	return GraphQLRequest[ScheduleExportQueryStruct]("query", client, ctx, &m, variables)
+}
+
+func (client *Client) UpdateScheduleExport(ctx context.Context, scheduleID int, payload ScheduleExportInput) (*UpdateScheduleExportMutateStruct, error) {
+	var m UpdateScheduleExportMutateStruct
+
+	variables := map[string]interface{}{
+		"input":      payload,
+		"scheduleID": scheduleID,
+	}
+
+	return GraphQLRequest[UpdateScheduleExportMutateStruct]("mutate", client, ctx, &m, variables)
+}
@@ -14,7 +14,7 @@ import (
 type Schedule struct {
 	ID          string   `json:"id" tf:"id"`
 	Name        string   `json:"name" tf:"name"`
-	Slug        string   `json:"slug" tf:"-"`
+	Slug        string   `json:"slug" tf:"slug"`
 	Colour      string   `json:"colour" tf:"color"`
 	Description string   `json:"description" tf:"description"`
 	Owner       OwnerRef `json:"owner" tf:"-"`
@@ -28,6 +28,8 @@ type NewSchedule struct {
 	TeamID      string `graphql:"teamID" json:"teamID" tf:"team_id"`
 	Owner       *Owner `graphql:"owner" json:"owner" tf:"-"`
 	Tags        []*Tag `graphql:"tags" json:"tags,omitempty" tf:"tags"`
+	// Rotations are only sent on creation, later changes go through the rotation mutations.
+	Rotations []NewRotation `graphql:"rotations" json:"rotations,omitempty" tf:"-"`
 }
 
 type UpdateSchedule struct {
@@ -58,6 +60,10 @@ type ScheduleByNameQueryStruct struct {
 	NewSchedule []*NewSchedule `graphql:"schedules(filters:  { scheduleName: $scheduleName, teamID: $teamID })"`
 }
 
+type SchedulesByTeamQueryStruct struct {
+	NewSchedule []*NewSchedule `graphql:"schedules(filters:  { teamID: $teamID })"`
+}
+
 type CreateScheduleMutateStruct struct {
 	NewSchedule `graphql:"createSchedule(input: $input)"`
 }
@@ -131,6 +137,22 @@ func (client *Client) GetScheduleByName(ctx context.Context, teamID string, name
 	return nil, fmt.Errorf("could not find a schedule with name `%s`", name)
 }
 
+// GetScheduleBySlug returns the schedule with the given slug, which unlike its name does not change when it is renamed.
+func (client *Client) GetScheduleBySlug(ctx context.Context, teamID string, slug string) (*Schedule, error) {
+	schedules, err := client.ListSchedules(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range schedules {
+		if s.Slug != "" && s.Slug == slug {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a schedule with slug `%s`", slug)
+}
+
 func (client *Client) ListSchedules(ctx context.Context, teamID string) ([]*Schedule, error) {
 	url := fmt.Sprintf("%s/schedules?owner_id=%s", client.BaseURLV3, teamID)
 
@@ -223,3 +245,56 @@ func (client *Client) GetScheduleV2ByName(ctx context.Context, teamID string, sc
 
 	return GraphQLRequest[ScheduleByNameQueryStruct]("query", client, ctx, &m, variables)
 }
+
+// ListSchedulesV2 returns the schedules of the team, with their rotations.
+func (client *Client) ListSchedulesV2(ctx context.Context, teamID string) ([]*NewSchedule, error) {
+	var m SchedulesByTeamQueryStruct
+
+	variables := map[string]interface{}{
+		"teamID": teamID,
+	}
+
+	res, err := GraphQLRequest[SchedulesByTeamQueryStruct]("query", client, ctx, &m, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.NewSchedule, nil
+}
+
+type ScheduleEvent struct {
+	RotationID   int           `graphql:"rotationID" json:"rotationID"`
+	StartTime    string        `graphql:"startTime" json:"startTime"`
+	EndTime      string        `graphql:"endTime" json:"endTime"`
+	Participants []Participant `graphql:"participants" json:"participants"`
+}
+
+type ScheduleEventsQueryStruct struct {
+	Schedule struct {
+		ID     int              `graphql:"ID"`
+		Events []*ScheduleEvent `graphql:"events(from: $from, till: $till)"`
+	} `graphql:"schedule(ID: $ID)"`
+}
+
+// ListScheduleV2Events returns the on-call events of a schedule between from and till (RFC3339).
+func (client *Client) ListScheduleV2Events(ctx context.Context, ID string, from string, till string) ([]*ScheduleEvent, error) {
+	var m ScheduleEventsQueryStruct
+
+	id, err := strconv.ParseInt(ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule id `%s`", ID)
+	}
+
+	variables := map[string]interface{}{
+		"ID":   id,
+		"from": from,
+		"till": till,
+	}
+
+	res, err := GraphQLRequest[ScheduleEventsQueryStruct]("query", client, ctx, &m, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Schedule.Events, nil
+}
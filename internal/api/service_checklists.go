@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type ServiceChecklistItem struct {
+	Type      string `json:"type" tf:"type"`
+	Name      string `json:"name" tf:"name"`
+	Link      string `json:"link" tf:"link"`
+	Completed bool   `json:"completed" tf:"completed"`
+}
+
+func (i *ServiceChecklistItem) Encode() (tf.M, error) {
+	return tf.Encode(i)
+}
+
+type ServiceChecklist struct {
+	ServiceID string                  `json:"service_id" tf:"service_id"`
+	Items     []*ServiceChecklistItem `json:"items" tf:"-"`
+	Ready     bool                    `json:"is_ready" tf:"ready"`
+}
+
+func (c *ServiceChecklist) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := tf.EncodeSlice(c.Items)
+	if err != nil {
+		return nil, err
+	}
+	m["items"] = items
+
+	return m, nil
+}
+
+func (client *Client) GetServiceChecklist(ctx context.Context, serviceID string) (*ServiceChecklist, error) {
+	url := fmt.Sprintf("%s/services/%s/checklist", client.BaseURLV3, serviceID)
+
+	return Request[any, ServiceChecklist](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateServiceChecklistReq struct {
+	Items []ServiceChecklistItem `json:"items"`
+}
+
+func (client *Client) UpdateServiceChecklist(ctx context.Context, serviceID string, req *UpdateServiceChecklistReq) (*ServiceChecklist, error) {
+	url := fmt.Sprintf("%s/services/%s/checklist", client.BaseURLV3, serviceID)
+
+	return Request[UpdateServiceChecklistReq, ServiceChecklist](http.MethodPut, url, client, ctx, req)
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// maxServiceRulesUpdateAttempts bounds the retrieve-merge-update loop when the rules keep changing concurrently.
+const maxServiceRulesUpdateAttempts = 5
+
+type serviceRules[T any] struct {
+	Rules []T `json:"rules"`
+}
+
+// MergeRules applies a local change of a rule list (from base to local) on top of the remote rules.
+// The result is the local rules, in their order, followed by the remote rules that were added since base was read,
+// i.e. that are neither part of base nor of local. Rules are compared by value, see ruleKey.
+//
+// It only keeps the rules added between the refresh and the apply of a plan: base is the whole list of rules read
+// into the state, the rules of others that were already there are removed like any rule dropped from local. The
+// rules of a shared service are managed with the per-rule resources instead, e.g. squadcast_routing_rule_v2.
+func MergeRules[T any](base, local, remote []T) ([]T, error) {
+	known := make(map[string]bool, len(base)+len(local))
+	for _, rules := range [][]T{base, local} {
+		for _, rule := range rules {
+			key, err := ruleKey(rule)
+			if err != nil {
+				return nil, err
+			}
+			known[key] = true
+		}
+	}
+
+	merged := make([]T, 0, len(local)+len(remote))
+	merged = append(merged, local...)
+	for _, rule := range remote {
+		key, err := ruleKey(rule)
+		if err != nil {
+			return nil, err
+		}
+		if !known[key] {
+			merged = append(merged, rule)
+		}
+	}
+
+	return merged, nil
+}
+
+// ruleKey returns the normalized JSON of a rule, to compare the rules decoded from the state with the rules returned
+// by the API. Null, empty and zero values are left out, so that e.g. a nil and an empty list of conditions are equal,
+// and the leading and trailing spaces of strings, which the API trims, are ignored.
+func ruleKey(rule any) (string, error) {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return "", err
+	}
+	var v any
+	if err = json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	b, err = json.Marshal(normalizeRuleValue(v))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizeRuleValue returns a decoded JSON value without its null, empty and zero values, nil when it is one.
+func normalizeRuleValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e = normalizeRuleValue(e); e == nil {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		for i, e := range v {
+			v[i] = normalizeRuleValue(e)
+		}
+	case string:
+		if v = strings.TrimSpace(v); v == "" {
+			return nil
+		}
+		return v
+	case bool:
+		if !v {
+			return nil
+		}
+	case float64:
+		if v == 0 {
+			return nil
+		}
+	}
+
+	return v
+}
+
+// mergeUpdateServiceRules replaces base with local in the rules of a service without losing the rules that were
+// added concurrently since base was read, see MergeRules. The current rules are retrieved, merged with the local
+// change and written back with an If-Match condition when the API returns an ETag; the loop is retried on conflicts.
+func mergeUpdateServiceRules[T any](ctx context.Context, client *Client, kind, serviceID, teamID string, base, local []T) error {
+	getURL := fmt.Sprintf("%s/services/%s/%s?owner_id=%s", client.BaseURLV3, serviceID, kind, teamID)
+	updateURL := fmt.Sprintf("%s/services/%s/%s", client.BaseURLV3, serviceID, kind)
+
+	for attempt := 1; ; attempt++ {
+		remote, header, err := RequestWithHeaders[any, serviceRules[T]](http.MethodGet, getURL, client, ctx, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var remoteRules []T
+		if remote != nil {
+			remoteRules = remote.Rules
+		}
+
+		rules, err := MergeRules(base, local, remoteRules)
+		if err != nil {
+			return err
+		}
+
+		condition := http.Header{}
+		if etag := header.Get("ETag"); etag != "" {
+			condition.Set("If-Match", etag)
+		}
+
+		_, _, err = RequestWithHeaders[serviceRules[T], any](http.MethodPost, updateURL, client, ctx, &serviceRules[T]{Rules: rules}, condition)
+		if errors.Is(err, ErrPreconditionFailed) && attempt < maxServiceRulesUpdateAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+func (client *Client) MergeUpdateTaggingRules(ctx context.Context, serviceID, teamID string, base, local []TaggingRule) error {
+	return mergeUpdateServiceRules(ctx, client, "tagging-rules", serviceID, teamID, base, local)
+}
+
+func (client *Client) MergeUpdateDeduplicationRules(ctx context.Context, serviceID, teamID string, base, local []DeduplicationRule) error {
+	return mergeUpdateServiceRules(ctx, client, "deduplication-rules", serviceID, teamID, base, local)
+}
+
+func (client *Client) MergeUpdateSuppressionRules(ctx context.Context, serviceID, teamID string, base, local []SuppressionRule) error {
+	return mergeUpdateServiceRules(ctx, client, "suppression-rules", serviceID, teamID, base, local)
+}
+
+func (client *Client) MergeUpdateRoutingRules(ctx context.Context, serviceID, teamID string, base, local []RoutingRule) error {
+	return mergeUpdateServiceRules(ctx, client, "routing-rules", serviceID, teamID, base, local)
+}
+
+// serviceRuleURL addresses a single rule, by id, of the rules of a service.
+func serviceRuleURL(client *Client, kind, serviceID, ruleID string) string {
+	url := fmt.Sprintf("%s/services/%s/%s/rules", client.BaseURLV3, serviceID, kind)
+	if ruleID != "" {
+		url += "/" + ruleID
+	}
+
+	return url
+}
+
+type RoutingRuleV2 struct {
+	ID          string `json:"id" tf:"id"`
+	ServiceID   string `json:"service_id" tf:"service_id"`
+	RoutingRule `tf:",squash"`
+}
+
+func (r *RoutingRuleV2) Encode() (tf.M, error) {
+	m, err := r.RoutingRule.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = r.ID
+	m["service_id"] = r.ServiceID
+
+	return m, nil
+}
+
+func (client *Client) GetRoutingRuleV2(ctx context.Context, serviceID, teamID, ruleID string) (*RoutingRuleV2, error) {
+	url := fmt.Sprintf("%s?owner_id=%s", serviceRuleURL(client, "routing-rules", serviceID, ruleID), teamID)
+
+	return Request[any, RoutingRuleV2](http.MethodGet, url, client, ctx, nil)
+}
+
+// CreateRoutingRuleV2 appends a rule to the routing rules of a service, leaving the other rules untouched.
+func (client *Client) CreateRoutingRuleV2(ctx context.Context, serviceID string, req *RoutingRule) (*RoutingRuleV2, error) {
+	return Request[RoutingRule, RoutingRuleV2](http.MethodPost, serviceRuleURL(client, "routing-rules", serviceID, ""), client, ctx, req)
+}
+
+func (client *Client) UpdateRoutingRuleV2(ctx context.Context, serviceID, ruleID string, req *RoutingRule) (*RoutingRuleV2, error) {
+	return Request[RoutingRule, RoutingRuleV2](http.MethodPut, serviceRuleURL(client, "routing-rules", serviceID, ruleID), client, ctx, req)
+}
+
+func (client *Client) DeleteRoutingRuleV2(ctx context.Context, serviceID, ruleID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, serviceRuleURL(client, "routing-rules", serviceID, ruleID), client, ctx, nil)
+}
+
+type SuppressionRuleV2 struct {
+	ID              string `json:"id" tf:"id"`
+	ServiceID       string `json:"service_id" tf:"service_id"`
+	SuppressionRule `tf:",squash"`
+}
+
+func (r *SuppressionRuleV2) Encode() (tf.M, error) {
+	m, err := r.SuppressionRule.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = r.ID
+	m["service_id"] = r.ServiceID
+
+	return m, nil
+}
+
+func (client *Client) GetSuppressionRuleV2(ctx context.Context, serviceID, teamID, ruleID string) (*SuppressionRuleV2, error) {
+	url := fmt.Sprintf("%s?owner_id=%s", serviceRuleURL(client, "suppression-rules", serviceID, ruleID), teamID)
+
+	return Request[any, SuppressionRuleV2](http.MethodGet, url, client, ctx, nil)
+}
+
+// CreateSuppressionRuleV2 appends a rule to the suppression rules of a service, leaving the other rules untouched.
+func (client *Client) CreateSuppressionRuleV2(ctx context.Context, serviceID string, req *SuppressionRule) (*SuppressionRuleV2, error) {
+	return Request[SuppressionRule, SuppressionRuleV2](http.MethodPost, serviceRuleURL(client, "suppression-rules", serviceID, ""), client, ctx, req)
+}
+
+func (client *Client) UpdateSuppressionRuleV2(ctx context.Context, serviceID, ruleID string, req *SuppressionRule) (*SuppressionRuleV2, error) {
+	return Request[SuppressionRule, SuppressionRuleV2](http.MethodPut, serviceRuleURL(client, "suppression-rules", serviceID, ruleID), client, ctx, req)
+}
+
+func (client *Client) DeleteSuppressionRuleV2(ctx context.Context, serviceID, ruleID string) (*any, error) {
+	return Request[any, any](http.MethodDelete, serviceRuleURL(client, "suppression-rules", serviceID, ruleID), client, ctx, nil)
+}
+
+// encodeEnabled replaces the disabled field of an encoded rule by the enabled attribute of the resources. The API
+// only reports the rules that are disabled, so that the rules created before they could be disabled are enabled.
+func encodeEnabled(m tf.M) {
+	disabled, _ := m["disabled"].(bool)
+	delete(m, "disabled")
+	m["enabled"] = !disabled
+}
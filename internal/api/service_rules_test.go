@@ -0,0 +1,105 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeRules(t *testing.T) {
+	base := []*TaggingRule{
+		{Expression: "payload.severity == 'high'", Tags: map[string]TaggingRuleTagValue{"severity": {Value: "high", Color: "#ff0000"}}},
+	}
+	local := []*TaggingRule{
+		{Expression: "payload.severity == 'low'", Tags: map[string]TaggingRuleTagValue{"severity": {Value: "low"}}},
+	}
+
+	tests := []struct {
+		name   string
+		base   []*TaggingRule
+		remote []*TaggingRule
+		want   []*TaggingRule
+	}{
+		{
+			name:   "unchanged remote",
+			remote: []*TaggingRule{{Expression: "payload.severity == 'high'", Tags: map[string]TaggingRuleTagValue{"severity": {Value: "high", Color: "#ff0000"}}}},
+			want:   local,
+		},
+		{
+			name:   "empty instead of nil conditions",
+			remote: []*TaggingRule{{Expression: "payload.severity == 'high'", BasicExpression: []*TaggingRuleCondition{}, Tags: map[string]TaggingRuleTagValue{"severity": {Value: "high", Color: "#ff0000"}}}},
+			want:   local,
+		},
+		{
+			name:   "trimmed by the server",
+			remote: []*TaggingRule{{Expression: "payload.severity == 'high' ", Tags: map[string]TaggingRuleTagValue{"severity": {Value: " high", Color: "#ff0000"}}}},
+			want:   local,
+		},
+		{
+			name: "added remotely",
+			remote: []*TaggingRule{
+				{Expression: "payload.severity == 'high'", Tags: map[string]TaggingRuleTagValue{"severity": {Value: "high", Color: "#ff0000"}}},
+				{Expression: "payload.team == 'sre'", Tags: map[string]TaggingRuleTagValue{"team": {Value: "sre"}}},
+			},
+			want: append(local[:len(local):len(local)], &TaggingRule{Expression: "payload.team == 'sre'", Tags: map[string]TaggingRuleTagValue{"team": {Value: "sre"}}}),
+		},
+		{
+			// The rules of others read into base are not told apart from the rules removed locally.
+			name: "read before the change",
+			remote: []*TaggingRule{
+				{Expression: "payload.severity == 'high'", Tags: map[string]TaggingRuleTagValue{"severity": {Value: "high", Color: "#ff0000"}}},
+				{Expression: "payload.team == 'sre'", Tags: map[string]TaggingRuleTagValue{"team": {Value: "sre"}}},
+			},
+			base: append(base[:len(base):len(base)], &TaggingRule{Expression: "payload.team == 'sre'", Tags: map[string]TaggingRuleTagValue{"team": {Value: "sre"}}}),
+			want: local,
+		},
+		{
+			name:   "deleted remotely",
+			remote: nil,
+			want:   local,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := base
+			if tt.base != nil {
+				b = tt.base
+			}
+			merged, err := MergeRules(b, local, tt.remote)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(merged, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, merged)
+			}
+		})
+	}
+}
+
+func TestRuleKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b any
+		same bool
+	}{
+		{"nil and empty slices", &DeduplicationRule{}, &DeduplicationRule{BasicExpression: []*DeduplicationRuleCondition{}}, true},
+		{"nil and empty maps", &TaggingRule{}, &TaggingRule{Tags: map[string]TaggingRuleTagValue{}}, true},
+		{"untrimmed strings", &RoutingRule{Expression: "x == 1"}, &RoutingRule{Expression: " x == 1\n"}, true},
+		{"different values", &RoutingRule{Expression: "x == 1"}, &RoutingRule{Expression: "x == 2"}, false},
+		{"disabled", &RoutingRule{Expression: "x == 1"}, &RoutingRule{Expression: "x == 1", Disabled: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := ruleKey(tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := ruleKey(tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (a == b) != tt.same {
+				t.Errorf("expected the keys %s and %s to be equal: %v", a, b, tt.same)
+			}
+		})
+	}
+}
@@ -19,9 +19,11 @@ type Service struct {
 	Description        string             `json:"description" tf:"description"`
 	EscalationPolicyID string             `json:"escalation_policy_id" tf:"escalation_policy_id"`
 	OnMaintenance      bool               `json:"on_maintenance" tf:"-"`
+	Muted              bool               `json:"is_muted" tf:"muted"`
 	Owner              OwnerRef           `json:"owner" tf:"-"`
 	Maintainer         *ServiceMaintainer `json:"maintainer" tf:"maintainer"`
 	Tags               []ServiceTag       `json:"tags" tf:"tags"`
+	Labels             map[string]string  `json:"labels" tf:"labels"`
 	Dependencies       []string           `json:"depends" tf:"dependencies"`
 	ActiveAlertSources map[string]string  `json:"-" tf:"active_alert_source_endpoints"`
 	AlertSources       map[string]string  `json:"-" tf:"alert_source_endpoints"`
@@ -46,6 +48,8 @@ func (s *Service) Encode() (tf.M, error) {
 
 	m["team_id"] = s.Owner.ID
 
+	// The maintainer is always set, so that a maintainer removed outside of Terraform is not kept in the state.
+	m["maintainer"] = []any{}
 	if s.Maintainer != nil {
 		m["maintainer"] = tf.List(tf.M{
 			"type": s.Maintainer.Type,
@@ -92,6 +96,7 @@ type CreateServiceReq struct {
 	EmailPrefix        string             `json:"email_prefix"`
 	Maintainer         *ServiceMaintainer `json:"maintainer"`
 	Tags               []ServiceTag       `json:"tags"`
+	Labels             map[string]string  `json:"labels"`
 }
 
 type UpdateServiceReq struct {
@@ -101,6 +106,7 @@ type UpdateServiceReq struct {
 	EmailPrefix        string             `json:"email_prefix"`
 	Maintainer         *ServiceMaintainer `json:"maintainer"`
 	Tags               []ServiceTag       `json:"tags"`
+	Labels             map[string]string  `json:"labels"`
 }
 
 type ServiceMaintainer struct {
@@ -117,13 +123,20 @@ type AddSlackChannelReq struct {
 	ChannelID string `json:"channel_id"`
 }
 
+type UpdateServiceMuteReq struct {
+	Muted bool `json:"is_muted"`
+}
+
 type UpdateServiceDependenciesReq struct {
 	Data []string `json:"data"`
 }
 
 func (client *Client) CreateService(ctx context.Context, req *CreateServiceReq) (*Service, error) {
 	url := fmt.Sprintf("%s/services", client.BaseURLV3)
-	return Request[CreateServiceReq, Service](http.MethodPost, url, client, ctx, req)
+
+	return createIdempotently(ctx, client, url, req, func() (*Service, error) {
+		return client.GetServiceByName(ctx, req.TeamID, req.Name)
+	})
 }
 
 func (client *Client) UpdateService(ctx context.Context, id string, req *UpdateServiceReq) (*Service, error) {
@@ -136,6 +149,12 @@ func (client *Client) UpdateServiceDependencies(ctx context.Context, id string,
 	return Request[UpdateServiceDependenciesReq, any](http.MethodPost, url, client, ctx, req)
 }
 
+// UpdateServiceMute mutes or unmutes the notifications of the incidents of a service, the incidents are still created.
+func (client *Client) UpdateServiceMute(ctx context.Context, id string, req *UpdateServiceMuteReq) (*any, error) {
+	url := fmt.Sprintf("%s/services/%s/mute", client.BaseURLV3, id)
+	return Request[UpdateServiceMuteReq, any](http.MethodPut, url, client, ctx, req)
+}
+
 func (client *Client) DeleteService(ctx context.Context, id string) (*any, error) {
 	url := fmt.Sprintf("%s/services/%s", client.BaseURLV3, id)
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
@@ -145,3 +164,102 @@ func (client *Client) UpdateSlackChannel(ctx context.Context, serviceID string,
 	url := fmt.Sprintf("%s/services/%s/extensions", client.BaseURLV3, serviceID)
 	return Request[AddSlackChannelReq, any](http.MethodPut, url, client, ctx, req)
 }
+
+// ServiceBusinessHours are the hours of the given days in which the notifications of a service with delayed
+// notifications are sent.
+type ServiceBusinessHours struct {
+	Days      []string `json:"days" tf:"days"`
+	StartTime string   `json:"start_time" tf:"start_time"`
+	EndTime   string   `json:"end_time" tf:"end_time"`
+}
+
+func (h *ServiceBusinessHours) Encode() (tf.M, error) {
+	return tf.Encode(h)
+}
+
+// ServiceDelayedNotificationConfig queues the notifications of the incidents of a service triggered outside of its
+// business hours until the next business hours start.
+type ServiceDelayedNotificationConfig struct {
+	Enabled       bool                    `json:"is_enabled" tf:"-"`
+	Timezone      string                  `json:"timezone" tf:"timezone"`
+	BusinessHours []*ServiceBusinessHours `json:"business_hours" tf:"-"`
+}
+
+func (c *ServiceDelayedNotificationConfig) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	businessHours, err := tf.EncodeSlice(c.BusinessHours)
+	if err != nil {
+		return nil, err
+	}
+	m["business_hours"] = businessHours
+
+	return m, nil
+}
+
+func (client *Client) GetServiceDelayedNotificationConfig(ctx context.Context, serviceID string) (*ServiceDelayedNotificationConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/delayed-notification-config", client.BaseURLV3, serviceID)
+
+	return Request[any, ServiceDelayedNotificationConfig](http.MethodGet, url, client, ctx, nil)
+}
+
+// UpdateServiceDelayedNotificationConfig enables or disables the delayed notifications of a service, the timezone and
+// the business hours are ignored when they are disabled.
+func (client *Client) UpdateServiceDelayedNotificationConfig(ctx context.Context, serviceID string, req *ServiceDelayedNotificationConfig) (*ServiceDelayedNotificationConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/delayed-notification-config", client.BaseURLV3, serviceID)
+
+	return Request[ServiceDelayedNotificationConfig, ServiceDelayedNotificationConfig](http.MethodPut, url, client, ctx, req)
+}
+
+// ServiceIntelligentAlertGroupingConfig groups the alerts of a service similar to the alerts of an open incident
+// triggered during the time window into that incident.
+type ServiceIntelligentAlertGroupingConfig struct {
+	Enabled    bool `json:"is_enabled" tf:"-"`
+	TimeWindow int  `json:"rolling_window_in_mins" tf:"time_window"`
+}
+
+func (c *ServiceIntelligentAlertGroupingConfig) Encode() (tf.M, error) {
+	return tf.Encode(c)
+}
+
+func (client *Client) GetServiceIntelligentAlertGroupingConfig(ctx context.Context, serviceID string) (*ServiceIntelligentAlertGroupingConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/iag-config", client.BaseURLV3, serviceID)
+
+	return Request[any, ServiceIntelligentAlertGroupingConfig](http.MethodGet, url, client, ctx, nil)
+}
+
+// UpdateServiceIntelligentAlertGroupingConfig enables or disables the intelligent alert grouping of a service, the
+// time window is ignored when it is disabled.
+func (client *Client) UpdateServiceIntelligentAlertGroupingConfig(ctx context.Context, serviceID string, req *ServiceIntelligentAlertGroupingConfig) (*ServiceIntelligentAlertGroupingConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/iag-config", client.BaseURLV3, serviceID)
+
+	return Request[ServiceIntelligentAlertGroupingConfig, ServiceIntelligentAlertGroupingConfig](http.MethodPut, url, client, ctx, req)
+}
+
+// ServiceAPTAConfig auto pauses the notifications of the incidents of a service for the evaluation window, the
+// incidents auto resolved during it are transient and never notified.
+type ServiceAPTAConfig struct {
+	Enabled bool `json:"is_enabled" tf:"-"`
+	Timeout int  `json:"timeout" tf:"timeout"`
+}
+
+func (c *ServiceAPTAConfig) Encode() (tf.M, error) {
+	return tf.Encode(c)
+}
+
+func (client *Client) GetServiceAPTAConfig(ctx context.Context, serviceID string) (*ServiceAPTAConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/apta-config", client.BaseURLV3, serviceID)
+
+	return Request[any, ServiceAPTAConfig](http.MethodGet, url, client, ctx, nil)
+}
+
+// UpdateServiceAPTAConfig enables or disables the auto pause of the transient alerts of a service, the timeout is
+// ignored when it is disabled.
+func (client *Client) UpdateServiceAPTAConfig(ctx context.Context, serviceID string, req *ServiceAPTAConfig) (*ServiceAPTAConfig, error) {
+	url := fmt.Sprintf("%s/services/%s/apta-config", client.BaseURLV3, serviceID)
+
+	return Request[ServiceAPTAConfig, ServiceAPTAConfig](http.MethodPut, url, client, ctx, req)
+}
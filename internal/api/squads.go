@@ -65,7 +65,9 @@ type UpdateSquadReq struct {
 func (client *Client) CreateSquad(ctx context.Context, req *CreateSquadReq) (*Squad, error) {
 	url := fmt.Sprintf("%s/squads", client.BaseURLV3)
 
-	return Request[CreateSquadReq, Squad](http.MethodPost, url, client, ctx, req)
+	return createIdempotently(ctx, client, url, req, func() (*Squad, error) {
+		return client.GetSquadByName(ctx, req.TeamID, req.Name)
+	})
 }
 
 func (client *Client) UpdateSquad(ctx context.Context, id string, req *UpdateSquadReq) (*Squad, error) {
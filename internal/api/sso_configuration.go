@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// SSOAttributeMapping maps the attributes of the SAML assertions of the IdP to the attributes of the Squadcast users.
+type SSOAttributeMapping struct {
+	Email     string `json:"email" tf:"email"`
+	FirstName string `json:"first_name" tf:"first_name"`
+	LastName  string `json:"last_name" tf:"last_name"`
+}
+
+func (m *SSOAttributeMapping) Encode() (tf.M, error) {
+	return tf.Encode(m)
+}
+
+type SSOConfiguration struct {
+	IdPMetadataURL   string               `json:"idp_metadata_url" tf:"idp_metadata_url"`
+	IdPMetadataXML   string               `json:"idp_metadata_xml" tf:"idp_metadata_xml"`
+	AttributeMapping *SSOAttributeMapping `json:"attribute_mapping" tf:"-"`
+	Enforced         bool                 `json:"is_enforced" tf:"enforced"`
+	OwnerBypass      bool                 `json:"allow_owner_password_login" tf:"allow_owner_password_login"`
+	AutoProvision    bool                 `json:"auto_provision_users" tf:"auto_provision_users"`
+	EntityID         string               `json:"sp_entity_id" tf:"sp_entity_id"`
+	ACSURL           string               `json:"sp_acs_url" tf:"sp_acs_url"`
+}
+
+func (c *SSOConfiguration) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AttributeMapping != nil {
+		mapping, err := c.AttributeMapping.Encode()
+		if err != nil {
+			return nil, err
+		}
+		m["attribute_mapping"] = tf.List(mapping)
+	}
+
+	return m, nil
+}
+
+func (client *Client) GetSSOConfiguration(ctx context.Context) (*SSOConfiguration, error) {
+	url := fmt.Sprintf("%s/organization/sso", client.BaseURLV3)
+
+	return Request[any, SSOConfiguration](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateSSOConfigurationReq struct {
+	IdPMetadataURL   string               `json:"idp_metadata_url,omitempty"`
+	IdPMetadataXML   string               `json:"idp_metadata_xml,omitempty"`
+	AttributeMapping *SSOAttributeMapping `json:"attribute_mapping"`
+	Enforced         bool                 `json:"is_enforced"`
+	OwnerBypass      bool                 `json:"allow_owner_password_login"`
+	AutoProvision    bool                 `json:"auto_provision_users"`
+}
+
+func (client *Client) UpdateSSOConfiguration(ctx context.Context, req *UpdateSSOConfigurationReq) (*SSOConfiguration, error) {
+	url := fmt.Sprintf("%s/organization/sso", client.BaseURLV3)
+
+	return Request[UpdateSSOConfigurationReq, SSOConfiguration](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteSSOConfiguration disables the SSO of the organization, the users log in with their password again.
+func (client *Client) DeleteSSOConfiguration(ctx context.Context) (*any, error) {
+	url := fmt.Sprintf("%s/organization/sso", client.BaseURLV3)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
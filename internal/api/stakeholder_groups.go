@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type StakeholderGroup struct {
+	ID          string   `json:"id" tf:"id"`
+	Name        string   `json:"name" tf:"name"`
+	Description string   `json:"description" tf:"description"`
+	Owner       OwnerRef `json:"owner" tf:"-"`
+	MemberIDs   []string `json:"members" tf:"member_ids"`
+}
+
+func (g *StakeholderGroup) Encode() (tf.M, error) {
+	m, err := tf.Encode(g)
+	if err != nil {
+		return nil, err
+	}
+
+	m["team_id"] = g.Owner.ID
+
+	return m, nil
+}
+
+func (client *Client) GetStakeholderGroupById(ctx context.Context, teamID string, id string) (*StakeholderGroup, error) {
+	url := fmt.Sprintf("%s/stakeholder-groups/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, StakeholderGroup](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetStakeholderGroupByName(ctx context.Context, teamID string, name string) (*StakeholderGroup, error) {
+	url := fmt.Sprintf("%s/stakeholder-groups/by-name?name=%s&owner_id=%s", client.BaseURLV3, url.QueryEscape(name), teamID)
+
+	return Request[any, StakeholderGroup](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateStakeholderGroupReq struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	TeamID      string   `json:"owner_id"`
+	MemberIDs   []string `json:"members"`
+}
+
+type UpdateStakeholderGroupReq struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	MemberIDs   []string `json:"members"`
+}
+
+func (client *Client) CreateStakeholderGroup(ctx context.Context, req *CreateStakeholderGroupReq) (*StakeholderGroup, error) {
+	url := fmt.Sprintf("%s/stakeholder-groups", client.BaseURLV3)
+
+	return createIdempotently(ctx, client, url, req, func() (*StakeholderGroup, error) {
+		return client.GetStakeholderGroupByName(ctx, req.TeamID, req.Name)
+	})
+}
+
+func (client *Client) UpdateStakeholderGroup(ctx context.Context, id string, req *UpdateStakeholderGroupReq) (*StakeholderGroup, error) {
+	url := fmt.Sprintf("%s/stakeholder-groups/%s", client.BaseURLV3, id)
+
+	return Request[UpdateStakeholderGroupReq, StakeholderGroup](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStakeholderGroup(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/stakeholder-groups/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// statusPageSubscribersPageSize is the number of subscribers fetched per request when listing them.
+const statusPageSubscribersPageSize = 500
+
+type StatusPageSubscriber struct {
+	ID    uint   `json:"id"`
+	Email string `json:"email"`
+}
+
+type StatusPageSubscribersReq struct {
+	Emails []string `json:"emails"`
+}
+
+// ListStatusPageSubscribers returns all the email subscribers of the status page, fetching them page by page.
+func (client *Client) ListStatusPageSubscribers(ctx context.Context, pageID string) ([]*StatusPageSubscriber, error) {
+	var subscribers []*StatusPageSubscriber
+	for pageNumber := 1; ; pageNumber++ {
+		url := fmt.Sprintf("%s/statuspages/%s/subscribers?type=email&pageNumber=%d&pageSize=%d", client.BaseURLV4, pageID, pageNumber, statusPageSubscribersPageSize)
+		page, err := RequestSlice[any, StatusPageSubscriber](http.MethodGet, url, client, ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		subscribers = append(subscribers, page...)
+		if len(page) < statusPageSubscribersPageSize {
+			return subscribers, nil
+		}
+	}
+}
+
+func (client *Client) AddStatusPageSubscribers(ctx context.Context, pageID string, req *StatusPageSubscribersReq) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/subscribers/bulk", client.BaseURLV4, pageID)
+	return Request[StatusPageSubscribersReq, any](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) RemoveStatusPageSubscribers(ctx context.Context, pageID string, req *StatusPageSubscribersReq) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/subscribers/bulk-delete", client.BaseURLV4, pageID)
+	return Request[StatusPageSubscribersReq, any](http.MethodPost, url, client, ctx, req)
+}
@@ -25,6 +25,7 @@ type SuppressionRule struct {
 	BasicExpression []*SuppressionRuleCondition `json:"basic_expression" tf:"basic_expressions"`
 	IsTimeBased     bool                        `json:"is_timebased" tf:"is_timebased"`
 	TimeSlots       []*TimeSlot                 `json:"timeslots" tf:"timeslots"`
+	Disabled        bool                        `json:"disabled,omitempty" tf:"disabled"`
 }
 
 type TimeSlot struct {
@@ -86,6 +87,7 @@ func (r *SuppressionRule) Encode() (tf.M, error) {
 			m["timeslots"].([]interface{})[idx].(map[string]interface{})["custom"] = mNewCustomField
 		}
 	}
+	encodeEnabled(m)
 
 	return m, nil
 }
@@ -31,8 +31,10 @@ func (tv *TaggingRuleTagValue) Encode() (tf.M, error) {
 type TaggingRule struct {
 	IsBasic         bool                           `json:"is_basic" tf:"is_basic"`
 	Expression      string                         `json:"expression" tf:"expression"`
+	Overwrite       bool                           `json:"overwrite" tf:"overwrite"`
 	BasicExpression []*TaggingRuleCondition        `json:"basic_expression" tf:"basic_expressions"`
 	Tags            map[string]TaggingRuleTagValue `json:"tags" tf:"-"`
+	Disabled        bool                           `json:"disabled,omitempty" tf:"disabled"`
 }
 
 func (r *TaggingRule) Encode() (tf.M, error) {
@@ -65,6 +67,7 @@ func (r *TaggingRule) Encode() (tf.M, error) {
 		tags = append(tags, mtag)
 	}
 	m["tags"] = tags
+	encodeEnabled(m)
 
 	return m, nil
 }
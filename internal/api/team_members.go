@@ -38,3 +38,16 @@ func (client *Client) DeleteTeamMember(ctx context.Context, teamID string, userI
 
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
+
+// UpdateTeamMembersReq adds, updates and removes several members of a team in a single request.
+type UpdateTeamMembersReq struct {
+	Add    []*CreateTeamMemberReq `json:"add,omitempty"`
+	Update []*CreateTeamMemberReq `json:"update,omitempty"`
+	Remove []string               `json:"remove,omitempty"`
+}
+
+func (client *Client) UpdateTeamMembers(ctx context.Context, teamID string, req *UpdateTeamMembersReq) (*any, error) {
+	url := fmt.Sprintf("%s/teams/%s/members/bulk?owner_id=%s", client.BaseURLV3, teamID, teamID)
+
+	return Request[UpdateTeamMembersReq, any](http.MethodPatch, url, client, ctx, req)
+}
@@ -20,6 +20,8 @@ type TeamMeta struct {
 	Description string          `json:"description" tf:"description"`
 	Default     bool            `json:"default" tf:"default"`
 	Roles       []*teamMetaRole `json:"roles" tf:"-"`
+
+	Members []*DataTeamMember `json:"-" tf:"-"`
 }
 
 func (t *TeamMeta) Encode() (tf.M, error) {
@@ -28,6 +30,17 @@ func (t *TeamMeta) Encode() (tf.M, error) {
 		return nil, err
 	}
 
+	roles := tf.M{}
+	for key, id := range t.DefaultRoleIDs() {
+		roles[key] = id
+	}
+	m["default_role_ids"] = roles
+
+	return m, nil
+}
+
+// DefaultRoleIDs returns the ids of the default roles of the team, keyed by manage_team, admin, user and observer.
+func (t *TeamMeta) DefaultRoleIDs() map[string]string {
 	defaultRoleNames := map[string]string{
 		"Manage Team": "manage_team",
 		"Admin":       "admin",
@@ -35,7 +48,7 @@ func (t *TeamMeta) Encode() (tf.M, error) {
 		"Observer":    "observer",
 	}
 
-	roles := tf.M{}
+	roles := map[string]string{}
 
 	for _, role := range t.Roles {
 		key := defaultRoleNames[role.Name]
@@ -43,9 +56,8 @@ func (t *TeamMeta) Encode() (tf.M, error) {
 			roles[key] = role.ID
 		}
 	}
-	m["default_role_ids"] = roles
 
-	return m, nil
+	return roles
 }
 
 func (client *Client) GetTeamMetaById(ctx context.Context, id string) (*TeamMeta, error) {
@@ -70,10 +82,18 @@ func (client *Client) GetTeamMetaById(ctx context.Context, id string) (*TeamMeta
 		Description: team.Description,
 		Default:     team.Default,
 		Roles:       roles,
+		Members:     team.Members,
 	}, nil
 
 }
 
+// ListTeams returns the teams of the organization.
+func (client *Client) ListTeams(ctx context.Context) ([]*TeamMeta, error) {
+	url := fmt.Sprintf("%s/teams", client.BaseURLV3)
+
+	return RequestSlice[any, TeamMeta](http.MethodGet, url, client, ctx, nil)
+}
+
 type CreateTeamReq struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxIdleConnsPerHost is above the default parallelism of terraform (10), so that the connections to the API are
+// reused during a parallel refresh instead of being closed after every request.
+const maxIdleConnsPerHost = 16
+
+// defaultHTTPClient is shared by the clients, its transport pools the connections to the API and logs the requests.
+var defaultHTTPClient = &http.Client{Transport: &loggingTransport{base: newTransport()}}
+
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		// The transport asks for gzip responses and decompresses them, as long as no Accept-Encoding header is set.
+		DisableCompression: false,
+	}
+}
+
+// HTTPConfig is the network configuration of the HTTP client of the API, e.g. to go through a corporate egress proxy
+// or an on-prem gateway.
+type HTTPConfig struct {
+	// ProxyURL is the URL of the proxy the requests go through. The HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment
+	// variables are used when empty.
+	ProxyURL string
+
+	// CABundle is a PEM bundle of certificate authorities trusted in addition to the ones of the system.
+	CABundle []byte
+
+	// InsecureSkipVerify disables the verification of the certificates of the API.
+	InsecureSkipVerify bool
+
+	// Headers are sent with every request, in addition to the headers set by the client.
+	Headers map[string]string
+}
+
+// NewHTTPClient returns an HTTP client with the given network configuration, to set with WithHTTPClient.
+func NewHTTPClient(config HTTPConfig) (*http.Client, error) {
+	transport := newTransport()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if len(config.CABundle) > 0 || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+		if len(config.CABundle) > 0 {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(config.CABundle) {
+				return nil, errors.New("the CA bundle contains no PEM certificate")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	var rt http.RoundTripper = &loggingTransport{base: transport, sensitiveHeaders: headerNames(config.Headers)}
+	if len(config.Headers) > 0 {
+		rt = &headerTransport{headers: config.Headers, base: rt}
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// headerTransport adds headers to the requests, without overriding the ones already set.
+type headerTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		if req.Header.Get(k) == "" {
+			req.Header.Set(k, v)
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+func headerNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+
+	return names
+}
+
+// HTTPClient returns the HTTP client the requests of the client are sent with.
+func (client *Client) HTTPClient() *http.Client {
+	if client.httpClient != nil {
+		return client.httpClient
+	}
+
+	return defaultHTTPClient
+}
+
+// WithHTTPClient sets the HTTP client the requests are sent with, e.g. to record them in tests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
+}
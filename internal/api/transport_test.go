@@ -0,0 +1,92 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Key") != "gw-secret" {
+			t.Errorf("expected the custom header, got %q", r.Header.Get("X-Gateway-Key"))
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected the custom headers not to override the authorization, got %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+	}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	headers := map[string]string{"X-Gateway-Key": "gw-secret", "Authorization": "Basic gateway"}
+
+	for name, config := range map[string]HTTPConfig{
+		"ca bundle":            {CABundle: caBundle, Headers: headers},
+		"insecure skip verify": {InsecureSkipVerify: true, Headers: headers},
+	} {
+		t.Run(name, func(t *testing.T) {
+			httpClient, err := NewHTTPClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+			client := &Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+			WithHTTPClient(httpClient)(client)
+
+			if _, err := Request[any, Squad](http.MethodGet, server.URL+"/v3/squads/61305a8eb7a2fa0e44cfd0f5", client, ctx, nil); err != nil {
+				t.Fatal(err)
+			}
+			if logs := output.String(); strings.Contains(logs, "gw-secret") {
+				t.Errorf("expected the custom headers to be redacted, got %s", logs)
+			}
+		})
+	}
+
+	// The certificate of the server is not trusted by default.
+	httpClient, err := NewHTTPClient(HTTPConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	WithHTTPClient(httpClient)(client)
+	if _, err := Request[any, Squad](http.MethodGet, server.URL+"/v3/squads/61305a8eb7a2fa0e44cfd0f5", client, context.Background(), nil); err == nil {
+		t.Error("expected the certificate of the server to be rejected")
+	}
+
+	if _, err := NewHTTPClient(HTTPConfig{CABundle: []byte("not a certificate")}); err == nil {
+		t.Error("expected an error for a CA bundle without certificates")
+	}
+}
+
+func TestNewHTTPClient_proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests to a proxy carry the absolute URL of the target.
+		proxied = append(proxied, r.URL.String())
+		w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+	}))
+	defer proxy.Close()
+
+	httpClient, err := NewHTTPClient(HTTPConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{BaseURLV3: "http://squadcast.invalid/v3", ServiceAccountToken: "token"}
+	WithHTTPClient(httpClient)(client)
+
+	if _, err := Request[any, Squad](http.MethodGet, "http://squadcast.invalid/v3/squads/61305a8eb7a2fa0e44cfd0f5", client, context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(proxied) != 1 || proxied[0] != "http://squadcast.invalid/v3/squads/61305a8eb7a2fa0e44cfd0f5" {
+		t.Errorf("expected the request to go through the proxy, got %q", proxied)
+	}
+}
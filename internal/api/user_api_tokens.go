@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type UserAPIToken struct {
+	ID        string   `json:"id" tf:"id"`
+	UserID    string   `json:"user_id" tf:"user_id"`
+	Name      string   `json:"name" tf:"name"`
+	Scopes    []string `json:"scopes" tf:"scopes"`
+	CreatedAt string   `json:"created_at" tf:"created_at"`
+	ExpiresAt string   `json:"expires_at" tf:"expires_at"`
+	// Token is only returned by the creation of the token.
+	Token string `json:"token,omitempty" tf:"-"`
+}
+
+func (t *UserAPIToken) Encode() (tf.M, error) {
+	return tf.Encode(t)
+}
+
+type CreateUserAPITokenReq struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+func (client *Client) CreateUserAPIToken(ctx context.Context, userID string, req *CreateUserAPITokenReq) (*UserAPIToken, error) {
+	url := fmt.Sprintf("%s/users/%s/api-tokens", client.BaseURLV3, userID)
+
+	return Request[CreateUserAPITokenReq, UserAPIToken](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) GetUserAPITokenByID(ctx context.Context, userID string, id string) (*UserAPIToken, error) {
+	url := fmt.Sprintf("%s/users/%s/api-tokens/%s", client.BaseURLV3, userID, id)
+
+	return Request[any, UserAPIToken](http.MethodGet, url, client, ctx, nil)
+}
+
+// DeleteUserAPIToken revokes the token.
+func (client *Client) DeleteUserAPIToken(ctx context.Context, userID string, id string) (*any, error) {
+	url := fmt.Sprintf("%s/users/%s/api-tokens/%s", client.BaseURLV3, userID, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
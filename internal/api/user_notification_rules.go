@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// UserNotificationRule is a step of the notification ladder of a user: the channel the user is notified with, once
+// the delay since the incident was assigned to them has elapsed.
+type UserNotificationRule struct {
+	Channel      string `json:"type" tf:"channel"`
+	DelayMinutes int    `json:"time" tf:"delay_minutes"`
+}
+
+func (r *UserNotificationRule) Encode() (tf.M, error) {
+	return tf.Encode(r)
+}
+
+type UserNotificationRules struct {
+	UserID       string                  `json:"user_id" tf:"user_id"`
+	HighPriority []*UserNotificationRule `json:"high_priority" tf:"-"`
+	LowPriority  []*UserNotificationRule `json:"low_priority" tf:"-"`
+}
+
+func (r *UserNotificationRules) Encode() (tf.M, error) {
+	m, err := tf.Encode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m["high_priority"], err = tf.EncodeSlice(r.HighPriority)
+	if err != nil {
+		return nil, err
+	}
+	m["low_priority"], err = tf.EncodeSlice(r.LowPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (client *Client) GetUserNotificationRules(ctx context.Context, userID string) (*UserNotificationRules, error) {
+	url := fmt.Sprintf("%s/users/%s/notification-rules", client.BaseURLV3, userID)
+
+	return Request[any, UserNotificationRules](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateUserNotificationRulesReq struct {
+	HighPriority []UserNotificationRule `json:"high_priority"`
+	LowPriority  []UserNotificationRule `json:"low_priority"`
+}
+
+func (client *Client) UpdateUserNotificationRules(ctx context.Context, userID string, req *UpdateUserNotificationRulesReq) (*UserNotificationRules, error) {
+	url := fmt.Sprintf("%s/users/%s/notification-rules", client.BaseURLV3, userID)
+
+	return Request[UpdateUserNotificationRulesReq, UserNotificationRules](http.MethodPut, url, client, ctx, req)
+}
+
+// DeleteUserNotificationRules resets the notification rules of the user to the defaults of Squadcast.
+func (client *Client) DeleteUserNotificationRules(ctx context.Context, userID string) (*any, error) {
+	url := fmt.Sprintf("%s/users/%s/notification-rules", client.BaseURLV3, userID)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -17,8 +17,8 @@ type Contact struct {
 type Ability struct {
 	ID string `json:"id" tf:"-"`
 	// Name    string `json:"name" tf:"-"`
-	Slug string `json:"slug" tf:"-"`
-	// Default bool   `json:"default" tf:"-"`
+	Slug    string `json:"slug" tf:"-"`
+	Default bool   `json:"default" tf:"-"`
 }
 
 type PersonalNotificationRule struct {
@@ -123,6 +123,13 @@ func (client *Client) GetUserById(ctx context.Context, id string) (*ResourceUser
 	return Request[any, ResourceUser](http.MethodGet, url, client, ctx, nil)
 }
 
+// GetCurrentUser returns the user the client is authenticated as.
+func (client *Client) GetCurrentUser(ctx context.Context) (*ResourceUser, error) {
+	url := fmt.Sprintf("%s/users/me", client.BaseURLV3)
+
+	return Request[any, ResourceUser](http.MethodGet, url, client, ctx, nil)
+}
+
 func (client *Client) GetUserByEmail(ctx context.Context, email string) (*DataSourceUser, error) {
 	url := fmt.Sprintf("%s/users?email=%s", client.BaseURLV3, url.QueryEscape(email))
 
@@ -183,3 +190,16 @@ func (client *Client) UpdateUserAbilities(ctx context.Context, req *UpdateUserAb
 
 	return Request[wrapped, any](http.MethodPut, url, client, ctx, &bulkReq)
 }
+
+type UserPermissions struct {
+	ID                 string   `tf:"id"`
+	UserID             string   `tf:"user_id"`
+	Role               string   `tf:"role"`
+	Abilities          []string `tf:"abilities"`
+	RoleAbilities      []string `tf:"role_abilities"`
+	EffectiveAbilities []string `tf:"effective_abilities"`
+}
+
+func (p *UserPermissions) Encode() (tf.M, error) {
+	return tf.Encode(p)
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// waitMinDelay is the delay before the second check of a wait, it doubles with every check up to waitMaxDelay.
+var waitMinDelay = 500 * time.Millisecond
+
+// waitMaxDelay is the longest delay between two checks of a wait.
+var waitMaxDelay = 30 * time.Second
+
+// WaitUntil calls check until it reports that it is done, returns an error, or the timeout is reached, with an
+// exponential backoff between the calls. It returns the result of the last call, the error on timeout wraps the
+// error of the context.
+func WaitUntil[T any](ctx context.Context, timeout time.Duration, check func(ctx context.Context) (result T, done bool, err error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	delay := waitMinDelay
+	for {
+		result, done, err := check(ctx)
+		if err != nil || done {
+			return result, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, fmt.Errorf("still pending after %s: %w", time.Since(start).Round(time.Second), ctx.Err())
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > waitMaxDelay {
+			delay = waitMaxDelay
+		}
+	}
+}
+
+// WaitForFound calls get until it no longer fails with a not found error, e.g. after the creation of an object the
+// API creates asynchronously.
+func WaitForFound[T any](ctx context.Context, timeout time.Duration, get func(ctx context.Context) (*T, error)) (*T, error) {
+	return WaitUntil(ctx, timeout, func(ctx context.Context) (*T, bool, error) {
+		result, err := get(ctx)
+		if err != nil && IsResourceNotFoundError(err) {
+			return nil, false, nil
+		}
+		return result, true, err
+	})
+}
+
+// WaitForNotFound calls get until it fails with a not found error, e.g. after the deletion of an object the API
+// deletes asynchronously.
+func WaitForNotFound[T any](ctx context.Context, timeout time.Duration, get func(ctx context.Context) (*T, error)) error {
+	_, err := WaitUntil(ctx, timeout, func(ctx context.Context) (*T, bool, error) {
+		_, err := get(ctx)
+		if err != nil && IsResourceNotFoundError(err) {
+			return nil, true, nil
+		}
+		return nil, false, err
+	})
+	return err
+}
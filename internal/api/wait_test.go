@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForFound(t *testing.T) {
+	calls := 0
+	team, err := WaitForFound(context.Background(), time.Minute, func(ctx context.Context) (*TeamMeta, error) {
+		calls++
+		if calls < 3 {
+			return nil, &Error{StatusCode: http.StatusNotFound}
+		}
+		return &TeamMeta{Name: "sre"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.Name != "sre" || calls != 3 {
+		t.Errorf("expected the team after 3 calls, got %#v after %d calls", team, calls)
+	}
+
+	// Other errors stop the wait.
+	calls = 0
+	_, err = WaitForFound(context.Background(), time.Minute, func(ctx context.Context) (*TeamMeta, error) {
+		calls++
+		return nil, &Error{StatusCode: http.StatusForbidden}
+	})
+	if ErrorStatusCode(err) != http.StatusForbidden || calls != 1 {
+		t.Errorf("expected the error of the first call, got %v after %d calls", err, calls)
+	}
+
+	_, err = WaitForFound(context.Background(), 100*time.Millisecond, func(ctx context.Context) (*TeamMeta, error) {
+		return nil, &Error{StatusCode: http.StatusNotFound}
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait to time out, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
@@ -27,6 +28,7 @@ type WebformReq struct {
 	FooterLink    string            `json:"footer_link"`
 	EmailOn       []string          `json:"email_on"`
 	Description   string            `json:"description"`
+	SuccessPage   *WFSuccessPage    `json:"success_page,omitempty"`
 }
 
 type Webform struct {
@@ -49,6 +51,7 @@ type Webform struct {
 	FooterLink    string            `json:"footer_link" tf:"footer_link"`
 	EmailOn       []string          `json:"email_on" tf:"email_on"`
 	Description   string            `json:"description" tf:"description"`
+	SuccessPage   *WFSuccessPage    `json:"success_page" tf:"-"`
 }
 
 type CreateWebformRes struct {
@@ -59,6 +62,10 @@ type WFService struct {
 	ServiceId string `json:"service_id" tf:"service_id"`
 	Name      string `json:"name" tf:"name"`
 	Alias     string `json:"alias" tf:"alias"`
+	// Weight orders the services in the dropdown of the public form, lower weights first.
+	Weight    int    `json:"weight" tf:"weight"`
+	Group     string `json:"group,omitempty" tf:"group"`
+	IsDefault bool   `json:"is_default" tf:"default"`
 }
 
 type WFTag struct {
@@ -76,6 +83,13 @@ type WFInputField struct {
 	Options []string `json:"options" tf:"options"`
 }
 
+// WFSuccessPage is what the reporter is shown once the form is submitted.
+type WFSuccessPage struct {
+	Message        string `json:"message" tf:"message"`
+	RedirectURL    string `json:"redirect_url" tf:"redirect_url"`
+	ShowIncidentID bool   `json:"show_incident_id" tf:"show_incident_id"`
+}
+
 type WebformOwner struct {
 	ID   string `tf:"id"`
 	Name string `tf:"name"`
@@ -98,6 +112,10 @@ func (webformInputField WFInputField) Encode() (tf.M, error) {
 	return tf.Encode(webformInputField)
 }
 
+func (webformSuccessPage WFSuccessPage) Encode() (tf.M, error) {
+	return tf.Encode(webformSuccessPage)
+}
+
 func (t *Webform) Encode() (tf.M, error) {
 	m, err := tf.Encode(t)
 	if err != nil {
@@ -134,6 +152,16 @@ func (t *Webform) Encode() (tf.M, error) {
 	}
 	m["input_field"] = inputFieldEncoded
 
+	// The default success page of the API, i.e. without a message nor a redirect, is left out of the state.
+	m["success_page"] = []tf.M{}
+	if t.SuccessPage != nil && *t.SuccessPage != (WFSuccessPage{}) {
+		successPage, err := t.SuccessPage.Encode()
+		if err != nil {
+			return nil, err
+		}
+		m["success_page"] = tf.List(successPage)
+	}
+
 	return m, nil
 }
 
@@ -149,10 +177,22 @@ func (client *Client) GetWebformByName(ctx context.Context, teamID string, name
 	return Request[any, Webform](http.MethodGet, url, client, ctx, nil)
 }
 
+func (client *Client) ListWebforms(ctx context.Context, teamID string) ([]*Webform, error) {
+	url := fmt.Sprintf("%s/webform?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, Webform](http.MethodGet, url, client, ctx, nil)
+}
+
 func (client *Client) CreateWebform(ctx context.Context, teamID string, req *WebformReq) (*CreateWebformRes, error) {
 	url := fmt.Sprintf("%s/webform?owner_id=%s", client.BaseURLV3, teamID)
 
-	return Request[WebformReq, CreateWebformRes](http.MethodPost, url, client, ctx, req)
+	return createIdempotently(ctx, client, url, req, func() (*CreateWebformRes, error) {
+		webform, err := client.GetWebformByName(ctx, teamID, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &CreateWebformRes{WebFormRes: webform}, nil
+	})
 }
 
 func (client *Client) UpdateWebform(ctx context.Context, teamID string, id string, req *WebformReq) (*Webform, error) {
@@ -166,3 +206,53 @@ func (client *Client) DeleteWebform(ctx context.Context, teamID string, id strin
 
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
+
+// SubmissionURL returns the public endpoint the submissions of the webform are posted to.
+func (t *Webform) SubmissionURL(ingestionBaseURL string) string {
+	return fmt.Sprintf("%s/v2/incidents/webform/%d", ingestionBaseURL, t.ID)
+}
+
+// WebformDNSRecord is a DNS record to create for the custom domain of a webform.
+type WebformDNSRecord struct {
+	Type  string `json:"type" tf:"type"`
+	Name  string `json:"name" tf:"name"`
+	Value string `json:"value" tf:"value"`
+}
+
+func (r WebformDNSRecord) Encode() (tf.M, error) {
+	return tf.Encode(r)
+}
+
+// WebformDomainStatus is the verification status of the custom domain of a webform, with the records proving its
+// ownership (TXT) and pointing it to the webform (CNAME).
+type WebformDomainStatus struct {
+	HostName   string             `json:"host_name"`
+	Verified   bool               `json:"is_verified"`
+	DNSRecords []WebformDNSRecord `json:"dns_records"`
+}
+
+func (client *Client) GetWebformDomainStatus(ctx context.Context, teamID string, id string) (*WebformDomainStatus, error) {
+	url := fmt.Sprintf("%s/webform/%s/domain-status?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, WebformDomainStatus](http.MethodGet, url, client, ctx, nil)
+}
+
+// WaitForWebformDomainVerification polls the verification status of the custom domain of a webform until it is
+// verified or the timeout is reached. The status is not found for a while after the custom domain is set.
+func (client *Client) WaitForWebformDomainVerification(ctx context.Context, teamID string, id string, timeout time.Duration) (*WebformDomainStatus, error) {
+	status, err := WaitUntil(ctx, timeout, func(ctx context.Context) (*WebformDomainStatus, bool, error) {
+		status, err := client.GetWebformDomainStatus(ctx, teamID, id)
+		if err != nil {
+			if IsResourceNotFoundError(err) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+		return status, status.Verified, nil
+	})
+	if err != nil && status != nil {
+		return nil, fmt.Errorf("custom domain %s of webform %s is not verified yet, check its DNS records: %w", status.HostName, id, err)
+	}
+
+	return status, err
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// adoptExistingOnConflict is called when the creation of a resource failed. When the API reported a conflict and the
+// provider is configured with adopt_existing_on_conflict, the existing object with the same name is looked up and
+// its id is set, the caller then updates the object to the configuration. It returns whether the object was adopted.
+func adoptExistingOnConflict(ctx context.Context, client *api.Client, d *schema.ResourceData, createErr error, kind string, name string, lookup func() (string, error)) (bool, diag.Diagnostics) {
+	if !client.AdoptExistingOnConflict || api.ErrorStatusCode(createErr) != http.StatusConflict {
+		return false, diagFromErr(createErr)
+	}
+
+	tflog.Info(ctx, "Adopting existing "+kind, tf.M{
+		"name": name,
+	})
+	id, err := lookup()
+	if err != nil {
+		return false, append(diagFromErr(createErr), diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to adopt the existing %s `%s`", kind, name),
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(id)
+
+	return true, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Adopted the existing %s `%s`", kind, name),
+		Detail: fmt.Sprintf("The %s `%s` (%s) already existed, it was adopted into the state and updated to the configuration instead of being created, "+
+			"as the provider is configured with adopt_existing_on_conflict.", kind, name, id),
+	}}
+}
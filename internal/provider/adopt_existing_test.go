@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAdoptExistingOnConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v3/squads" {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"meta":{"status":409,"error_message":"squad name already exists"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"meta":{"status":400,"error_message":"invalid members"}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	_, conflictErr := api.Request[any, any](http.MethodPost, client.BaseURLV3+"/squads", client, context.Background(), nil)
+	_, badRequestErr := api.Request[any, any](http.MethodPost, client.BaseURLV3+"/squads/members", client, context.Background(), nil)
+
+	lookup := func() (string, error) { return "61305a8eb7a2fa0e44cfd0f5", nil }
+
+	tests := []struct {
+		name      string
+		adopt     bool
+		createErr error
+		lookup    func() (string, error)
+		adopted   bool
+		severity  diag.Severity
+		diags     int
+	}{
+		{name: "disabled", adopt: false, createErr: conflictErr, lookup: lookup, severity: diag.Error, diags: 1},
+		{name: "conflict", adopt: true, createErr: conflictErr, lookup: lookup, adopted: true, severity: diag.Warning, diags: 1},
+		{name: "not a conflict", adopt: true, createErr: badRequestErr, lookup: lookup, severity: diag.Error, diags: 1},
+		{name: "lookup failed", adopt: true, createErr: conflictErr, lookup: func() (string, error) { return "", errors.New("not found") }, severity: diag.Error, diags: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client.AdoptExistingOnConflict = tt.adopt
+			d := schema.TestResourceDataRaw(t, resourceSquad().Schema, map[string]any{"name": "sre"})
+
+			adopted, diags := adoptExistingOnConflict(context.Background(), client, d, tt.createErr, "squad", "sre", tt.lookup)
+			if adopted != tt.adopted {
+				t.Errorf("expected adopted to be %t", tt.adopted)
+			}
+			if len(diags) != tt.diags || diags[0].Severity != tt.severity {
+				t.Errorf("expected %d diagnostics of severity %d, got %#v", tt.diags, tt.severity, diags)
+			}
+			if adopted && d.Id() != "61305a8eb7a2fa0e44cfd0f5" {
+				t.Errorf("expected the id of the existing squad, got %q", d.Id())
+			}
+			if !adopted && d.Id() != "" {
+				t.Errorf("expected no id, got %q", d.Id())
+			}
+		})
+	}
+}
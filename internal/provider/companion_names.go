@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// companionName is a computed attribute holding the name of the object referenced by the id of another attribute,
+// e.g. team_name for team_id, so that the plans and the state can be reviewed without looking the ids up.
+type companionName struct {
+	IDAttribute   string
+	NameAttribute string
+	// Type is the type of the referenced object, see api.Client.ResolveEntityNames.
+	Type string
+	// TeamScoped is whether the object is looked up in the team of the resource, which then must have a team_id.
+	TeamScoped bool
+}
+
+var companionNames = []companionName{
+	{IDAttribute: "team_id", NameAttribute: "team_name", Type: "team"},
+	{IDAttribute: "escalation_policy_id", NameAttribute: "escalation_policy_name", Type: "escalation_policy", TeamScoped: true},
+}
+
+// resourceCompanionNames returns the companion names that apply to the schema of a resource.
+func resourceCompanionNames(s map[string]*schema.Schema) []companionName {
+	var names []companionName
+	for _, name := range companionNames {
+		id, ok := s[name.IDAttribute]
+		if !ok || id.Type != schema.TypeString {
+			continue
+		}
+		if _, ok := s[name.NameAttribute]; ok {
+			continue
+		}
+		if _, ok := s["team_id"]; name.TeamScoped && !ok {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// withCompanionNames adds the computed companion names of the id attributes of a resource, e.g. team_name for
+// team_id, resolved after the resource is created, read or updated. They are marked as unknown during plan when the
+// id changes. The names are informative, a failure to resolve them is logged rather than failing the operation.
+func withCompanionNames(r *schema.Resource) *schema.Resource {
+	names := resourceCompanionNames(r.Schema)
+	if len(names) == 0 {
+		return r
+	}
+
+	for _, name := range names {
+		r.Schema[name.NameAttribute] = &schema.Schema{
+			Description: fmt.Sprintf("Name of the %s, resolved from `%s`.", strings.ReplaceAll(name.Type, "_", " "), name.IDAttribute),
+			Type:        schema.TypeString,
+			Computed:    true,
+		}
+	}
+
+	wrap := func(op func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			diags := op(ctx, d, meta)
+			if client, ok := meta.(*api.Client); ok && !diags.HasError() && d.Id() != "" {
+				setCompanionNames(ctx, client, d, names)
+			}
+			return diags
+		}
+	}
+	if r.CreateContext != nil {
+		r.CreateContext = wrap(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap(r.UpdateContext)
+	}
+
+	computed := make([]schema.CustomizeDiffFunc, 0, len(names)+1)
+	if r.CustomizeDiff != nil {
+		computed = append(computed, r.CustomizeDiff)
+	}
+	for _, name := range names {
+		name := name
+		computed = append(computed, customdiff.ComputedIf(name.NameAttribute, func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+			return d.Id() != "" && d.HasChange(name.IDAttribute)
+		}))
+	}
+	r.CustomizeDiff = customdiff.All(computed...)
+
+	return r
+}
+
+// setCompanionNames sets the companion names of a resource from its id attributes. Names that can not be resolved,
+// e.g. of objects that were deleted, are set empty.
+func setCompanionNames(ctx context.Context, client *api.Client, d *schema.ResourceData, names []companionName) {
+	teamID, _ := d.Get("team_id").(string)
+
+	refs := make([]api.EntityRef, 0, len(names))
+	for _, name := range names {
+		id, _ := d.Get(name.IDAttribute).(string)
+		if id == "" || name.TeamScoped && teamID == "" {
+			d.Set(name.NameAttribute, "")
+			continue
+		}
+		refs = append(refs, api.EntityRef{Type: name.Type, ID: id})
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	resolved, err := client.ResolveEntityNames(ctx, teamID, refs)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to resolve the companion names", tf.M{
+			"error": err.Error(),
+		})
+		return
+	}
+	for _, name := range names {
+		if id, _ := d.Get(name.IDAttribute).(string); id != "" {
+			d.Set(name.NameAttribute, resolved[api.EntityRef{Type: name.Type, ID: id}])
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestWithCompanionNames(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/v3/teams":
+			w.Write([]byte(`{"data":[{"id":"613611c1eb22db455cfa789f","name":"SRE"}]}`))
+		case "/v3/escalation-policies":
+			if r.URL.Query().Get("owner_id") != "613611c1eb22db455cfa789f" {
+				t.Errorf("unexpected request %s", r.URL)
+			}
+			w.Write([]byte(`{"data":[{"id":"5f8c4ff09b0ccd917237c04b","name":"Primary"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := withCompanionNames(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"team_id":              {Type: schema.TypeString, Required: true, ForceNew: true},
+			"escalation_policy_id": {Type: schema.TypeString, Required: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return nil
+		},
+	})
+
+	for i, escalationPolicyID := range []string{"5f8c4ff09b0ccd917237c04b", "5f8c4ff09b0ccd917237c04c"} {
+		d := r.TestResourceData()
+		d.SetId("61305a8eb7a2fa0e44cfd0f5")
+		d.Set("team_id", "613611c1eb22db455cfa789f")
+		d.Set("escalation_policy_id", escalationPolicyID)
+		if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if name := d.Get("team_name"); name != "SRE" {
+			t.Errorf("expected the team name, got %q", name)
+		}
+		if name, want := d.Get("escalation_policy_name"), []string{"Primary", ""}[i]; name != want {
+			t.Errorf("expected the escalation policy name %q, got %q", want, name)
+		}
+	}
+	if requests["/v3/teams"] != 1 {
+		t.Errorf("expected the teams to be listed once, got %d", requests["/v3/teams"])
+	}
+
+	state := &terraform.InstanceState{ID: "61305a8eb7a2fa0e44cfd0f5", Attributes: map[string]string{
+		"id":                     "61305a8eb7a2fa0e44cfd0f5",
+		"team_id":                "613611c1eb22db455cfa789f",
+		"team_name":              "SRE",
+		"escalation_policy_id":   "5f8c4ff09b0ccd917237c04b",
+		"escalation_policy_name": "Primary",
+	}}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]any{
+		"team_id":              "613611c1eb22db455cfa789f",
+		"escalation_policy_id": "5f8c4ff09b0ccd917237c04c",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["escalation_policy_name"]; attr == nil || !attr.NewComputed {
+		t.Errorf("expected the escalation policy name to be unknown when its id changes, got %+v", attr)
+	}
+	if attr := diff.Attributes["team_name"]; attr != nil {
+		t.Errorf("expected the team name to be unchanged, got %+v", attr)
+	}
+
+	if names := resourceCompanionNames(map[string]*schema.Schema{"escalation_policy_id": {Type: schema.TypeString}}); len(names) != 0 {
+		t.Errorf("expected no escalation policy name without a team, got %v", names)
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testAccResourceConfig renders the HCL configuration of a resource from its attributes, e.g. to generate the
+// configurations of table-driven acceptance tests. Lists of maps are rendered as repeated nested blocks, nil values
+// are left out as if not configured.
+func testAccResourceConfig(resourceType, name string, attributes map[string]any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource %q %q {\n", resourceType, name)
+	writeTestAccBlockBody(&b, attributes, 1)
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func writeTestAccBlockBody(b *strings.Builder, attributes map[string]any, depth int) {
+	indent := strings.Repeat("\t", depth)
+
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch v := attributes[k].(type) {
+		case []any:
+			if len(v) > 0 {
+				if _, ok := v[0].(map[string]any); ok {
+					for _, block := range v {
+						fmt.Fprintf(b, "%s%s {\n", indent, k)
+						writeTestAccBlockBody(b, block.(map[string]any), depth+1)
+						fmt.Fprintf(b, "%s}\n", indent)
+					}
+					continue
+				}
+			}
+			values := make([]string, len(v))
+			for i, e := range v {
+				values[i] = testAccHCLValue(e)
+			}
+			fmt.Fprintf(b, "%s%s = [%s]\n", indent, k, strings.Join(values, ", "))
+		default:
+			if v == nil {
+				continue
+			}
+			fmt.Fprintf(b, "%s%s = %s\n", indent, k, testAccHCLValue(v))
+		}
+	}
+}
+
+func testAccHCLValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func TestResourceConfigRendering(t *testing.T) {
+	got := testAccResourceConfig("squadcast_schedule_rotation_v2", "test", map[string]any{
+		"name":                  "primary",
+		"ends_after_iterations": nil,
+		"shift_timeslots": []any{
+			map[string]any{"start_hour": 10, "day_of_week": "monday"},
+			map[string]any{"start_hour": 12, "day_of_week": "sunday"},
+		},
+		"start_minute": 0,
+		"tags":         []any{"a", "b"},
+	})
+
+	want := `resource "squadcast_schedule_rotation_v2" "test" {
+	name = "primary"
+	shift_timeslots {
+		day_of_week = "monday"
+		start_hour = 10
+	}
+	shift_timeslots {
+		day_of_week = "sunday"
+		start_hour = 12
+	}
+	start_minute = 0
+	tags = ["a", "b"]
+}
+`
+	if got != want {
+		t.Errorf("unexpected configuration:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceAlertSources() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Alert Sources](https://www.squadcast.com/integrations) are the integrations through which alerts are sent to Squadcast services." +
+			"Use this data source to get the webhook URLs and emails of every supported alert source for a service, e.g. to configure your monitoring tools.",
+
+		ReadContext: dataSourceAlertSourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Service id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"endpoints": {
+				Description: "Map of alert source short names to their webhook URL, API key or email for this service.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"payload_fields": {
+				Description: "Payload fields sent by the alert sources active on the service, e.g. to validate the templated values of `squadcast_tagging_rules`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"alert_sources": {
+				Description: "List of all available alert sources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Alert source id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Alert source name, as used in the `alert_sources` attribute of `squadcast_service`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"short_name": {
+							Description: "Alert source short name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"support_doc_url": {
+							Description: "Link to the documentation of this alert source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"endpoint": {
+							Description: "Webhook URL, API key or email to send alerts of this alert source to the service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"active": {
+							Description: "Whether this alert source is active on the service.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"payload_fields": {
+							Description: "Payload fields sent by this alert source. Only set for the alert sources active on the service.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertSourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID := d.Get("service_id").(string)
+
+	tflog.Info(ctx, "Reading alert sources", tf.M{
+		"service_id": serviceID,
+	})
+	service, err := client.GetServiceById(ctx, d.Get("team_id").(string), serviceID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	activeAlertSources, err := client.ListActiveAlertSources(ctx, serviceID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	active := make(map[string]bool, len(activeAlertSources.AlertSources))
+	for _, alertSource := range activeAlertSources.AlertSources {
+		active[alertSource.ID] = true
+	}
+
+	available := alertSources.Available()
+	malertSources := make([]any, 0, len(*available))
+	payloadFields := make(map[string]bool)
+	for _, alertSource := range *available {
+		var fields []string
+		if active[alertSource.ID] {
+			payloadSchema, err := client.GetAlertSourcePayloadSchema(ctx, alertSource.ID)
+			if err != nil {
+				return diagFromErr(err)
+			}
+			if payloadSchema != nil {
+				fields = payloadSchema.Fields
+			}
+			for _, field := range fields {
+				payloadFields[field] = true
+			}
+		}
+
+		malertSources = append(malertSources, tf.M{
+			"id":              alertSource.ID,
+			"type":            alertSource.Type,
+			"short_name":      alertSource.ShortName,
+			"support_doc_url": alertSource.SupportDocURL,
+			"endpoint":        alertSource.Endpoint(client.IngestionBaseURL, service),
+			"active":          active[alertSource.ID],
+			"payload_fields":  fields,
+		})
+	}
+
+	d.SetId(serviceID)
+	if err = d.Set("endpoints", available.EndpointMap(client.IngestionBaseURL, service)); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("payload_fields", sortedKeys(payloadFields)); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("alert_sources", malertSources); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAlertSources(t *testing.T) {
+	serviceName := testAccName("service")
+
+	resourceName := "data.squadcast_alert_sources.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertSourcesDataSourceConfig(serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.email", serviceName+"@squadcast.incidents.squadcast.com"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoints.email", "squadcast_service.test", "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.prometheus"),
+					resource.TestCheckResourceAttrSet(resourceName, "alert_sources.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "payload_fields.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlertSourcesDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+	alert_sources = ["Prometheus"]
+}
+
+data "squadcast_alert_sources" "test" {
+	service_id = squadcast_service.test.id
+	team_id = "613611c1eb22db455cfa789f"
+}
+	`, serviceName, serviceName)
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceAnalytics() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the incident metrics of the organization or of a team over a period: the number of incidents, the mean time to acknowledge (MTTA) and the mean time to resolve (MTTR), in total and per service, " +
+			"e.g. to feed Terraform-managed dashboards or SLO thresholds.",
+
+		ReadContext: dataSourceAnalyticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id. The metrics are those of the whole organization when not set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"service_ids": {
+				Description: "ids of the services to limit the metrics to. The metrics cover all the services when not set.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"from": {
+				Description:  "Start of the period, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End of the period, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"incident_count": {
+				Description: "Number of incidents triggered over the period.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"mtta": {
+				Description: "Mean time to acknowledge the incidents of the period, in seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"mttr": {
+				Description: "Mean time to resolve the incidents of the period, in seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"services": {
+				Description: "Metrics of each service over the period.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Description: "Service id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"service_name": {
+							Description: "Service name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"incident_count": {
+							Description: "Number of incidents of the service.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"mtta": {
+							Description: "Mean time to acknowledge the incidents of the service, in seconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"mttr": {
+							Description: "Mean time to resolve the incidents of the service, in seconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAnalyticsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	serviceIDs := tf.ExpandStringList(d.Get("service_ids").([]any))
+	from, _ := time.Parse(time.RFC3339, d.Get("from").(string))
+	till, _ := time.Parse(time.RFC3339, d.Get("till").(string))
+	if !till.After(from) {
+		return diag.Errorf("till (%s) must be after from (%s)", d.Get("till").(string), d.Get("from").(string))
+	}
+
+	tflog.Info(ctx, "Reading incident analytics", tf.M{
+		"team_id": teamID,
+		"from":    from.Format(time.RFC3339),
+		"till":    till.Format(time.RFC3339),
+	})
+	analytics, err := client.GetIncidentAnalytics(ctx, teamID, from.Format(time.RFC3339), till.Format(time.RFC3339), serviceIDs)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	m, err := analytics.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", teamID, strings.Join(serviceIDs, ","), d.Get("from").(string), d.Get("till").(string)))
+	for _, k := range []string{"incident_count", "mtta", "mttr", "services"} {
+		if err = d.Set(k, m[k]); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return nil
+}
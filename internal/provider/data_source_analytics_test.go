@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDataSourceAnalyticsRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/v3/analytics/incidents" || query.Get("owner_id") != "613611c1eb22db455cfa789f" || query.Get("service_ids") != "6389ba2ec31b7df1caecd579" ||
+			query.Get("from") != "2023-06-01T00:00:00Z" || query.Get("to") != "2023-07-01T00:00:00Z" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"incident_count":12,"mtta":90,"mttr":1800,"services":[{"service_id":"6389ba2ec31b7df1caecd579","service_name":"api","incident_count":12,"mtta":90,"mttr":1800}]}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := dataSourceAnalytics()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"team_id":     "613611c1eb22db455cfa789f",
+		"service_ids": []any{"6389ba2ec31b7df1caecd579"},
+		"from":        "2023-06-01T00:00:00Z",
+		"till":        "2023-07-01T00:00:00Z",
+	})
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("incident_count") != 12 || d.Get("mtta") != 90 || d.Get("mttr") != 1800 {
+		t.Errorf("unexpected metrics %v %v %v", d.Get("incident_count"), d.Get("mtta"), d.Get("mttr"))
+	}
+	if d.Get("services.#") != 1 || d.Get("services.0.service_name") != "api" || d.Get("services.0.mttr") != 1800 {
+		t.Errorf("unexpected services %v", d.Get("services"))
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"from": "2023-07-01T00:00:00Z",
+		"till": "2023-06-01T00:00:00Z",
+	})
+	if diags := r.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Error("expected an error for a period ending before it starts")
+	}
+}
+
+func TestAccDataSourceAnalytics(t *testing.T) {
+	resourceName := "data.squadcast_analytics.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "squadcast_analytics" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	from = "2023-06-01T00:00:00Z"
+	till = "2023-07-01T00:00:00Z"
+}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "incident_count"),
+					resource.TestCheckResourceAttrSet(resourceName, "mtta"),
+					resource.TestCheckResourceAttrSet(resourceName, "mttr"),
+					resource.TestCheckResourceAttrSet(resourceName, "services.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// defaultObject is a kind of object Squadcast creates by default, looked up by its well-known name.
+type defaultObject struct {
+	Name string
+	// TeamScoped is whether the object belongs to a team, whose id is then required.
+	TeamScoped bool
+	Lookup     func(ctx context.Context, client *api.Client, teamID, name string) (string, error)
+}
+
+// defaultObjects are the objects Squadcast creates by default, by type.
+var defaultObjects = map[string]defaultObject{
+	"team": {
+		Name: "Default Team",
+		Lookup: func(ctx context.Context, client *api.Client, teamID, name string) (string, error) {
+			team, err := client.GetTeamByName(ctx, name)
+			if err != nil {
+				return "", err
+			}
+			return team.ID, nil
+		},
+	},
+	"escalation_policy": {
+		Name:       "Default Escalation Policy",
+		TeamScoped: true,
+		Lookup: func(ctx context.Context, client *api.Client, teamID, name string) (string, error) {
+			escalationPolicy, err := client.GetEscalationPolicyByName(ctx, teamID, name)
+			if err != nil {
+				return "", err
+			}
+			return escalationPolicy.ID, nil
+		},
+	},
+	"service": {
+		Name:       "Default Service",
+		TeamScoped: true,
+		Lookup: func(ctx context.Context, client *api.Client, teamID, name string) (string, error) {
+			service, err := client.GetServiceByName(ctx, teamID, name)
+			if err != nil {
+				return "", err
+			}
+			return service.ID, nil
+		},
+	},
+}
+
+func defaultObjectTypes() []string {
+	types := make([]string, 0, len(defaultObjects))
+	for t := range defaultObjects {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+func defaultObjectNames() string {
+	names := make([]string, 0, len(defaultObjects))
+	for _, t := range defaultObjectTypes() {
+		names = append(names, fmt.Sprintf("`%s` for %s", defaultObjects[t].Name, t))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func dataSourceDefaultObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the id of an object Squadcast creates by default, e.g. the default escalation policy of a team, to attach new objects to it without hardcoding ids which differ between organizations. " +
+			"The object is looked up by its well-known name: " + defaultObjectNames() + ".",
+
+		ReadContext: dataSourceDefaultObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Id of the object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description:  fmt.Sprintf("Type of the object. (%s)", strings.Join(defaultObjectTypes(), ", ")),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(defaultObjectTypes(), false),
+			},
+			"team_id": {
+				Description:  "Team id of the object, required for escalation policies and services.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name": {
+				Description:  "Name of the object, defaults to the well-known name of the default object of the type. Set it when the default object was renamed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func dataSourceDefaultObjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	objectType := d.Get("type").(string)
+	object := defaultObjects[objectType]
+	teamID := d.Get("team_id").(string)
+	if object.TeamScoped && teamID == "" {
+		return diag.Errorf("team_id is required for the default %s", objectType)
+	}
+	name := d.Get("name").(string)
+	if name == "" {
+		name = object.Name
+	}
+
+	tflog.Info(ctx, "Reading default object", tf.M{
+		"type":    objectType,
+		"team_id": teamID,
+		"name":    name,
+	})
+	id, err := object.Lookup(ctx, client, teamID, name)
+	if err != nil {
+		return append(diagFromErr(err), diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Default %s `%s` not found", objectType, name),
+			Detail:   "Set `name` to the name of the default object when it was renamed.",
+		})
+	}
+
+	d.SetId(id)
+	if err = d.Set("name", name); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
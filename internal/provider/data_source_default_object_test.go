@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDataSourceDefaultObjectRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/escalation-policies" || r.URL.Query().Get("owner_id") != "613611c1eb22db455cfa789f" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":[{"id":"61305a8eb7a2fa0e44cfd0f4","name":"Primary"},{"id":"61305a8eb7a2fa0e44cfd0f5","name":"Default Escalation Policy"}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := dataSourceDefaultObject()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"type": "escalation_policy", "team_id": "613611c1eb22db455cfa789f"})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "61305a8eb7a2fa0e44cfd0f5" || d.Get("name") != "Default Escalation Policy" {
+		t.Errorf("expected the default escalation policy, got %q %v", d.Id(), d.Get("name"))
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{"type": "escalation_policy", "team_id": "613611c1eb22db455cfa789f", "name": "Primary"})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() || d.Id() != "61305a8eb7a2fa0e44cfd0f4" {
+		t.Errorf("expected the renamed default escalation policy, got %q %v", d.Id(), diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{"type": "escalation_policy", "team_id": "613611c1eb22db455cfa789f", "name": "Secondary"})
+	if diags := r.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Errorf("expected an error for a missing default escalation policy")
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]any{"type": "service"})
+	if diags := r.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Errorf("expected team_id to be required for services")
+	}
+}
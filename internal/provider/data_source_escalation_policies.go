@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceEscalationPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Escalation Policies](https://support.squadcast.com/docs/escalation-policies) defines rules indicating when and how alerts will escalate to various Users, Squads and (or) Schedules within your Organization." +
+			"Use this data source to list the escalation policies of a team, optionally filtered by description or tags, e.g. to adopt existing policies into Terraform.",
+
+		ReadContext: dataSourceEscalationPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Team id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"description_contains": {
+				Description: "Only return escalation policies whose description contains this string (case insensitive).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": {
+				Description: "Only return escalation policies having all of these tags.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"escalation_policies": {
+				Description: "List of matching escalation policies.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Escalation Policy id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Escalation Policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Detailed description about the Escalation Policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Escalation Policy tags.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func escalationPolicyMatches(ep *api.EscalationPolicy, descriptionContains string, tags map[string]any) bool {
+	if descriptionContains != "" && !strings.Contains(strings.ToLower(ep.Description), strings.ToLower(descriptionContains)) {
+		return false
+	}
+
+	for key, value := range tags {
+		found := false
+		for _, tag := range ep.Tags {
+			if tag.Key == key && tag.Value == value.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSourceEscalationPoliciesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+
+	tflog.Info(ctx, "Reading escalation_policies", tf.M{
+		"team_id": teamID,
+	})
+	escalationPolicies, err := client.ListEscalationPolicies(ctx, teamID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	descriptionContains := d.Get("description_contains").(string)
+	tags := d.Get("tags").(map[string]any)
+
+	policies := make([]any, 0)
+	for _, ep := range escalationPolicies {
+		if !escalationPolicyMatches(ep, descriptionContains, tags) {
+			continue
+		}
+
+		policies = append(policies, tf.M{
+			"id":          ep.ID,
+			"name":        ep.Name,
+			"description": ep.Description,
+			"tags":        flattenEscalationPolicyTags(ep.Tags),
+		})
+	}
+
+	d.SetId(teamID)
+	if err = d.Set("escalation_policies", policies); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccDataSourceEscalationPolicies(t *testing.T) {
+	escalationPolicyName := testAccName("escalation_policy")
+
+	resourceName := "data.squadcast_escalation_policies.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEscalationPoliciesConfig(escalationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "escalation_policies.0.id", "squadcast_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.0.name", escalationPolicyName),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.0.description", escalationPolicyName+" is an amazing policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEscalationPoliciesConfig(escalationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	description = "%s is an amazing policy"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b7"
+			type = "user"
+		}
+	}
+}
+
+data "squadcast_escalation_policies" "test" {
+	team_id = squadcast_escalation_policy.test.team_id
+	description_contains = "%s"
+}
+	`, escalationPolicyName, escalationPolicyName, escalationPolicyName)
+}
+
+func TestEscalationPolicyMatches_tags(t *testing.T) {
+	ep := &api.EscalationPolicy{
+		Tags: []api.ServiceTag{
+			{Key: "oncall"},
+			{Key: "env", Value: "prod"},
+			{Key: "team", Value: "payments"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		tags map[string]any
+		want bool
+	}{
+		{name: "no filter", want: true},
+		{name: "key only", tags: map[string]any{"oncall": ""}, want: true},
+		{name: "key only on a tag with a value", tags: map[string]any{"env": ""}, want: false},
+		{name: "key and value", tags: map[string]any{"env": "prod"}, want: true},
+		{name: "all of the tags", tags: map[string]any{"env": "prod", "team": "payments", "oncall": ""}, want: true},
+		{name: "value mismatch", tags: map[string]any{"env": "staging"}, want: false},
+		{name: "key mismatch", tags: map[string]any{"region": "prod"}, want: false},
+		{name: "one of the tags mismatches", tags: map[string]any{"env": "prod", "team": "platform"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escalationPolicyMatches(ep, "", tt.tags); got != tt.want {
+				t.Errorf("escalationPolicyMatches(%v) = %v, want %v", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
@@ -79,92 +79,7 @@ func dataSourceEscalationPolicy() *schema.Resource {
 					},
 				},
 			},
-			"rules": {
-				Description: "Rules will have the details of who to notify and when to notify and how to notify them.",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"delay_minutes": {
-							Type:     schema.TypeInt,
-							Computed: true,
-						},
-						"targets": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"type": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-						"notification_channels": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-						"round_robin": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"enabled": {
-										Description: "Round Robin Escalation is an incident assignment strategy where users are placed in a ring and assigned to incidents sequentially. This strategy can help ensure that incidents are equitably distributed. It can also lower incident response time if a service experiences concurrent incidents, since the incidents will not all be assigned to the same responder.",
-										Type:        schema.TypeBool,
-										Computed:    true,
-									},
-									"rotation": {
-										Type:     schema.TypeList,
-										Computed: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"enabled": {
-													Description: "enable rotation within",
-													Type:        schema.TypeBool,
-													Computed:    true,
-												},
-												"delay_minutes": {
-													Description: "repeat after minutes",
-													Type:        schema.TypeInt,
-													Computed:    true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"repeat": {
-							Description: "repeat this rule",
-							Type:        schema.TypeList,
-							Computed:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"times": {
-										Description: "repeat times",
-										Type:        schema.TypeInt,
-										Computed:    true,
-									},
-									"delay_minutes": {
-										Description: "repeat after minutes",
-										Type:        schema.TypeInt,
-										Computed:    true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"rules": escalationPolicyComputedRulesSchema("Rules will have the details of who to notify and when to notify and how to notify them."),
 		},
 	}
 }
@@ -177,12 +92,121 @@ func dataSourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData,
 	})
 	escalationPolicy, err := client.GetEscalationPolicyByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(escalationPolicy, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
+
+// escalationPolicyComputedRulesSchema is the schema of the rules of an escalation policy as read from the API.
+func escalationPolicyComputedRulesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"delay_minutes": {
+					Description: "The number of minutes to wait before escalating to the targets of this rule.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"targets": {
+					Description: "The users, squads or schedules notified by this rule.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Description: "Target id.",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"type": {
+								Description: "Target type. (user, squad, schedule or schedulev2)",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"rotation_id": {
+								Description: "Id of the rotation (layer) of the schedule notified, when only one is. (schedulev2 only)",
+								Type:        schema.TypeString,
+								Computed:    true,
+							},
+							"notification_channels": {
+								Description: "Notification channels used for this target, overriding the channels of the rule.",
+								Type:        schema.TypeList,
+								Computed:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"notification_channels": {
+					Description: "Notification channels used to notify the targets. (SMS, Phone, Email or Push)",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"round_robin": {
+					Description: "Round robin configuration of this rule.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Description: "Round Robin Escalation is an incident assignment strategy where users are placed in a ring and assigned to incidents sequentially. This strategy can help ensure that incidents are equitably distributed. It can also lower incident response time if a service experiences concurrent incidents, since the incidents will not all be assigned to the same responder.",
+								Type:        schema.TypeBool,
+								Computed:    true,
+							},
+							"rotation": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"enabled": {
+											Description: "enable rotation within",
+											Type:        schema.TypeBool,
+											Computed:    true,
+										},
+										"delay_minutes": {
+											Description: "repeat after minutes",
+											Type:        schema.TypeInt,
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"repeat": {
+					Description: "repeat this rule",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"times": {
+								Description: "repeat times",
+								Type:        schema.TypeInt,
+								Computed:    true,
+							},
+							"delay_minutes": {
+								Description: "repeat after minutes",
+								Type:        schema.TypeInt,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
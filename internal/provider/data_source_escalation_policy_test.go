@@ -4,17 +4,16 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccDataSourceEscalationPolicy(t *testing.T) {
-	escalationPolicyName := acctest.RandomWithPrefix("escalation_policy")
+	escalationPolicyName := testAccName("escalation_policy")
 
 	resourceName := "data.squadcast_escalation_policy.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataSourceEscalationPolicyConfig(escalationPolicyName),
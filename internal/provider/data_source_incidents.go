@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var incidentStatuses = []string{"triggered", "acknowledged", "resolved", "suppressed"}
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the incidents of a team, filtered by service, status, creation time and tags, " +
+			"e.g. to check that a `squadcast_test_incident` was escalated.",
+		ReadContext: dataSourceIncidentsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Team id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"service_ids": {
+				Description: "Only return incidents of these services.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"statuses": {
+				Description: "Only return incidents with these statuses. Supported values are `triggered`, `acknowledged`, `resolved` and `suppressed`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+				},
+			},
+			"start_time": {
+				Description:  "Only return incidents created at or after this time, in RFC 3339 format, e.g. `2023-01-02T15:04:05Z`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Description:  "Only return incidents created before this time, in RFC 3339 format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tags": {
+				Description: "Only return incidents having all of these tags.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"limit": {
+				Description:  "Maximum number of incidents to return, the most recent first. Defaults to `100`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"incidents": {
+				Description: "List of matching incidents.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Incident id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"service_id": {
+							Description: "Service id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"message": {
+							Description: "Message of the incident.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the incident.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the incident.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "Incident tags.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Description: "Creation time of the incident.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resolved_at": {
+							Description: "Resolution time of the incident, empty while it is not resolved.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIncidentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	filter := &api.IncidentFilter{
+		ServiceIDs: tf.ListToSlice[string](d.Get("service_ids")),
+		Statuses:   tf.ListToSlice[string](d.Get("statuses")),
+		StartTime:  d.Get("start_time").(string),
+		EndTime:    d.Get("end_time").(string),
+		Tags:       tf.ExpandStringMap(d.Get("tags")),
+		Limit:      d.Get("limit").(int),
+	}
+
+	tflog.Info(ctx, "Reading incidents", tf.M{
+		"team_id": teamID,
+	})
+	incidents, err := client.ListIncidents(ctx, teamID, filter)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	encoded, err := tf.EncodeSlice(incidents)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(teamID)
+	if err = d.Set("incidents", encoded); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
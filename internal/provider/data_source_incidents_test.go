@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDataSourceIncidentsFilters(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"data":[{"id":"64a5c1e8b2a1f0a1b2c3d4e5","service_id":"61305a8eb7a2fa0e44cfd0f5","message":"Synthetic escalation check","status":"triggered","tags":{"synthetic":"true"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	d := dataSourceIncidents().TestResourceData()
+	d.Set("team_id", "613611c1eb22db455cfa789f")
+	d.Set("service_ids", []any{"61305a8eb7a2fa0e44cfd0f5"})
+	d.Set("statuses", []any{"triggered", "acknowledged"})
+	d.Set("start_time", "2023-07-05T19:00:00Z")
+	d.Set("tags", map[string]any{"synthetic": "true"})
+	d.Set("limit", 10)
+
+	if diags := dataSourceIncidentsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	want := "limit=10&owner_id=613611c1eb22db455cfa789f&service_id=61305a8eb7a2fa0e44cfd0f5&start_time=2023-07-05T19%3A00%3A00Z&status=triggered&status=acknowledged&tags=synthetic%3Atrue"
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+	if d.Get("incidents.#") != 1 || d.Get("incidents.0.tags.synthetic") != "true" || d.Get("incidents.0.status") != "triggered" {
+		t.Errorf("unexpected incidents %v", d.Get("incidents"))
+	}
+}
+
+func TestAccDataSourceIncidents(t *testing.T) {
+	resourceName := "data.squadcast_incidents.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTestIncidentConfig("1") + `
+data "squadcast_incidents" "test" {
+	team_id = squadcast_test_incident.test.team_id
+	service_ids = [squadcast_test_incident.test.service_id]
+	statuses = ["triggered"]
+	tags = {
+		synthetic = "true"
+	}
+}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrPair(resourceName, "incidents.0.id", "squadcast_test_incident.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "incidents.0.status", "triggered"),
+					resource.TestCheckResourceAttr(resourceName, "incidents.0.tags.synthetic", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func onCallParticipantsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "Participant id.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"type": {
+					Description: "Participant type (user, team, squad).",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOnCall() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get who is currently on-call on a schedule or on the first level of an escalation policy, and optionally the upcoming shifts, e.g. to feed dashboards or Slack channel topics.",
+
+		ReadContext: dataSourceOnCallRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:  "Schedule id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"schedule_id", "escalation_policy_id"},
+			},
+			"escalation_policy_id": {
+				Description:  "Escalation policy id. The targets of its first level are taken into account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				RequiredWith: []string{"team_id"},
+			},
+			"team_id": {
+				Description:  "Team id of the escalation policy.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"next_shifts": {
+				Description:  "Number of upcoming shifts to return in `shifts`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"lookahead_days": {
+				Description:  "Number of days to look ahead for the upcoming shifts.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 90),
+			},
+			"on_call": onCallParticipantsSchema("Participants currently on-call."),
+			"shifts": {
+				Description: "Upcoming shifts, ordered by start time.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_id": {
+							Description: "Id of the schedule of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_time": {
+							Description: "Start of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_time": {
+							Description: "End of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participants": onCallParticipantsSchema("Participants on-call during the shift."),
+					},
+				},
+			},
+		},
+	}
+}
+
+type onCallShift struct {
+	ScheduleID   string            `tf:"schedule_id"`
+	StartTime    string            `tf:"start_time"`
+	EndTime      string            `tf:"end_time"`
+	Participants []api.Participant `tf:"-"`
+
+	start time.Time
+	end   time.Time
+}
+
+func (s *onCallShift) Encode() (tf.M, error) {
+	m, err := tf.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := tf.EncodeSlice(s.Participants)
+	if err != nil {
+		return nil, err
+	}
+	m["participants"] = participants
+
+	return m, nil
+}
+
+// findOnCall returns the distinct participants of the shifts active at now and the first next shifts starting after now.
+func findOnCall(shifts []*onCallShift, now time.Time, next int) ([]api.Participant, []*onCallShift) {
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].start.Before(shifts[j].start)
+	})
+
+	current := make([]api.Participant, 0)
+	upcoming := make([]*onCallShift, 0, next)
+	for _, shift := range shifts {
+		switch {
+		case !shift.start.After(now) && shift.end.After(now):
+			for _, participant := range shift.Participants {
+				current = appendParticipant(current, participant)
+			}
+		case shift.start.After(now) && len(upcoming) < next:
+			upcoming = append(upcoming, shift)
+		}
+	}
+
+	return current, upcoming
+}
+
+// listOnCallShifts lists the shifts of the schedule, only those of the given rotation unless rotationID is 0.
+func listOnCallShifts(ctx context.Context, client *api.Client, scheduleID string, rotationID int, from, till time.Time) ([]*onCallShift, error) {
+	tflog.Info(ctx, "Reading schedule events", tf.M{
+		"schedule_id": scheduleID,
+	})
+	events, err := client.ListScheduleV2Events(ctx, scheduleID, from.Format(time.RFC3339), till.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	shifts := make([]*onCallShift, 0, len(events))
+	for _, event := range events {
+		if rotationID != 0 && event.RotationID != rotationID {
+			continue
+		}
+
+		start, err := time.Parse(time.RFC3339, event.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time of schedule %s event: %w", scheduleID, err)
+		}
+		end, err := time.Parse(time.RFC3339, event.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time of schedule %s event: %w", scheduleID, err)
+		}
+
+		shifts = append(shifts, &onCallShift{
+			ScheduleID:   scheduleID,
+			StartTime:    event.StartTime,
+			EndTime:      event.EndTime,
+			Participants: event.Participants,
+			start:        start,
+			end:          end,
+		})
+	}
+
+	return shifts, nil
+}
+
+func dataSourceOnCallRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+	now := time.Now().UTC().Truncate(time.Second)
+	till := now.AddDate(0, 0, d.Get("lookahead_days").(int))
+
+	var id string
+	var shifts []*onCallShift
+	// Participants which are always on-call, i.e. users, squads or teams targeted directly by the escalation policy.
+	var permanent []api.Participant
+
+	if scheduleID, ok := d.GetOk("schedule_id"); ok {
+		id = scheduleID.(string)
+
+		scheduleShifts, err := listOnCallShifts(ctx, client, id, 0, now, till)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		shifts = scheduleShifts
+	} else {
+		id = d.Get("escalation_policy_id").(string)
+
+		tflog.Info(ctx, "Reading escalation policy", tf.M{
+			"id": id,
+		})
+		escalationPolicy, err := client.GetEscalationPolicyById(ctx, d.Get("team_id").(string), id)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		if len(escalationPolicy.Rules) > 0 {
+			for _, target := range escalationPolicy.Rules[0].Targets {
+				switch target.Type {
+				case "user", "squad", "team":
+					permanent = append(permanent, api.Participant{ID: target.ID, Type: target.Type})
+				case "schedulev2":
+					scheduleShifts, err := listOnCallShifts(ctx, client, fmt.Sprintf("%d", target.PID), target.RotationID, now, till)
+					if err != nil {
+						return diagFromErr(err)
+					}
+					shifts = append(shifts, scheduleShifts...)
+				default:
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Warning,
+						Summary:       fmt.Sprintf("Escalation policy target of type %s is not supported", target.Type),
+						Detail:        fmt.Sprintf("The on-call participants of the %s %s are not included, only users, squads, teams and schedules (v2) are supported.", target.Type, target.ID),
+						AttributePath: cty.GetAttrPath("escalation_policy_id"),
+					})
+				}
+			}
+		}
+	}
+
+	current, upcoming := findOnCall(shifts, now, d.Get("next_shifts").(int))
+	for _, participant := range permanent {
+		current = appendParticipant(current, participant)
+	}
+
+	onCall, err := tf.EncodeSlice(current)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	mshifts, err := tf.EncodeSlice(upcoming)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(id)
+	if err = d.Set("on_call", onCall); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("shifts", mshifts); err != nil {
+		return diagFromErr(err)
+	}
+
+	return diags
+}
+
+func appendParticipant(participants []api.Participant, participant api.Participant) []api.Participant {
+	for _, p := range participants {
+		if p == participant {
+			return participants
+		}
+	}
+
+	return append(participants, participant)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestFindOnCall(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2032, 6, 1, hour, 0, 0, 0, time.UTC)
+	}
+	u1 := api.Participant{ID: "u1", Type: "user"}
+	u2 := api.Participant{ID: "u2", Type: "user"}
+	s1 := api.Participant{ID: "s1", Type: "squad"}
+
+	shifts := []*onCallShift{
+		{ScheduleID: "1", Participants: []api.Participant{u2}, start: at(16), end: at(20)},
+		{ScheduleID: "1", Participants: []api.Participant{u1}, start: at(0), end: at(8)},
+		{ScheduleID: "2", Participants: []api.Participant{u1, s1}, start: at(6), end: at(12)},
+		{ScheduleID: "1", Participants: []api.Participant{u2}, start: at(8), end: at(16)},
+		{ScheduleID: "2", Participants: []api.Participant{u2}, start: at(12), end: at(18)},
+	}
+
+	current, upcoming := findOnCall(shifts, at(7), 2)
+
+	if fmt.Sprint(current) != fmt.Sprint([]api.Participant{u1, s1}) {
+		t.Errorf("expected on-call %v, got %v", []api.Participant{u1, s1}, current)
+	}
+	if len(upcoming) != 2 {
+		t.Fatalf("expected 2 upcoming shifts, got %d", len(upcoming))
+	}
+	if !upcoming[0].start.Equal(at(8)) || !upcoming[1].start.Equal(at(12)) {
+		t.Errorf("expected upcoming shifts at 08:00 and 12:00, got %s and %s", upcoming[0].start, upcoming[1].start)
+	}
+
+	current, upcoming = findOnCall(shifts, at(8), 0)
+
+	if fmt.Sprint(current) != fmt.Sprint([]api.Participant{u1, s1, u2}) {
+		t.Errorf("expected on-call %v, got %v", []api.Participant{u1, s1, u2}, current)
+	}
+	if len(upcoming) != 0 {
+		t.Errorf("expected no upcoming shifts, got %d", len(upcoming))
+	}
+}
+
+func TestAccDataSourceOnCall(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_on_call.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOnCallDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "on_call.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_call.0.id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "on_call.0.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "shifts.0.schedule_id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "shifts.0.start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "shifts.0.end_time"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participants.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participants.0.id", "5f8891527f735f0a6646f3b6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOnCallDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_rotation_v2" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			name = "always on-call"
+			start_date = "2022-01-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 0
+				start_minute = 0
+				duration = 1440
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+		}
+
+		data "squadcast_on_call" "test" {
+			schedule_id = squadcast_schedule_rotation_v2.test.schedule_id
+			next_shifts = 2
+			lookahead_days = 7
+		}
+	`, scheduleName)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceOncallShiftHistory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get who was on-call when in a schedule (v2) over a past period, with the total time each participant was on-call, e.g. to feed compensation or audit tooling from Terraform outputs. " +
+			"The part of the period after the time of the read is ignored, use `squadcast_schedule_forecast` for the upcoming shifts.",
+
+		ReadContext: dataSourceOncallShiftHistoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"participant_id": {
+				Description:  "id of a user, squad or team. If set, only the shifts of this participant are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"from": {
+				Description:  "Start of the period, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End of the period, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"shifts": {
+				Description: "Shifts of the participants, ordered by start time. Shifts overlapping the period are clipped to it.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_id": {
+							Description: "id of the rotation of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participant_type": {
+							Description: "Participant type (user, team, squad).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participant_id": {
+							Description: "Participant id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_time": {
+							Description: "Start of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_time": {
+							Description: "End of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"duration_minutes": {
+							Description: "Duration of the shift in minutes.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"participants": {
+				Description: "Time on-call of each participant over the period, ordered by participant type and id.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"participant_type": {
+							Description: "Participant type (user, team, squad).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participant_id": {
+							Description: "Participant id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"shift_count": {
+							Description: "Number of shifts of the participant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"total_minutes": {
+							Description: "Total time on-call of the participant in minutes. Overlapping shifts of different rotations are counted separately.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type shiftHistoryParticipant struct {
+	ParticipantType string `tf:"participant_type"`
+	ParticipantID   string `tf:"participant_id"`
+	ShiftCount      int    `tf:"shift_count"`
+	TotalMinutes    int    `tf:"total_minutes"`
+}
+
+func (p *shiftHistoryParticipant) Encode() (tf.M, error) {
+	return tf.Encode(p)
+}
+
+// summarizeShiftHistory totals the shifts of every participant.
+func summarizeShiftHistory(shifts []*scheduleShift) []*shiftHistoryParticipant {
+	byParticipant := map[string]*shiftHistoryParticipant{}
+	for _, shift := range shifts {
+		key := shift.ParticipantType + "/" + shift.ParticipantID
+		p, ok := byParticipant[key]
+		if !ok {
+			p = &shiftHistoryParticipant{ParticipantType: shift.ParticipantType, ParticipantID: shift.ParticipantID}
+			byParticipant[key] = p
+		}
+		p.ShiftCount++
+		p.TotalMinutes += int(shift.end.Sub(shift.start).Minutes())
+	}
+
+	participants := make([]*shiftHistoryParticipant, 0, len(byParticipant))
+	for _, p := range byParticipant {
+		participants = append(participants, p)
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		if participants[i].ParticipantType != participants[j].ParticipantType {
+			return participants[i].ParticipantType < participants[j].ParticipantType
+		}
+		return participants[i].ParticipantID < participants[j].ParticipantID
+	})
+
+	return participants
+}
+
+// shiftHistoryPeriod returns the part of the period before now, it fails when the period starts in the future.
+func shiftHistoryPeriod(from time.Time, till time.Time, now time.Time) (time.Time, error) {
+	if !till.After(from) {
+		return time.Time{}, fmt.Errorf("till must be after from")
+	}
+	if !from.Before(now) {
+		return time.Time{}, fmt.Errorf("from must be in the past, use squadcast_schedule_forecast for the upcoming shifts")
+	}
+	if till.After(now) {
+		till = now.Truncate(time.Minute)
+	}
+
+	return till, nil
+}
+
+func dataSourceOncallShiftHistoryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID := d.Get("schedule_id").(string)
+	participantID := d.Get("participant_id").(string)
+	from, _ := time.Parse(time.RFC3339, d.Get("from").(string))
+	till, _ := time.Parse(time.RFC3339, d.Get("till").(string))
+	till, err := shiftHistoryPeriod(from, till, time.Now())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Reading schedule events", tf.M{
+		"schedule_id": scheduleID,
+		"from":        from.Format(time.RFC3339),
+		"till":        till.Format(time.RFC3339),
+	})
+	events, err := client.ListScheduleV2Events(ctx, scheduleID, from.Format(time.RFC3339), till.Format(time.RFC3339))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	shifts, err := forecastScheduleShifts(events, "", from, till)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if participantID != "" {
+		filtered := make([]*scheduleShift, 0, len(shifts))
+		for _, shift := range shifts {
+			if shift.ParticipantID == participantID {
+				filtered = append(filtered, shift)
+			}
+		}
+		shifts = filtered
+	}
+
+	mshifts := make([]any, 0, len(shifts))
+	for _, shift := range shifts {
+		m, err := shift.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		m["duration_minutes"] = int(shift.end.Sub(shift.start).Minutes())
+		mshifts = append(mshifts, m)
+	}
+	mparticipants, err := tf.EncodeSlice(summarizeShiftHistory(shifts))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", scheduleID, participantID, d.Get("from").(string), d.Get("till").(string)))
+	if err = d.Set("shifts", mshifts); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("participants", mparticipants); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
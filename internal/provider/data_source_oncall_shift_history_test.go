@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSummarizeShiftHistory(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2023, 6, 1, hour, 0, 0, 0, time.UTC)
+	}
+	shift := func(participantType string, participantID string, start int, end int) *scheduleShift {
+		return &scheduleShift{RotationID: "1", ParticipantType: participantType, ParticipantID: participantID, start: at(start), end: at(end)}
+	}
+
+	participants := summarizeShiftHistory([]*scheduleShift{
+		shift("user", "u2", 0, 8),
+		shift("user", "u1", 8, 20),
+		shift("squad", "s1", 8, 20),
+		shift("user", "u2", 20, 22),
+	})
+
+	var got []string
+	for _, p := range participants {
+		got = append(got, fmt.Sprintf("%s/%s %d %d", p.ParticipantType, p.ParticipantID, p.ShiftCount, p.TotalMinutes))
+	}
+	want := []string{"squad/s1 1 720", "user/u1 1 720", "user/u2 2 600"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("participants = %v, want %v", got, want)
+	}
+}
+
+func TestShiftHistoryPeriod(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 30, 15, 0, time.UTC)
+
+	till, err := shiftHistoryPeriod(now.Add(-48*time.Hour), now.Add(24*time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !till.Equal(time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("expected the period to end now, got %s", till)
+	}
+
+	if _, err := shiftHistoryPeriod(now.Add(time.Hour), now.Add(2*time.Hour), now); err == nil {
+		t.Error("expected an error for a period in the future")
+	}
+	if _, err := shiftHistoryPeriod(now.Add(-time.Hour), now.Add(-2*time.Hour), now); err == nil {
+		t.Error("expected an error for a period ending before it starts")
+	}
+}
+
+func TestAccDataSourceOncallShiftHistory(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_oncall_shift_history.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOncallShiftHistoryDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participant_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participant_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.start_time", "2023-06-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.end_time", "2023-06-01T22:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.duration_minutes", "720"),
+					resource.TestCheckResourceAttr(resourceName, "participants.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "participants.0.shift_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "participants.0.total_minutes", "720"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOncallShiftHistoryDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_rotation_v2" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			name = "primary"
+			start_date = "2023-06-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 10
+				start_minute = 30
+				duration = 720
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+			ends_after_iterations = 1
+		}
+
+		data "squadcast_oncall_shift_history" "test" {
+			schedule_id = squadcast_schedule_rotation_v2.test.schedule_id
+			from = "2023-06-01T00:00:00Z"
+			till = "2023-06-02T00:00:00Z"
+		}
+	`, scheduleName)
+}
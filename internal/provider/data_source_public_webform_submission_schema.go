@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourcePublicWebformSubmissionSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the [JSON Schema](https://json-schema.org) of the submissions of a public webform, " +
+			"e.g. to generate the forms of a customer portal or a chatbot that submit to the webform from the same configuration as the webform. " +
+			"The schema is generated from the services, severities and input fields of the webform.",
+		ReadContext: dataSourcePublicWebformSubmissionSchemaRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Webform id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the Webform.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"public_url": {
+				Description: "Public URL of the Webform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"submission_url": {
+				Description: "URL the submissions are posted to, as JSON.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schema_json": {
+				Description: "JSON Schema of the submissions, with the services, severities and input field options of the webform as enums. " +
+					"The JSON is stable as long as the webform is not changed.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"required_fields": {
+				Description: "Fields every submission must have.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// webformSubmissionSchema returns the JSON Schema of the submissions of the webform, and its required fields.
+func webformSubmissionSchema(webform *api.Webform) (tf.M, []string) {
+	title := webform.Title
+	if title == "" {
+		title = webform.Name
+	}
+
+	properties := tf.M{
+		"title": tf.M{
+			"type":        "string",
+			"minLength":   1,
+			"description": "Title of the incident.",
+		},
+		"description": tf.M{
+			"type":        "string",
+			"description": "Description of the incident.",
+		},
+		"email": tf.M{
+			"type":        "string",
+			"format":      "email",
+			"description": "Email address of the submitter, notified about the incident.",
+		},
+	}
+	required := []string{"title"}
+
+	services := make([]api.WFService, len(webform.Services))
+	copy(services, webform.Services)
+	sort.SliceStable(services, func(i, j int) bool {
+		return services[i].Weight < services[j].Weight
+	})
+	if len(services) > 0 {
+		ids := make([]string, 0, len(services))
+		names := make([]string, 0, len(services))
+		property := tf.M{
+			"type":        "string",
+			"description": "id of the service the incident is created in.",
+		}
+		for _, service := range services {
+			ids = append(ids, service.ServiceId)
+			name := service.Alias
+			if name == "" {
+				name = service.Name
+			}
+			names = append(names, name)
+			if service.IsDefault {
+				property["default"] = service.ServiceId
+			}
+		}
+		property["enum"] = ids
+		property["x-enum-names"] = names
+		properties["service_id"] = property
+		if _, ok := property["default"]; !ok {
+			required = append(required, "service_id")
+		}
+	}
+
+	if len(webform.Severity) > 0 {
+		types := make([]string, 0, len(webform.Severity))
+		descriptions := make([]string, 0, len(webform.Severity))
+		for _, severity := range webform.Severity {
+			types = append(types, severity.Type)
+			descriptions = append(descriptions, severity.Description)
+		}
+		properties["severity"] = tf.M{
+			"type":         "string",
+			"description":  "Severity of the incident.",
+			"enum":         types,
+			"x-enum-names": descriptions,
+		}
+	}
+
+	if len(webform.InputField) > 0 {
+		fields := tf.M{}
+		labels := make([]string, 0, len(webform.InputField))
+		for _, inputField := range webform.InputField {
+			fields[inputField.Label] = tf.M{
+				"type": "string",
+				"enum": inputField.Options,
+			}
+			labels = append(labels, inputField.Label)
+		}
+		sort.Strings(labels)
+		properties["input_fields"] = tf.M{
+			"type":                 "object",
+			"description":          "Values of the input fields, added as tags to the incident.",
+			"properties":           fields,
+			"required":             labels,
+			"additionalProperties": false,
+		}
+		required = append(required, "input_fields")
+	}
+
+	return tf.M{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                title,
+		"description":          webform.Description,
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, required
+}
+
+func dataSourcePublicWebformSubmissionSchemaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading webform submission schema by name", tf.M{
+		"name": name,
+	})
+
+	webform, err := client.GetWebformByName(ctx, teamID.(string), name)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	submissionSchema, required := webformSubmissionSchema(webform)
+	schemaJSON, err := json.Marshal(submissionSchema)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(webform.ID), 10))
+	d.Set("public_url", webform.PublicUrl)
+	d.Set("submission_url", webform.SubmissionURL(client.IngestionBaseURL))
+	d.Set("schema_json", string(schemaJSON))
+	d.Set("required_fields", required)
+
+	return nil
+}
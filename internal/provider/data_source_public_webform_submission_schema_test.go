@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestWebformSubmissionSchema(t *testing.T) {
+	webform := &api.Webform{
+		Name:        "support",
+		Title:       "Report an issue",
+		Description: "Customer support",
+		Services: []api.WFService{
+			{ServiceId: "6389ba2ec31b7df1caecd579", Name: "API", Weight: 2},
+			{ServiceId: "6389ba2ec31b7df1caecd57a", Name: "Web", Alias: "Website", Weight: 1, IsDefault: true},
+		},
+		Severity: []api.WFSeverity{
+			{Type: "critical", Description: "Everything is down"},
+		},
+		InputField: []api.WFInputField{
+			{Label: "region", Options: []string{"eu", "us"}},
+			{Label: "plan", Options: []string{"free", "paid"}},
+		},
+	}
+
+	submissionSchema, required := webformSubmissionSchema(webform)
+	if want := []string{"title", "input_fields"}; !reflect.DeepEqual(required, want) {
+		t.Errorf("required = %v, want %v", required, want)
+	}
+
+	b, err := json.Marshal(submissionSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","additionalProperties":false,"description":"Customer support",` +
+		`"properties":{"description":{"description":"Description of the incident.","type":"string"},` +
+		`"email":{"description":"Email address of the submitter, notified about the incident.","format":"email","type":"string"},` +
+		`"input_fields":{"additionalProperties":false,"description":"Values of the input fields, added as tags to the incident.",` +
+		`"properties":{"plan":{"enum":["free","paid"],"type":"string"},"region":{"enum":["eu","us"],"type":"string"}},"required":["plan","region"],"type":"object"},` +
+		`"service_id":{"default":"6389ba2ec31b7df1caecd57a","description":"id of the service the incident is created in.",` +
+		`"enum":["6389ba2ec31b7df1caecd57a","6389ba2ec31b7df1caecd579"],"type":"string","x-enum-names":["Website","API"]},` +
+		`"severity":{"description":"Severity of the incident.","enum":["critical"],"type":"string","x-enum-names":["Everything is down"]},` +
+		`"title":{"description":"Title of the incident.","minLength":1,"type":"string"}},` +
+		`"required":["title","input_fields"],"title":"Report an issue","type":"object"}`
+	if string(b) != want {
+		t.Errorf("schema =\n%s\nwant\n%s", b, want)
+	}
+}
+
+func TestWebformSubmissionSchemaWithoutDefaultService(t *testing.T) {
+	webform := &api.Webform{
+		Name: "support",
+		Services: []api.WFService{
+			{ServiceId: "6389ba2ec31b7df1caecd579", Name: "API"},
+		},
+	}
+
+	submissionSchema, required := webformSubmissionSchema(webform)
+	if want := []string{"title", "service_id"}; !reflect.DeepEqual(required, want) {
+		t.Errorf("required = %v, want %v", required, want)
+	}
+	if title := submissionSchema["title"]; title != "support" {
+		t.Errorf("title = %v, want the name of the webform", title)
+	}
+}
+
+func TestAccDataSourcePublicWebformSubmissionSchema(t *testing.T) {
+	webformName := "webform-submission-schema"
+
+	resourceName := "data.squadcast_public_webform_submission_schema.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicWebformSubmissionSchemaDataSourceConfig(webformName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_webform.test_parent", "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "61305a9e127c63c6d2c8f76d"),
+					resource.TestCheckResourceAttr(resourceName, "name", webformName),
+					resource.TestCheckResourceAttrSet(resourceName, "public_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "submission_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema_json"),
+					resource.TestCheckResourceAttr(resourceName, "required_fields.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "required_fields.0", "title"),
+					resource.TestCheckResourceAttr(resourceName, "required_fields.1", "service_id"),
+					resource.TestCheckResourceAttr(resourceName, "required_fields.2", "input_fields"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPublicWebformSubmissionSchemaDataSourceConfig(webformName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_webform" "test_parent" {
+			name = "%s"
+			team_id = "61305a9e127c63c6d2c8f76d"
+			owner {
+				id = "61305a9e127c63c6d2c8f76d"
+				type = "team"
+				name = "Default Team"
+			}
+			header = "test header"
+			title = "test title"
+			description = "test description"
+			input_field {
+				label = "severity"
+				options = ["critical"]
+			}
+			services {
+				service_id = "6389ba2ec31b7df1caecd579"
+				name = "Test"
+			}
+			email_on = ["triggered"]
+		}
+
+		data "squadcast_public_webform_submission_schema" "test" {
+			name = squadcast_webform.test_parent.name
+			team_id = "61305a9e127c63c6d2c8f76d"
+		}
+	`, webformName)
+}
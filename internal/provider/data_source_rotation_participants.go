@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceRotationParticipants() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to expand the participants of the rotations of a schedule (v2), including the members of the squads and teams, into the list of users, " +
+			"e.g. to mirror on-call membership in access-control systems.",
+
+		ReadContext: dataSourceRotationParticipantsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"rotation_id": {
+				Description:  "id of the rotation. If not set, the participants of all the rotations of the schedule are expanded.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric rotation id"),
+			},
+			"users": {
+				Description: "Users participating in the rotations, directly or as members of a squad or team, in order of appearance.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "User id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "User email.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"first_name": {
+							Description: "User first name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_name": {
+							Description: "User last name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"emails": {
+				Description: "Emails of the `users`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// expandRotationParticipants returns the distinct ids of the users among the participants, replacing the squads and teams by their members.
+func expandRotationParticipants(participants []api.Participant, squadMembers, teamMembers map[string][]string) []string {
+	userIDs := make([]string, 0, len(participants))
+	seen := make(map[string]bool)
+	add := func(ids ...string) {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
+
+	for _, participant := range participants {
+		switch participant.Type {
+		case "user":
+			add(participant.ID)
+		case "squad":
+			add(squadMembers[participant.ID]...)
+		case "team":
+			add(teamMembers[participant.ID]...)
+		}
+	}
+
+	return userIDs
+}
+
+func dataSourceRotationParticipantsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID := d.Get("schedule_id").(string)
+	rotationID := d.Get("rotation_id").(string)
+
+	tflog.Info(ctx, "Reading schedule", tf.M{
+		"id": scheduleID,
+	})
+	schedule, err := client.GetScheduleV2ById(ctx, scheduleID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	found := rotationID == ""
+	var participants []api.Participant
+	for _, rotation := range schedule.Rotations {
+		if rotationID != "" && strconv.Itoa(rotation.ID) != rotationID {
+			continue
+		}
+		found = true
+		for _, group := range rotation.ParticipantGroups {
+			participants = append(participants, group.Participants...)
+		}
+	}
+	if !found {
+		return diag.Errorf("rotation %s not found in schedule %s", rotationID, scheduleID)
+	}
+
+	squadMembers := make(map[string][]string)
+	teamMembers := make(map[string][]string)
+	for _, participant := range participants {
+		switch participant.Type {
+		case "squad":
+			if _, ok := squadMembers[participant.ID]; ok {
+				continue
+			}
+			squad, err := client.GetSquadById(ctx, schedule.TeamID, participant.ID)
+			if err != nil {
+				return diagFromErr(err)
+			}
+			squadMembers[participant.ID] = squad.MemberIDs
+		case "team":
+			if _, ok := teamMembers[participant.ID]; ok {
+				continue
+			}
+			team, err := client.GetTeamById(ctx, participant.ID)
+			if err != nil {
+				return diagFromErr(err)
+			}
+			for _, member := range team.Members {
+				teamMembers[participant.ID] = append(teamMembers[participant.ID], member.UserID)
+			}
+		}
+	}
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	usersByID := make(map[string]*api.ResourceUser, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	musers := make([]any, 0)
+	emails := make([]string, 0)
+	for _, userID := range expandRotationParticipants(participants, squadMembers, teamMembers) {
+		user, ok := usersByID[userID]
+		if !ok {
+			continue
+		}
+		musers = append(musers, tf.M{
+			"id":         user.ID,
+			"email":      user.Email,
+			"first_name": user.FirstName,
+			"last_name":  user.LastName,
+		})
+		emails = append(emails, user.Email)
+	}
+
+	if rotationID != "" {
+		d.SetId(rotationID)
+	} else {
+		d.SetId(scheduleID)
+	}
+	if err = d.Set("users", musers); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("emails", emails); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestExpandRotationParticipants(t *testing.T) {
+	userIDs := expandRotationParticipants([]api.Participant{
+		{ID: "u1", Type: "user"},
+		{ID: "s1", Type: "squad"},
+		{ID: "t1", Type: "team"},
+		{ID: "u3", Type: "user"},
+		{ID: "s2", Type: "squad"},
+	}, map[string][]string{
+		"s1": {"u2", "u1"},
+	}, map[string][]string{
+		"t1": {"u3", "u4"},
+	})
+
+	expected := []string{"u1", "u2", "u3", "u4"}
+	if fmt.Sprint(userIDs) != fmt.Sprint(expected) {
+		t.Errorf("expected users %v, got %v", expected, userIDs)
+	}
+}
+
+func TestAccDataSourceRotationParticipants(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_rotation_participants.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationParticipantsDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_schedule_rotation_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.0.id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttrSet(resourceName, "users.0.email"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "emails.0", resourceName, "users.0.email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRotationParticipantsDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_rotation_v2" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			name = "primary"
+			start_date = "2032-06-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 10
+				start_minute = 30
+				duration = 720
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+		}
+
+		data "squadcast_rotation_participants" "test" {
+			schedule_id = squadcast_schedule_rotation_v2.test.schedule_id
+			rotation_id = squadcast_schedule_rotation_v2.test.id
+		}
+	`, scheduleName)
+}
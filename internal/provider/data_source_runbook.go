@@ -89,11 +89,11 @@ func dataSourceRunbookRead(ctx context.Context, d *schema.ResourceData, meta any
 	})
 	runbook, err := client.GetRunbookByName(ctx, teamID.(string), name.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(runbook, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -24,10 +24,20 @@ func dataSourceSchedule() *schema.Resource {
 				Computed:    true,
 			},
 			"name": {
-				Description:  "Name of the Schedule.",
+				Description:  "Name of the Schedule. Prefer `slug`, the lookup by name breaks once the schedule is renamed.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
+				ExactlyOneOf: []string{"name", "slug"},
+			},
+			"slug": {
+				Description:  "Slug of the Schedule, it does not change when the schedule is renamed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"name", "slug"},
 			},
 			"description": {
 				Description: "Detailed description about the schedule.",
@@ -52,27 +62,35 @@ func dataSourceSchedule() *schema.Resource {
 func dataSourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	name, ok := d.GetOk("name")
-	if !ok {
-		return diag.Errorf("invalid schedule name provided")
-	}
-
 	teamID, ok := d.GetOk("team_id")
 	if !ok {
 		return diag.Errorf("invalid team id provided")
 	}
 
-	tflog.Info(ctx, "Reading schedule by name", tf.M{
-		"name": name.(string),
-	})
-	schedule, err := client.GetScheduleByName(ctx, teamID.(string), name.(string))
+	var schedule *api.Schedule
+	var diags diag.Diagnostics
+	var err error
+	if slug, ok := d.GetOk("slug"); ok {
+		tflog.Info(ctx, "Reading schedule by slug", tf.M{
+			"slug": slug.(string),
+		})
+		schedule, err = client.GetScheduleBySlug(ctx, teamID.(string), slug.(string))
+	} else {
+		tflog.Info(ctx, "Reading schedule by name", tf.M{
+			"name": d.Get("name").(string),
+		})
+		schedule, err = client.GetScheduleByName(ctx, teamID.(string), d.Get("name").(string))
+		if err == nil {
+			diags = append(diags, scheduleLookedUpByNameWarning(schedule))
+		}
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(schedule, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	return nil
+	return diags
 }
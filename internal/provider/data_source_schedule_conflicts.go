@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceScheduleConflicts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to find users that are on-call on two or more of the given schedules at the same time (double-booking) within a time window. " +
+			"Only users that are direct participants of the rotations are taken into account.",
+		ReadContext: dataSourceScheduleConflictsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_ids": {
+				Description: "Ids of the schedules to compare.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"from": {
+				Description:  "Start of the window to check, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End of the window to check, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"conflicts": {
+				Description: "Overlapping on-call assignments of the same user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description: "Id of the double-booked user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schedule_ids": {
+							Description: "Ids of the two schedules the user is on-call on.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"start_time": {
+							Description: "Start of the overlap.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_time": {
+							Description: "End of the overlap.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type scheduleAssignment struct {
+	scheduleID string
+	userID     string
+	start      time.Time
+	end        time.Time
+}
+
+type scheduleConflict struct {
+	UserID      string   `tf:"user_id"`
+	ScheduleIDs []string `tf:"schedule_ids"`
+	StartTime   string   `tf:"start_time"`
+	EndTime     string   `tf:"end_time"`
+}
+
+func (c *scheduleConflict) Encode() (tf.M, error) {
+	return tf.Encode(c)
+}
+
+// findScheduleConflicts returns every overlap between assignments of the same user on different schedules.
+func findScheduleConflicts(assignments []scheduleAssignment) []*scheduleConflict {
+	sort.SliceStable(assignments, func(i, j int) bool {
+		if assignments[i].userID != assignments[j].userID {
+			return assignments[i].userID < assignments[j].userID
+		}
+		return assignments[i].start.Before(assignments[j].start)
+	})
+
+	conflicts := make([]*scheduleConflict, 0)
+	for i, a := range assignments {
+		for _, b := range assignments[i+1:] {
+			if b.userID != a.userID || !b.start.Before(a.end) {
+				break
+			}
+			if b.scheduleID == a.scheduleID {
+				continue
+			}
+
+			end := a.end
+			if b.end.Before(end) {
+				end = b.end
+			}
+			scheduleIDs := []string{a.scheduleID, b.scheduleID}
+			sort.Strings(scheduleIDs)
+
+			conflicts = append(conflicts, &scheduleConflict{
+				UserID:      a.userID,
+				ScheduleIDs: scheduleIDs,
+				StartTime:   b.start.UTC().Format(time.RFC3339),
+				EndTime:     end.UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	return conflicts
+}
+
+func dataSourceScheduleConflictsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleIDs := tf.ExpandStringList(d.Get("schedule_ids").([]any))
+	from := d.Get("from").(string)
+	till := d.Get("till").(string)
+
+	var assignments []scheduleAssignment
+	for _, scheduleID := range scheduleIDs {
+		tflog.Info(ctx, "Reading schedule events", tf.M{
+			"schedule_id": scheduleID,
+			"from":        from,
+			"till":        till,
+		})
+		events, err := client.ListScheduleV2Events(ctx, scheduleID, from, till)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		for _, event := range events {
+			start, err := time.Parse(time.RFC3339, event.StartTime)
+			if err != nil {
+				return diagFromErr(fmt.Errorf("invalid start time of schedule %s event: %w", scheduleID, err))
+			}
+			end, err := time.Parse(time.RFC3339, event.EndTime)
+			if err != nil {
+				return diagFromErr(fmt.Errorf("invalid end time of schedule %s event: %w", scheduleID, err))
+			}
+
+			for _, participant := range event.Participants {
+				if participant.Type != "user" {
+					continue
+				}
+				assignments = append(assignments, scheduleAssignment{
+					scheduleID: scheduleID,
+					userID:     participant.ID,
+					start:      start,
+					end:        end,
+				})
+			}
+		}
+	}
+
+	conflicts, err := tf.EncodeSlice(findScheduleConflicts(assignments))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", strings.Join(scheduleIDs, ","), from, till))
+	if err = d.Set("conflicts", conflicts); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFindScheduleConflicts(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2032, 6, 1, hour, 0, 0, 0, time.UTC)
+	}
+
+	conflicts := findScheduleConflicts([]scheduleAssignment{
+		{scheduleID: "1", userID: "u1", start: at(0), end: at(8)},
+		{scheduleID: "2", userID: "u1", start: at(6), end: at(12)},
+		{scheduleID: "1", userID: "u1", start: at(8), end: at(16)},
+		{scheduleID: "2", userID: "u2", start: at(0), end: at(8)},
+		{scheduleID: "1", userID: "u3", start: at(0), end: at(8)},
+		{scheduleID: "2", userID: "u3", start: at(8), end: at(16)},
+	})
+
+	if len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts, got %d", len(conflicts))
+	}
+
+	expected := []scheduleConflict{
+		{UserID: "u1", ScheduleIDs: []string{"1", "2"}, StartTime: "2032-06-01T06:00:00Z", EndTime: "2032-06-01T08:00:00Z"},
+		{UserID: "u1", ScheduleIDs: []string{"1", "2"}, StartTime: "2032-06-01T08:00:00Z", EndTime: "2032-06-01T12:00:00Z"},
+	}
+	for i, c := range conflicts {
+		e := expected[i]
+		if c.UserID != e.UserID || fmt.Sprint(c.ScheduleIDs) != fmt.Sprint(e.ScheduleIDs) || c.StartTime != e.StartTime || c.EndTime != e.EndTime {
+			t.Errorf("conflict %d: expected %+v, got %+v", i, e, *c)
+		}
+	}
+}
+
+func TestAccDataSourceScheduleConflicts(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_schedule_conflicts.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConflictsDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "conflicts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conflicts.0.user_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "conflicts.0.schedule_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "conflicts.0.start_time", "2032-06-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "conflicts.0.end_time", "2032-06-01T22:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleConflictsDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "primary" {
+			name = "%[1]s-primary"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_v2" "secondary" {
+			name = "%[1]s-secondary"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_rotation_v2" "primary" {
+			schedule_id = squadcast_schedule_v2.primary.id
+			name = "primary"
+			start_date = "2032-06-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 10
+				start_minute = 30
+				duration = 720
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+			ends_after_iterations = 1
+		}
+
+		resource "squadcast_schedule_rotation_v2" "secondary" {
+			schedule_id = squadcast_schedule_v2.secondary.id
+			name = "secondary"
+			start_date = "2032-06-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 10
+				start_minute = 30
+				duration = 720
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+			ends_after_iterations = 1
+		}
+
+		data "squadcast_schedule_conflicts" "test" {
+			schedule_ids = [
+				squadcast_schedule_rotation_v2.primary.schedule_id,
+				squadcast_schedule_rotation_v2.secondary.schedule_id,
+			]
+			from = "2032-06-01T00:00:00Z"
+			till = "2032-06-02T00:00:00Z"
+		}
+	`, scheduleName)
+}
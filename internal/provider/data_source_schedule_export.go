@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceScheduleExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the calendar (ICS) export of a schedule (v2), e.g. to distribute calendar subscription links.",
+
+		ReadContext: dataSourceScheduleExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"enabled": {
+				Description: "Whether the calendar export is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"ics_url": {
+				Description: "URL of the ICS feed of the schedule, including the overrides. Empty when the export is disabled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"webcal_url": {
+				Description: "The `ics_url` with the webcal scheme, to subscribe to the schedule from calendar apps.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceScheduleExportRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Get("schedule_id").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Reading schedule export", tf.M{
+		"schedule_id": scheduleID,
+	})
+	export, err := client.GetScheduleExport(ctx, scheduleID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(d.Get("schedule_id").(string))
+	if err = tf.EncodeAndSet(&export.ScheduleExport, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceScheduleExport(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_schedule_export.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleExportDataSourceConfig(scheduleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "ics_url", "squadcast_schedule_export.test", "ics_url"),
+					resource.TestCheckResourceAttrPair(resourceName, "webcal_url", "squadcast_schedule_export.test", "webcal_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleExportDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_export" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+		}
+
+		data "squadcast_schedule_export" "test" {
+			schedule_id = squadcast_schedule_export.test.schedule_id
+		}
+	`, scheduleName)
+}
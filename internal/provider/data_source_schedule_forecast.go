@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceScheduleForecast() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to preview the on-call shifts computed from the rotations of a schedule (v2) within a time window, and the gaps no one is on-call in, " +
+			"e.g. to check in a postcondition that a change of the rotations doesn't leave the schedule uncovered.",
+
+		ReadContext: dataSourceScheduleForecastRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"rotation_id": {
+				Description:  "id of the rotation. If not set, the shifts of all the rotations of the schedule are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric rotation id"),
+			},
+			"from": {
+				Description:  "Start of the window to forecast, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"till": {
+				Description:  "End of the window to forecast, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"shifts": {
+				Description: "On-call shifts of the participants, ordered by start time. Shifts overlapping the window are clipped to it.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_id": {
+							Description: "id of the rotation of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participant_type": {
+							Description: "Participant type (user, team, squad).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"participant_id": {
+							Description: "Participant id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_time": {
+							Description: "Start of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_time": {
+							Description: "End of the shift.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"gaps": {
+				Description: "Periods of the window no one is on-call in, ordered by start time.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Description: "Start of the gap.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_time": {
+							Description: "End of the gap.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type scheduleShift struct {
+	RotationID      string `tf:"rotation_id"`
+	ParticipantType string `tf:"participant_type"`
+	ParticipantID   string `tf:"participant_id"`
+	start           time.Time
+	end             time.Time
+}
+
+func (s *scheduleShift) Encode() (tf.M, error) {
+	m, err := tf.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+	m["start_time"] = s.start.UTC().Format(time.RFC3339)
+	m["end_time"] = s.end.UTC().Format(time.RFC3339)
+
+	return m, nil
+}
+
+type scheduleGap struct {
+	StartTime string `tf:"start_time"`
+	EndTime   string `tf:"end_time"`
+}
+
+func (g *scheduleGap) Encode() (tf.M, error) {
+	return tf.Encode(g)
+}
+
+// forecastScheduleShifts returns a shift for every participant of the events of the given rotation, or of all the
+// rotations when rotationID is empty, clipped to the window.
+func forecastScheduleShifts(events []*api.ScheduleEvent, rotationID string, from time.Time, till time.Time) ([]*scheduleShift, error) {
+	shifts := make([]*scheduleShift, 0)
+	for _, event := range events {
+		if rotationID != "" && strconv.Itoa(event.RotationID) != rotationID {
+			continue
+		}
+
+		start, err := time.Parse(time.RFC3339, event.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time of rotation %d event: %w", event.RotationID, err)
+		}
+		end, err := time.Parse(time.RFC3339, event.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time of rotation %d event: %w", event.RotationID, err)
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(till) {
+			end = till
+		}
+		if !start.Before(end) {
+			continue
+		}
+
+		for _, participant := range event.Participants {
+			shifts = append(shifts, &scheduleShift{
+				RotationID:      strconv.Itoa(event.RotationID),
+				ParticipantType: participant.Type,
+				ParticipantID:   participant.ID,
+				start:           start,
+				end:             end,
+			})
+		}
+	}
+
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].start.Before(shifts[j].start)
+	})
+
+	return shifts, nil
+}
+
+// findScheduleGaps returns the periods of the window that none of the shifts, sorted by start time, cover.
+func findScheduleGaps(shifts []*scheduleShift, from time.Time, till time.Time) []*scheduleGap {
+	gaps := make([]*scheduleGap, 0)
+	covered := from
+	for _, shift := range shifts {
+		if shift.start.After(covered) {
+			gaps = append(gaps, &scheduleGap{
+				StartTime: covered.UTC().Format(time.RFC3339),
+				EndTime:   shift.start.UTC().Format(time.RFC3339),
+			})
+		}
+		if shift.end.After(covered) {
+			covered = shift.end
+		}
+	}
+	if till.After(covered) {
+		gaps = append(gaps, &scheduleGap{
+			StartTime: covered.UTC().Format(time.RFC3339),
+			EndTime:   till.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return gaps
+}
+
+func dataSourceScheduleForecastRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID := d.Get("schedule_id").(string)
+	rotationID := d.Get("rotation_id").(string)
+	from, _ := time.Parse(time.RFC3339, d.Get("from").(string))
+	till, _ := time.Parse(time.RFC3339, d.Get("till").(string))
+	if !till.After(from) {
+		return diag.Errorf("till must be after from")
+	}
+
+	tflog.Info(ctx, "Reading schedule events", tf.M{
+		"schedule_id": scheduleID,
+		"from":        d.Get("from").(string),
+		"till":        d.Get("till").(string),
+	})
+	events, err := client.ListScheduleV2Events(ctx, scheduleID, d.Get("from").(string), d.Get("till").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	shifts, err := forecastScheduleShifts(events, rotationID, from, till)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	mshifts, err := tf.EncodeSlice(shifts)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	mgaps, err := tf.EncodeSlice(findScheduleGaps(shifts, from, till))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", scheduleID, rotationID, d.Get("from").(string), d.Get("till").(string)))
+	if err = d.Set("shifts", mshifts); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("gaps", mgaps); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestForecastScheduleShifts(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2032, 6, 1, hour, 0, 0, 0, time.UTC)
+	}
+	event := func(rotationID int, start int, end int, participants ...api.Participant) *api.ScheduleEvent {
+		return &api.ScheduleEvent{RotationID: rotationID, StartTime: at(start).Format(time.RFC3339), EndTime: at(end).Format(time.RFC3339), Participants: participants}
+	}
+	user := func(id string) api.Participant {
+		return api.Participant{ID: id, Type: "user"}
+	}
+
+	events := []*api.ScheduleEvent{
+		event(2, 6, 10, user("u2")),
+		event(1, 0, 8, user("u1"), api.Participant{ID: "s1", Type: "squad"}),
+		event(1, 12, 20, user("u1")),
+		event(1, 22, 23, user("u3")),
+	}
+
+	shifts, err := forecastScheduleShifts(events, "", at(2), at(22))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, s := range shifts {
+		got = append(got, fmt.Sprintf("%s/%s/%s %d-%d", s.RotationID, s.ParticipantType, s.ParticipantID, s.start.Hour(), s.end.Hour()))
+	}
+	want := []string{"1/user/u1 2-8", "1/squad/s1 2-8", "2/user/u2 6-10", "1/user/u1 12-20"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("shifts = %v, want %v", got, want)
+	}
+
+	gaps := findScheduleGaps(shifts, at(0), at(22))
+	expected := []scheduleGap{
+		{StartTime: "2032-06-01T00:00:00Z", EndTime: "2032-06-01T02:00:00Z"},
+		{StartTime: "2032-06-01T10:00:00Z", EndTime: "2032-06-01T12:00:00Z"},
+		{StartTime: "2032-06-01T20:00:00Z", EndTime: "2032-06-01T22:00:00Z"},
+	}
+	if len(gaps) != len(expected) {
+		t.Fatalf("expected %d gaps, got %d", len(expected), len(gaps))
+	}
+	for i, g := range gaps {
+		if *g != expected[i] {
+			t.Errorf("gap %d: expected %+v, got %+v", i, expected[i], *g)
+		}
+	}
+
+	shifts, err = forecastScheduleShifts(events, "2", at(0), at(22))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shifts) != 1 || shifts[0].ParticipantID != "u2" {
+		t.Errorf("expected only the shift of rotation 2, got %d shifts", len(shifts))
+	}
+}
+
+func TestAccDataSourceScheduleForecast(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "data.squadcast_schedule_forecast.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleForecastDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participant_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.participant_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.start_time", "2032-06-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "shifts.0.end_time", "2032-06-01T22:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "gaps.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "gaps.0.start_time", "2032-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "gaps.0.end_time", "2032-06-01T10:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "gaps.1.start_time", "2032-06-01T22:30:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "gaps.1.end_time", "2032-06-02T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScheduleForecastDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_rotation_v2" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			name = "primary"
+			start_date = "2032-06-01T00:00:00Z"
+			period = "daily"
+			shift_timeslots {
+				start_hour = 10
+				start_minute = 30
+				duration = 720
+			}
+			change_participants_frequency = 1
+			change_participants_unit = "rotation"
+			participant_groups {
+				participants {
+					id = "5f8891527f735f0a6646f3b6"
+					type = "user"
+				}
+			}
+			ends_after_iterations = 1
+		}
+
+		data "squadcast_schedule_forecast" "test" {
+			schedule_id = squadcast_schedule_rotation_v2.test.schedule_id
+			from = "2032-06-01T00:00:00Z"
+			till = "2032-06-02T00:00:00Z"
+		}
+	`, scheduleName)
+}
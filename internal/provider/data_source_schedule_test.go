@@ -1,20 +1,47 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
+func TestDataSourceScheduleRead_warnsOnNameLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":"1","name":"Primary","slug":"primary-schedule"}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := dataSourceSchedule()
+	for attribute, warnings := range map[string]int{"name": 1, "slug": 0} {
+		d := r.TestResourceData()
+		d.Set("team_id", "613611c1eb22db455cfa789f")
+		d.Set(attribute, map[string]string{"name": "Primary", "slug": "primary-schedule"}[attribute])
+
+		diags := r.ReadContext(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if d.Id() != "1" || len(diags) != warnings || warnings > 0 && diags[0].Severity != diag.Warning {
+			t.Errorf("expected the schedule read by %s with %d warnings, got %s and %v", attribute, warnings, d.Id(), diags)
+		}
+	}
+}
+
 func TestAccDataSourceSchedule(t *testing.T) {
-	scheduleName := acctest.RandomWithPrefix("schedule")
+	scheduleName := testAccName("schedule")
 
 	resourceName := "data.squadcast_schedule.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScheduleDataSourceConfig(scheduleName),
@@ -24,6 +51,9 @@ func TestAccDataSourceSchedule(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", scheduleName),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					resource.TestCheckResourceAttr(resourceName, "color", "#9900ef"),
+					resource.TestCheckResourceAttrPair(resourceName, "slug", "squadcast_schedule.test", "slug"),
+					resource.TestCheckResourceAttrPair("data.squadcast_schedule.by_slug", "id", "squadcast_schedule.test", "id"),
+					resource.TestCheckResourceAttr("data.squadcast_schedule.by_slug", "name", scheduleName),
 				),
 			},
 		},
@@ -42,5 +72,10 @@ data "squadcast_schedule" "test" {
 	name = squadcast_schedule.test.name
 	team_id = "613611c1eb22db455cfa789f"
 }
+
+data "squadcast_schedule" "by_slug" {
+	slug = squadcast_schedule.test.slug
+	team_id = "613611c1eb22db455cfa789f"
+}
 	`, scheduleName)
 }
@@ -13,7 +13,8 @@ import (
 func dataSourceScheduleV2() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Squadcast schedules](https://support.squadcast.com/docs/schedules) are used to manage on-call scheduling & determine who will be notified when an incident is triggered. " +
-			"Use this data source to get information about a specific schedule that you can use for other Squadcast resources.",
+			"Use this data source to get information about a specific schedule that you can use for other Squadcast resources. " +
+			"Unlike the schedules of `squadcast_schedule`, the schedules of the v2 API have no slug, so they are looked up by name, and the lookup breaks once the schedule is renamed.",
 		ReadContext: dataSourceScheduleV2Read,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -27,7 +28,7 @@ func dataSourceScheduleV2() *schema.Resource {
 				Required:    true,
 			},
 			"name": {
-				Description: "Name of the Schedule.",
+				Description: "Name of the Schedule. The v2 API has no slug to look schedules up by, update the name when the schedule is renamed.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
@@ -108,7 +109,7 @@ func dataSourceScheduleV2Read(ctx context.Context, d *schema.ResourceData, meta
 
 	schedules, err := client.GetScheduleV2ByName(ctx, teamID.(string), name.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if len(schedules.NewSchedule) == 0 {
@@ -117,7 +118,7 @@ func dataSourceScheduleV2Read(ctx context.Context, d *schema.ResourceData, meta
 	schedule := schedules.NewSchedule[0]
 
 	if err = tf.EncodeAndSet(schedule, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
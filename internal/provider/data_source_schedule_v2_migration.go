@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const scheduleV2MigrationDetail = "Moving the references of a legacy schedule, e.g. the targets of escalation policies, to its squadcast_schedule_v2 is one way: " +
+	"targets of type schedulev2 can not point back at the legacy schedule, and destroying the legacy squadcast_schedule once nothing references it can not be undone. " +
+	"Import the v2 schedule with squadcast_schedule_v2 and remove the legacy schedule from the state with `terraform state rm` to stop managing it without deleting it."
+
+func dataSourceScheduleV2Migration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to migrate the references of legacy schedules (`squadcast_schedule`) to the schedules v2 (`squadcast_schedule_v2`) Squadcast created from them. " +
+			"A legacy schedule is mapped to the v2 schedule of its team with the same name, the read fails when a legacy schedule has no v2 schedule or more than one. " +
+			"The data source does not change the schedules, it is only read.",
+		ReadContext: dataSourceScheduleV2MigrationRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Team id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id of the schedules.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"legacy_schedule_ids": {
+				Description: "Ids of the legacy schedules to migrate.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(legacyScheduleIDRegexp, "must be the id of a legacy squadcast_schedule"),
+				},
+			},
+			"schedule_ids": {
+				Description: "Ids of the v2 schedules, by id of legacy schedule.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// migrateLegacySchedules maps the ids of the legacy schedules to the ids of the v2 schedules of the same name.
+func migrateLegacySchedules(legacyScheduleIDs []string, legacySchedules []*api.Schedule, schedules []*api.NewSchedule) (map[string]string, diag.Diagnostics) {
+	names := make(map[string]string, len(legacySchedules))
+	for _, s := range legacySchedules {
+		names[s.ID] = s.Name
+	}
+	ids := make(map[string][]string, len(schedules))
+	for _, s := range schedules {
+		ids[s.Name] = append(ids[s.Name], strconv.Itoa(s.ID))
+	}
+
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("legacy_schedule_ids")
+	scheduleIDs := make(map[string]string, len(legacyScheduleIDs))
+	sort.Strings(legacyScheduleIDs)
+	for _, legacyScheduleID := range legacyScheduleIDs {
+		name, ok := names[legacyScheduleID]
+		if !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("legacy schedule %s not found in the team", legacyScheduleID),
+				AttributePath: path,
+			})
+			continue
+		}
+
+		switch matches := ids[name]; len(matches) {
+		case 0:
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("legacy schedule %s (%s) has no v2 schedule", legacyScheduleID, name),
+				Detail:        fmt.Sprintf("No squadcast_schedule_v2 named %q was found in the team. Migrate the schedule to schedules v2 in Squadcast, or create it with squadcast_schedule_v2, first.", name),
+				AttributePath: path,
+			})
+		case 1:
+			scheduleIDs[legacyScheduleID] = matches[0]
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("legacy schedule %s (%s) matches %d v2 schedules", legacyScheduleID, name, len(matches)),
+				Detail:        fmt.Sprintf("The squadcast_schedule_v2 %v of the team are all named %q, rename all but the one migrated from the legacy schedule.", matches, name),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return scheduleIDs, diags
+}
+
+func dataSourceScheduleV2MigrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Reading schedule v2 migration", tf.M{
+		"team_id": teamID,
+	})
+
+	legacySchedules, err := client.ListSchedules(ctx, teamID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	schedules, err := client.ListSchedulesV2(ctx, teamID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	scheduleIDs, diags := migrateLegacySchedules(tf.ExpandStringSet(d.Get("legacy_schedule_ids").(*schema.Set)), legacySchedules, schedules)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(teamID)
+	if err = d.Set("schedule_ids", scheduleIDs); err != nil {
+		return diagFromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%d legacy schedules mapped to schedules v2, the migration of their references is irreversible", len(scheduleIDs)),
+		Detail:   scheduleV2MigrationDetail,
+	}}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestMigrateLegacySchedules(t *testing.T) {
+	legacySchedules := []*api.Schedule{
+		{ID: "5f9a1b2c3d4e5f6a7b8c9d01", Name: "primary"},
+		{ID: "5f9a1b2c3d4e5f6a7b8c9d02", Name: "secondary"},
+		{ID: "5f9a1b2c3d4e5f6a7b8c9d03", Name: "database"},
+	}
+	schedules := []*api.NewSchedule{
+		{ID: 101, Name: "primary"},
+		{ID: 102, Name: "database"},
+		{ID: 103, Name: "database"},
+	}
+
+	scheduleIDs, diags := migrateLegacySchedules([]string{"5f9a1b2c3d4e5f6a7b8c9d01"}, legacySchedules, schedules)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %#v", diags)
+	}
+	if len(scheduleIDs) != 1 || scheduleIDs["5f9a1b2c3d4e5f6a7b8c9d01"] != "101" {
+		t.Errorf("unexpected schedule ids %v", scheduleIDs)
+	}
+
+	_, diags = migrateLegacySchedules([]string{"5f9a1b2c3d4e5f6a7b8c9d03", "5f9a1b2c3d4e5f6a7b8c9d02", "5f9a1b2c3d4e5f6a7b8c9d0f"}, legacySchedules, schedules)
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %#v", diags)
+	}
+	for i, summary := range []string{"has no v2 schedule", "matches 2 v2 schedules", "not found in the team"} {
+		if !strings.Contains(diags[i].Summary, summary) {
+			t.Errorf("expected diagnostic %d to contain %q, got %q", i, summary, diags[i].Summary)
+		}
+	}
+}
@@ -59,6 +59,11 @@ func dataSourceService() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"muted": {
+				Description: "Whether the notifications of the incidents of the service are muted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 			"dependencies": {
 				Description: "dependencies.",
 				Type:        schema.TypeSet,
@@ -87,6 +92,14 @@ func dataSourceService() *schema.Resource {
 					},
 				},
 			},
+			"labels": {
+				Description: "Metadata labels of the service, by key.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"tags": {
 				Description: "Service tags",
 				Type:        schema.TypeList,
@@ -127,6 +140,7 @@ func dataSourceService() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"metrics": serviceMetricsSchema(),
 		},
 	}
 }
@@ -149,17 +163,17 @@ func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any
 	})
 	service, err := client.GetServiceByName(ctx, teamID.(string), name.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	activeAlertSources, err := client.ListActiveAlertSources(ctx, service.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	alertSources, err := client.ListAlertSources(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var activeAlertSourcesMap = make(map[string]string, len(activeAlertSources.AlertSources))
@@ -176,7 +190,11 @@ func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any
 	service.AlertSources = alertSources.Available().EndpointMap(client.IngestionBaseURL, service)
 
 	if err = tf.EncodeAndSet(service, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if diags := setServiceMetrics(ctx, client, d, service.Owner.ID, service.ID); diags.HasError() {
+		return diags
 	}
 
 	return nil
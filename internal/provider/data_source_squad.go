@@ -63,11 +63,11 @@ func dataSourceSquadRead(ctx context.Context, d *schema.ResourceData, meta any)
 	})
 	squad, err := client.GetSquadByName(ctx, teamID.(string), name.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(squad, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
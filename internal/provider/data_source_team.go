@@ -99,11 +99,11 @@ func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	team, err := client.GetTeamByName(ctx, name.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(team, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
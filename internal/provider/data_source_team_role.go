@@ -66,11 +66,11 @@ func dataSourceTeamRoleRead(ctx context.Context, d *schema.ResourceData, meta an
 	})
 	teamRole, err := client.GetTeamRoleByName(ctx, team_id, teamRoleName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(teamRole, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
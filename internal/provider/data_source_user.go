@@ -134,11 +134,11 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	})
 	user, err := client.GetUserByEmail(ctx, email)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(user, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,6 +43,16 @@ func dataSourceWebform() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"mttr": {
+				Description: "Mean time to resolve the incidents created through the Webform over the last 30 days, in seconds. Not refreshed when `skip_analytics_refresh` is set on the provider.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"incident_count": {
+				Description: "Number of incidents created through the Webform over the last 30 days. Not refreshed when `skip_analytics_refresh` is set on the provider.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"owner": {
 				Description: "Form owner.",
 				Type:        schema.TypeList,
@@ -99,6 +110,30 @@ func dataSourceWebform() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"success_page": {
+				Description: "What the reporter is shown once the form is submitted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Description: "Thank-you message shown after the submission.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"redirect_url": {
+							Description: "URL the reporter is redirected to after the submission.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"show_incident_id": {
+							Description: "Whether the id of the created incident is shown to the reporter.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"tags": {
 				Description: "Webform Tags.",
 				Type:        schema.TypeMap,
@@ -128,6 +163,21 @@ func dataSourceWebform() *schema.Resource {
 							Type:        schema.TypeString,
 							Computed:    true,
 						},
+						"weight": {
+							Description: "Position of the service in the dropdown of the public form, lower weights are listed first.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"group": {
+							Description: "Heading under which the service is grouped in the dropdown of the public form.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default": {
+							Description: "Whether the service is selected by default in the dropdown of the public form.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -196,11 +246,15 @@ func dataSourceWebformRead(ctx context.Context, d *schema.ResourceData, meta any
 
 	webform, err := client.GetWebformByName(ctx, teamID.(string), name)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(webform, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if diags := setWebformAnalytics(ctx, client, d, webform.TeamID, strconv.FormatUint(uint64(webform.ID), 10)); diags.HasError() {
+		return diags
 	}
 
 	return nil
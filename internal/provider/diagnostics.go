@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// apiFieldAttributes maps the fields of the API requests to the attributes of the resources, when they are named differently.
+var apiFieldAttributes = map[string]string{
+	"owner_id": "team_id",
+}
+
+// graphQLFieldAttributes maps the fields of the GraphQL inputs to the attributes of the resources, when they are not
+// named like the snake case of the field.
+var graphQLFieldAttributes = map[string]string{
+	"shiftTimeSlots": "shift_timeslots",
+	"startMin":       "start_minute",
+	"timeZone":       "timezone",
+	"owner":          "entity_owner",
+}
+
+var apiFieldRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.([a-z_][a-z0-9_]*|[0-9]+))*$`)
+
+// diagFromErr is like diag.FromErr, the errors of the Squadcast API are converted to a diagnostic with the error code
+// of the API, pointing to the attribute the error is about when the API reports the field. The errors of the GraphQL
+// API are converted to a diagnostic each.
+func diagFromErr(err error) diag.Diagnostics {
+	var gqlErrs *api.GraphQLErrors
+	if errors.As(err, &gqlErrs) {
+		diags := make(diag.Diagnostics, 0, len(gqlErrs.Errors))
+		for _, gqlErr := range gqlErrs.Errors {
+			diags = append(diags, graphQLErrorDiagnostic(gqlErrs.Operation, gqlErr))
+		}
+		return diags
+	}
+
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{apiErrorDiagnostic(apiErr)}
+}
+
+// addFrameworkError adds the error to the diagnostics of a framework resource, like diagFromErr.
+func addFrameworkError(diags *fwdiag.Diagnostics, summary string, err error) {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	d := apiErrorDiagnostic(apiErr)
+	detail := d.Summary + "\n\n" + d.Detail
+	if apiErr.Field == "" || !apiFieldRegexp.MatchString(apiErr.Field) || strings.Contains(apiErr.Field, ".") {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	attribute := apiErr.Field
+	if a, ok := apiFieldAttributes[attribute]; ok {
+		attribute = a
+	}
+	diags.AddAttributeError(path.Root(attribute), summary, detail)
+}
+
+func apiErrorDiagnostic(err *api.Error) diag.Diagnostic {
+	summary := err.Message
+	if summary == "" {
+		summary = http.StatusText(err.StatusCode)
+	}
+	if err.Code != "" {
+		summary = fmt.Sprintf("%s (%s)", summary, err.Code)
+	}
+
+	detail := fmt.Sprintf("The Squadcast API returned %d %s for %s %s.", err.StatusCode, http.StatusText(err.StatusCode), err.Method, err.URL)
+	if err.Field != "" {
+		detail += fmt.Sprintf(" The error is about the field %s of the request.", err.Field)
+	}
+	if err.Description != "" {
+		detail += "\n\n" + err.Description
+	}
+	if err.Link != "" {
+		detail += "\n\nSee " + err.Link
+	}
+	if err.RequestID != "" {
+		detail += fmt.Sprintf("\n\nRequest id: %s, quote it when contacting the Squadcast support.", err.RequestID)
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: apiFieldPath(err.Field),
+	}
+}
+
+// apiFieldPath returns the path of the attribute of an API field, e.g. rules.0.targets for rules[0].targets, or nil
+// when the field is not a path.
+func apiFieldPath(field string) cty.Path {
+	field = strings.ReplaceAll(strings.ReplaceAll(field, "[", "."), "]", "")
+	if !apiFieldRegexp.MatchString(field) {
+		return nil
+	}
+
+	path := cty.Path{}
+	for _, step := range strings.Split(field, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+			continue
+		}
+		if attribute, ok := apiFieldAttributes[step]; ok {
+			step = attribute
+		}
+		path = path.GetAttr(step)
+	}
+
+	return path
+}
+
+func graphQLErrorDiagnostic(operation string, err *api.GraphQLError) diag.Diagnostic {
+	summary := err.Message
+	if err.Code != "" {
+		summary = fmt.Sprintf("%s (%s)", summary, err.Code)
+	}
+
+	detail := fmt.Sprintf("The Squadcast GraphQL API returned an error for a %s.", operation)
+	if err.Field != "" {
+		detail += fmt.Sprintf(" The error is about the field %s of the input.", err.Field)
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: graphQLFieldPath(err.Field),
+	}
+}
+
+// graphQLFieldPath returns the path of the attribute of a GraphQL input field, e.g. shift_timeslots.0.duration for
+// shiftTimeSlots[0].duration or input.shiftTimeSlots[0].duration, or nil when the field is not a path.
+func graphQLFieldPath(field string) cty.Path {
+	steps := strings.Split(strings.ReplaceAll(strings.ReplaceAll(field, "[", "."), "]", ""), ".")
+	if len(steps) > 1 && steps[0] == "input" {
+		steps = steps[1:]
+	}
+	for i, step := range steps {
+		if attribute, ok := graphQLFieldAttributes[step]; ok {
+			steps[i] = attribute
+			continue
+		}
+		steps[i] = snakeCase(step)
+	}
+
+	return apiFieldPath(strings.Join(steps, "."))
+}
+
+// snakeCase returns the snake case of a camel case name, e.g. team_id for teamID.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(name[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// withGraphQLFieldPrefix prefixes the fields of the errors of the GraphQL API, e.g. with rotations[1] for the errors of
+// the mutation of the second inline rotation of a schedule, whose fields are relative to the rotation.
+func withGraphQLFieldPrefix(err error, prefix string) error {
+	var gqlErrs *api.GraphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		return err
+	}
+
+	prefixed := &api.GraphQLErrors{Operation: gqlErrs.Operation}
+	for _, gqlErr := range gqlErrs.Errors {
+		gqlErr := *gqlErr
+		if gqlErr.Field != "" {
+			gqlErr.Field = prefix + "." + strings.TrimPrefix(gqlErr.Field, "input.")
+		}
+		prefixed.Errors = append(prefixed.Errors, &gqlErr)
+	}
+
+	return prefixed
+}
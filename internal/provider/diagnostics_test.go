@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hasura/go-graphql-client"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/squads":
+			w.Header().Set(api.RequestIDHeader, "req-123")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"meta":{"status":409,"error_message":"squad name already exists","error_details":{"code":"name_already_exists","errors":{"name":"must be unique in the team"}}}}`))
+		case "/v3/escalation-policies":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"meta":{"status":422,"error_message":"invalid owner","error_details":{"code":"invalid_owner","description":"The owner must be a member of the team.","errors":[{"field":"owner_id","message":"not found"}]}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+
+	_, err := api.Request[any, any](http.MethodPost, client.BaseURLV3+"/squads", client, context.Background(), nil)
+	diags := diagFromErr(err)
+	if len(diags) != 1 || diags[0].Summary != "squad name already exists (name_already_exists)" {
+		t.Fatalf("expected the name conflict, got %#v", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("name")) {
+		t.Errorf("expected the name attribute, got %#v", diags[0].AttributePath)
+	}
+	if !strings.Contains(diags[0].Detail, "Request id: req-123") {
+		t.Errorf("expected the request id in the diagnostic, got %q", diags[0].Detail)
+	}
+	if api.ErrorStatusCode(err) != http.StatusConflict {
+		t.Errorf("expected a conflict, got %d", api.ErrorStatusCode(err))
+	}
+
+	_, err = api.Request[any, any](http.MethodPost, client.BaseURLV3+"/escalation-policies", client, context.Background(), nil)
+	diags = diagFromErr(err)
+	if len(diags) != 1 || diags[0].Summary != "invalid owner (invalid_owner)" || !strings.Contains(diags[0].Detail, "The owner must be a member of the team.") {
+		t.Fatalf("expected the invalid owner, got %#v", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("team_id")) {
+		t.Errorf("expected the team_id attribute, got %#v", diags[0].AttributePath)
+	}
+
+	_, err = api.Request[any, any](http.MethodGet, client.BaseURLV3+"/services/1", client, context.Background(), nil)
+	if !api.IsResourceNotFoundError(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if diags = diagFromErr(err); len(diags) != 1 || diags[0].Summary != "Not Found" || diags[0].AttributePath != nil {
+		t.Errorf("expected a not found diagnostic without attribute, got %#v", diags)
+	}
+}
+
+func TestAPIFieldPath(t *testing.T) {
+	tests := map[string]cty.Path{
+		"name":                   cty.GetAttrPath("name"),
+		"owner_id":               cty.GetAttrPath("team_id"),
+		"rules.1.targets":        cty.GetAttrPath("rules").IndexInt(1).GetAttr("targets"),
+		"rules[0].delay_minutes": cty.GetAttrPath("rules").IndexInt(0).GetAttr("delay_minutes"),
+		"":                       nil,
+		"Name of the squad":      nil,
+	}
+
+	for field, expected := range tests {
+		if path := apiFieldPath(field); !path.Equals(expected) {
+			t.Errorf("%q: expected %#v, got %#v", field, expected, path)
+		}
+	}
+}
+
+func TestDiagFromGraphQLErr(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":null,"errors":[` +
+			`{"message":"duration must be at least 30 minutes","extensions":{"code":"BAD_USER_INPUT","field":"input.shiftTimeSlots[0].duration"}},` +
+			`{"message":"participant not found","extensions":{"field":"participantGroups[1].participants[0].ID"}},` +
+			`{"message":"internal error"}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{GraphQLClient: graphql.NewClient(server.URL, nil), ServiceAccountToken: "token"}
+
+	_, err := api.GraphQLRequest("mutate", client, context.Background(), &api.UpdateScheduleRotationMutateStruct{}, map[string]any{"ID": 1, "input": api.NewRotation{}})
+	diags := diagFromErr(err)
+	if len(diags) != 3 {
+		t.Fatalf("expected a diagnostic per error, got %#v", diags)
+	}
+	if diags[0].Summary != "duration must be at least 30 minutes (BAD_USER_INPUT)" || !diags[0].AttributePath.Equals(cty.GetAttrPath("shift_timeslots").IndexInt(0).GetAttr("duration")) {
+		t.Errorf("expected the duration of the first timeslot, got %#v", diags[0])
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("participant_groups").IndexInt(1).GetAttr("participants").IndexInt(0).GetAttr("id")) {
+		t.Errorf("expected the id of the participant, got %#v", diags[1].AttributePath)
+	}
+	if diags[2].Summary != "internal error" || diags[2].AttributePath != nil {
+		t.Errorf("expected an error without attribute, got %#v", diags[2])
+	}
+
+	diags = diagFromErr(withGraphQLFieldPrefix(err, "rotations[1]"))
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("rotations").IndexInt(1).GetAttr("shift_timeslots").IndexInt(0).GetAttr("duration")) {
+		t.Errorf("expected the duration of the first timeslot of the second rotation, got %#v", diags[0].AttributePath)
+	}
+	if diags[2].AttributePath != nil {
+		t.Errorf("expected the error without field not to be prefixed, got %#v", diags[2].AttributePath)
+	}
+}
+
+func TestGraphQLFieldPath(t *testing.T) {
+	tests := map[string]cty.Path{
+		"name":                             cty.GetAttrPath("name"),
+		"teamID":                           cty.GetAttrPath("team_id"),
+		"timeZone":                         cty.GetAttrPath("timezone"),
+		"shiftTimeSlots[2].startMin":       cty.GetAttrPath("shift_timeslots").IndexInt(2).GetAttr("start_minute"),
+		"input.changeParticipantsUnit":     cty.GetAttrPath("change_participants_unit"),
+		"rotations[0].endsAfterIterations": cty.GetAttrPath("rotations").IndexInt(0).GetAttr("ends_after_iterations"),
+		"":                                 nil,
+	}
+
+	for field, expected := range tests {
+		if path := graphQLFieldPath(field); !path.Equals(expected) {
+			t.Errorf("%q: expected %#v, got %#v", field, expected, path)
+		}
+	}
+}
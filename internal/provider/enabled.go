@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// enabledSchema is the schema of the enabled attribute of the objects that can be disabled without being deleted,
+// e.g. to pause the rules of a service during an incident. Toggling it is always an update in place, the attributes
+// named enabled must never force the recreation of a resource.
+func enabledSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description + " Toggling it updates the resource in place. Defaults to `true`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	}
+}
+
+// decodeEnabledHook is a mapstructure decode hook, it decodes the enabled attribute of the state into the field of
+// the API objects tagged `tf:"disabled"`, whose zero value is the enabled state.
+func decodeEnabledHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	m, ok := data.(tf.M)
+	if !ok {
+		return data, nil
+	}
+	enabled, ok := m["enabled"].(bool)
+	if !ok {
+		return data, nil
+	}
+
+	for to.Kind() == reflect.Pointer {
+		to = to.Elem()
+	}
+	if to.Kind() != reflect.Struct || !hasDisabledField(to) {
+		return data, nil
+	}
+
+	decoded := make(tf.M, len(m))
+	for k, v := range m {
+		decoded[k] = v
+	}
+	delete(decoded, "enabled")
+	decoded["disabled"] = !enabled
+
+	return decoded, nil
+}
+
+func hasDisabledField(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get(tf.EncoderStructTag), ",")
+		if name == "disabled" {
+			return true
+		}
+	}
+
+	return false
+}
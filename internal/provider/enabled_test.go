@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestDecodeEnabled(t *testing.T) {
+	mrules := []any{
+		tf.M{"is_basic": false, "expression": "payload.x == 1", "route_to_id": "61305a8eb7a2fa0e44cfd0f5", "route_to_type": "user", "enabled": true},
+		tf.M{"is_basic": false, "expression": "payload.x == 2", "route_to_id": "61305a8eb7a2fa0e44cfd0f5", "route_to_type": "user", "enabled": false},
+	}
+
+	var rules []*api.RoutingRule
+	if err := Decode(mrules, &rules); err != nil {
+		t.Fatal(err)
+	}
+	if rules[0].Disabled || !rules[1].Disabled {
+		t.Fatalf("expected only the second rule to be disabled, got %v and %v", rules[0].Disabled, rules[1].Disabled)
+	}
+
+	// Enabled rules are sent as before, so that they still match the rules of the API when they are merged.
+	b, err := json.Marshal(rules[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "disabled") {
+		t.Errorf("expected an enabled rule to be sent without disabled, got %s", b)
+	}
+
+	for i, rule := range rules {
+		m, err := rule.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := m["disabled"]; ok || m["enabled"] != mrules[i].(tf.M)["enabled"] {
+			t.Errorf("rule %d: expected enabled %v in the state, got %v", i, mrules[i].(tf.M)["enabled"], m)
+		}
+	}
+}
+
+func TestDecodeEnabledMissing(t *testing.T) {
+	var rule api.SuppressionRule
+	if err := json.Unmarshal([]byte(`{"is_basic":false,"expression":"payload.x == 1"}`), &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := rule.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["enabled"] != true {
+		t.Errorf("expected a rule the API does not report as disabled to be enabled, got %v", m["enabled"])
+	}
+}
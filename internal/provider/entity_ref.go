@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// entityRefSchema returns the attributes of a reference to a user, squad, team or schedule, e.g. an owner or a
+// participant, which is set either by id or by name. Users are referenced by email. entity names the reference in
+// the descriptions, e.g. "GER owner". The attributes can be extended, e.g. with the notification channels of targets.
+func entityRefSchema(entity string, types []string, validateID schema.SchemaValidateFunc) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Description:  fmt.Sprintf("%s type. (%s)", entity, strings.Join(types, " or ")),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(types, false),
+		},
+		"id": {
+			Description:  fmt.Sprintf("%s id. Exactly one of id and name must be set.", entity),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateID,
+		},
+		"name": {
+			Description: fmt.Sprintf("%s name, or email for users, looked up during apply instead of setting the id. Exactly one of id and name must be set.", entity),
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+}
+
+// entityRef is a reference found in the attributes of a resource, at the attribute path of its block,
+// e.g. participant_groups.0.participants.1.
+type entityRef struct {
+	attribute string
+	m         tf.M
+}
+
+// findEntityRefs returns the references of the list at path in m. The path may go through lists with `*`,
+// e.g. participant_groups.*.participants.
+func findEntityRefs(m tf.M, path string) []entityRef {
+	var refs []entityRef
+
+	var walk func(v any, attribute string, parts []string)
+	walk = func(v any, attribute string, parts []string) {
+		if len(parts) > 0 && parts[0] != "*" {
+			if mv, ok := v.(tf.M); ok {
+				walk(mv[parts[0]], joinAttributePath(attribute, parts[0]), parts[1:])
+			}
+			return
+		}
+
+		var list []tf.M
+		switch l := v.(type) {
+		case []any:
+			for _, e := range l {
+				elem, _ := e.(tf.M)
+				list = append(list, elem)
+			}
+		case []tf.M:
+			list = l
+		}
+		for i, elem := range list {
+			if elem == nil {
+				continue
+			}
+			if len(parts) == 0 {
+				refs = append(refs, entityRef{attribute: fmt.Sprintf("%s.%d", attribute, i), m: elem})
+			} else {
+				walk(elem, fmt.Sprintf("%s.%d", attribute, i), parts[1:])
+			}
+		}
+	}
+	walk(m, "", strings.Split(path, "."))
+
+	return refs
+}
+
+// entityRefByName reports whether the reference at attribute is configured by name rather than by id, and fails
+// unless exactly one of them is configured. References whose configuration is not known, e.g. in dynamic blocks
+// during plan or when importing, are considered configured by id.
+func entityRefByName(config cty.Value, attribute string) (bool, error) {
+	v := config
+	for _, part := range strings.Split(attribute, ".") {
+		if v.IsNull() || !v.IsKnown() {
+			return false, nil
+		}
+		if i, err := strconv.Atoi(part); err == nil {
+			if !v.Type().IsListType() && !v.Type().IsTupleType() || i >= v.LengthInt() {
+				return false, nil
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !v.Type().IsObjectType() || !v.Type().HasAttribute(part) {
+			return false, nil
+		}
+		v = v.GetAttr(part)
+	}
+	if v.IsNull() || !v.IsKnown() {
+		return false, nil
+	}
+
+	hasID := !v.GetAttr("id").IsNull()
+	hasName := !v.GetAttr("name").IsNull()
+	switch {
+	case hasID && hasName:
+		return false, fmt.Errorf("%s: only one of id and name can be set", attribute)
+	case !hasID && !hasName:
+		return false, fmt.Errorf("%s: one of id and name must be set", attribute)
+	}
+
+	return hasName, nil
+}
+
+// validateEntityRefs returns a CustomizeDiff function that makes sure exactly one of the id and the name of the
+// references at the given paths is configured.
+func validateEntityRefs(paths ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		config := d.GetRawConfig()
+		for _, path := range paths {
+			top, _, _ := strings.Cut(path, ".")
+			for _, ref := range findEntityRefs(tf.M{top: d.Get(top)}, path) {
+				if _, err := entityRefByName(config, ref.attribute); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// hasEntityRefsByName reports whether any of the references at the given paths of m is configured by name, e.g. to
+// only look up the team of a resource when it is needed to resolve them.
+func hasEntityRefsByName(d *schema.ResourceData, m tf.M, paths ...string) bool {
+	config := d.GetRawConfig()
+	for _, path := range paths {
+		for _, ref := range findEntityRefs(m, path) {
+			if byName, _ := entityRefByName(config, ref.attribute); byName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// resolveEntityRefs sets the id of the references at the given paths of m which are configured by name. The squads
+// and schedules are looked up in the team.
+func resolveEntityRefs(ctx context.Context, client *api.Client, d *schema.ResourceData, teamID string, m tf.M, paths ...string) error {
+	config := d.GetRawConfig()
+	for _, path := range paths {
+		for _, ref := range findEntityRefs(m, path) {
+			byName, err := entityRefByName(config, ref.attribute)
+			if err != nil {
+				return err
+			}
+			if !byName {
+				continue
+			}
+
+			entityType, _ := ref.m["type"].(string)
+			name, _ := ref.m["name"].(string)
+			tflog.Info(ctx, "Resolving reference by name", tf.M{
+				"attribute": ref.attribute,
+				"type":      entityType,
+				"name":      name,
+			})
+			id, err := client.ResolveEntityID(ctx, entityType, teamID, name)
+			if err != nil {
+				return fmt.Errorf("%s: unable to find the %s `%s`: %w", ref.attribute, entityType, name, err)
+			}
+			ref.m["id"] = id
+		}
+	}
+
+	return nil
+}
+
+// setEntityRefDisplayNames sets the `display_name` of the references at the given paths of m, resolved in batch from
+// their ids. The names are informative, a failure to resolve them is logged rather than failing the read.
+func setEntityRefDisplayNames(ctx context.Context, client *api.Client, teamID string, m tf.M, paths ...string) {
+	var refs []entityRef
+	var ids []api.EntityRef
+	for _, path := range paths {
+		for _, ref := range findEntityRefs(m, path) {
+			ref.m["display_name"] = ""
+			refs = append(refs, ref)
+			ids = append(ids, api.EntityRef{Type: fmt.Sprint(ref.m["type"]), ID: fmt.Sprint(ref.m["id"])})
+		}
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	names, err := client.ResolveEntityNames(ctx, teamID, ids)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to resolve the display names of references", tf.M{
+			"error": err.Error(),
+		})
+		return
+	}
+	for i, ref := range refs {
+		ref.m["display_name"] = names[ids[i]]
+	}
+}
+
+// preserveEntityRefNames copies the names of the references at the given paths from the state into the attributes
+// read from the API, which mostly only returns their ids, and the display name rather than the email of users.
+// A name is only kept while its reference still has the same id.
+func preserveEntityRefNames(d *schema.ResourceData, m tf.M, paths ...string) {
+	for _, path := range paths {
+		for _, ref := range findEntityRefs(m, path) {
+			name, _ := d.Get(ref.attribute + ".name").(string)
+			id, _ := d.Get(ref.attribute + ".id").(string)
+			if name != "" && id == fmt.Sprint(ref.m["id"]) {
+				ref.m["name"] = name
+			}
+		}
+	}
+}
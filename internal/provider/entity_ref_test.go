@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestFindEntityRefs(t *testing.T) {
+	m := tf.M{
+		"participant_groups": []any{
+			tf.M{"participants": []any{tf.M{"type": "user", "id": "a"}, tf.M{"type": "squad", "id": "b"}}},
+			tf.M{"participants": []any{}},
+			tf.M{"participants": []any{tf.M{"type": "team", "id": "c"}}},
+		},
+		"entity_owner": []tf.M{{"type": "team", "id": "d"}},
+	}
+
+	var attributes []string
+	for _, ref := range findEntityRefs(m, "participant_groups.*.participants") {
+		attributes = append(attributes, ref.attribute)
+	}
+	want := []string{"participant_groups.0.participants.0", "participant_groups.0.participants.1", "participant_groups.2.participants.0"}
+	if !reflect.DeepEqual(attributes, want) {
+		t.Errorf("attributes = %v, want %v", attributes, want)
+	}
+
+	if refs := findEntityRefs(m, "entity_owner"); len(refs) != 1 || refs[0].attribute != "entity_owner.0" || refs[0].m["id"] != "d" {
+		t.Errorf("unexpected entity_owner references %v", refs)
+	}
+}
+
+func TestEntityRefByName(t *testing.T) {
+	ref := func(id, name cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"entity_owner": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"type": cty.StringVal("squad"),
+				"id":   id,
+				"name": name,
+			})}),
+		})
+	}
+
+	cases := map[string]struct {
+		config cty.Value
+		byName bool
+		err    string
+	}{
+		"id":      {config: ref(cty.StringVal("61305a8eb7a2fa0e44cfd0f5"), cty.NullVal(cty.String))},
+		"name":    {config: ref(cty.NullVal(cty.String), cty.StringVal("Platform")), byName: true},
+		"unknown": {config: ref(cty.NullVal(cty.String), cty.UnknownVal(cty.String)), byName: true},
+		"both":    {config: ref(cty.StringVal("61305a8eb7a2fa0e44cfd0f5"), cty.StringVal("Platform")), err: "only one of id and name"},
+		"none":    {config: ref(cty.NullVal(cty.String), cty.NullVal(cty.String)), err: "one of id and name must be set"},
+		"import":  {config: cty.NullVal(cty.EmptyObject)},
+	}
+
+	for name, c := range cases {
+		byName, err := entityRefByName(c.config, "entity_owner.0")
+		if c.err != "" {
+			if err == nil || !regexp.MustCompile(c.err).MatchString(err.Error()) {
+				t.Errorf("%s: expected an error matching %q, got %v", name, c.err, err)
+			}
+			continue
+		}
+		if err != nil || byName != c.byName {
+			t.Errorf("%s: got %v, %v, want %v", name, byName, err, c.byName)
+		}
+	}
+}
+
+func TestResolveEntityRefs(t *testing.T) {
+	lookups := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+		if r.URL.Path != "/v3/squads/by-name" || r.URL.Query().Get("name") != "Platform" || r.URL.Query().Get("owner_id") != "613611c1eb22db455cfa789f" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"Platform"}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	for i := 0; i < 2; i++ {
+		d := resourceGER().TestResourceData()
+		d.Set("entity_owner", []any{tf.M{"type": "squad", "name": "Platform"}})
+
+		owners := d.Get("entity_owner").([]any)
+		if err := resolveEntityRefs(context.Background(), client, d, "613611c1eb22db455cfa789f", tf.M{"entity_owner": owners}, "entity_owner"); err != nil {
+			t.Fatal(err)
+		}
+		// Without a configuration, as when importing, the references are used by id.
+		if id := owners[0].(tf.M)["id"]; id != "" {
+			t.Errorf("expected the reference to be left as is without a configuration, got id %v", id)
+		}
+	}
+	if lookups != 0 {
+		t.Errorf("expected no lookup without a configuration, got %d", lookups)
+	}
+
+	for i := 0; i < 2; i++ {
+		id, err := client.ResolveEntityID(context.Background(), "squad", "613611c1eb22db455cfa789f", "Platform")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != "61305a8eb7a2fa0e44cfd0f5" {
+			t.Errorf("id = %s", id)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("expected the squad to be looked up once, got %d lookups", lookups)
+	}
+}
+
+func TestSetEntityRefDisplayNames(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/v3/users":
+			w.Write([]byte(`{"data":[{"id":"5f8891527f735f0a6646f3b6","first_name":"Ada","last_name":"Lovelace"},{"id":"5f8891527f735f0a6646f3b7","first_name":"Alan","last_name":"Turing"}]}`))
+		case "/v3/squads":
+			w.Write([]byte(`{"data":[{"id":"61305a8eb7a2fa0e44cfd0f5","name":"Platform"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	for i := 0; i < 2; i++ {
+		m := tf.M{"rules": []any{
+			tf.M{"targets": []any{
+				tf.M{"type": "user", "id": "5f8891527f735f0a6646f3b6"},
+				tf.M{"type": "squad", "id": "61305a8eb7a2fa0e44cfd0f5"},
+			}},
+			tf.M{"targets": []any{
+				tf.M{"type": "user", "id": "5f8891527f735f0a6646f3b7"},
+				// Deleted entities have no name.
+				tf.M{"type": "user", "id": "5f8891527f735f0a6646f3b8"},
+			}},
+		}}
+		setEntityRefDisplayNames(context.Background(), client, "613611c1eb22db455cfa789f", m, "rules.*.targets")
+
+		var names []string
+		for _, ref := range findEntityRefs(m, "rules.*.targets") {
+			names = append(names, ref.m["display_name"].(string))
+		}
+		if want := []string{"Ada Lovelace", "Platform", "Alan Turing", ""}; !reflect.DeepEqual(names, want) {
+			t.Errorf("expected the display names %q, got %q", want, names)
+		}
+	}
+
+	// The deleted user is not cached, the users are listed again for it.
+	if requests["/v3/users"] != 2 || requests["/v3/squads"] != 1 {
+		t.Errorf("expected the entities to be listed once per type and read, got %v", requests)
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The default plan modifiers plan the default value of an optional and computed attribute of a framework
+// resource when it is not configured, like the Default of the SDKv2 schemas.
+
+type defaultBoolModifier struct {
+	value bool
+}
+
+func defaultBool(value bool) planmodifier.Bool {
+	return defaultBoolModifier{value: value}
+}
+
+func (m defaultBoolModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to %t.", m.value)
+}
+
+func (m defaultBoolModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%t`.", m.value)
+}
+
+func (m defaultBoolModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.BoolValue(m.value)
+	}
+}
+
+type defaultStringModifier struct {
+	value string
+}
+
+func defaultString(value string) planmodifier.String {
+	return defaultStringModifier{value: value}
+}
+
+func (m defaultStringModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to %q.", m.value)
+}
+
+func (m defaultStringModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%s`.", m.value)
+}
+
+func (m defaultStringModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringValue(m.value)
+	}
+}
+
+type defaultInt64Modifier struct {
+	value int64
+}
+
+func defaultInt64(value int64) planmodifier.Int64 {
+	return defaultInt64Modifier{value: value}
+}
+
+func (m defaultInt64Modifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to %d.", m.value)
+}
+
+func (m defaultInt64Modifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%d`.", m.value)
+}
+
+func (m defaultInt64Modifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.Int64Value(m.value)
+	}
+}
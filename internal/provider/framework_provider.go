@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// NewProviderServer returns the protocol version 6 server of the provider. It muxes the given SDKv2 provider with
+// the provider of the resources migrated to terraform-plugin-framework, resources are moved from one to the other
+// one at a time.
+func NewProviderServer(ctx context.Context, version string, sdkProvider *schema.Provider) (tfprotov6.ProviderServer, error) {
+	upgradedSDKServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDKv2 provider comes first, the mux server configures the providers in order and the framework provider
+	// reuses its client.
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgradedSDKServer },
+		providerserver.NewProtocol6(&frameworkProvider{version: version, sdkProvider: sdkProvider}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer(), nil
+}
+
+// frameworkProvider is the provider of the resources migrated to terraform-plugin-framework. The provider
+// configuration stays with the SDKv2 provider until all the resources are migrated.
+type frameworkProvider struct {
+	version     string
+	sdkProvider *schema.Provider
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "squadcast"
+	resp.Version = p.version
+}
+
+// Schema mirrors the schema of the SDKv2 provider, the mux server requires the provider schemas to be identical.
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := make(map[string]fwschema.Attribute, len(p.sdkProvider.Schema))
+	for name, s := range p.sdkProvider.Schema {
+		switch s.Type {
+		case schema.TypeString:
+			attributes[name] = fwschema.StringAttribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+			}
+		case schema.TypeBool:
+			attributes[name] = fwschema.BoolAttribute{
+				MarkdownDescription: s.Description,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+			}
+		case schema.TypeMap:
+			attributes[name] = fwschema.MapAttribute{
+				MarkdownDescription: s.Description,
+				ElementType:         types.StringType,
+				Required:            s.Required,
+				Optional:            s.Optional,
+				Sensitive:           s.Sensitive,
+			}
+		default:
+			resp.Diagnostics.AddError("Unsupported provider attribute", "The type of the provider attribute "+name+" is not supported by the framework provider.")
+		}
+	}
+
+	resp.Schema = fwschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", "The SDKv2 provider must be configured before the framework provider, serve the provider with NewProviderServer.")
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newIncidentChatTranscriptExportResource,
+		newSSOConfigurationResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// stringOrNull returns the value of an optional string attribute of a framework resource, null when it is empty: the
+// API returns empty strings for the values that are not set.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkTimeoutsAttrTypes are the attributes of the `timeouts` block of the framework resources, the same as the
+// block the SDK adds to the resources with withTimeouts, so that the configurations of a resource keep working once it
+// is migrated to the framework.
+var frameworkTimeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// frameworkTimeoutsBlock returns the `timeouts` block of a framework resource.
+func frameworkTimeoutsBlock() schema.SingleNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(frameworkTimeoutsAttrTypes))
+	for operation := range frameworkTimeoutsAttrTypes {
+		attributes[operation] = schema.StringAttribute{
+			Optional:   true,
+			Validators: []validator.String{durationValidator{}},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+// withFrameworkTimeout returns a context whose deadline is the timeout of the operation configured in the timeouts
+// block of a framework resource, or defaultResourceTimeout, like the SDK does for its resources.
+func withFrameworkTimeout(ctx context.Context, timeouts types.Object, operation string) (context.Context, context.CancelFunc) {
+	timeout := defaultResourceTimeout
+	if value, ok := timeouts.Attributes()[operation].(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+		if d, err := time.ParseDuration(value.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// durationValidator checks that a string is a duration, as parsed by time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration, e.g. 30s or 2h45m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a duration, e.g. `30s` or `2h45m`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", fmt.Sprintf("%s: %s", v.Description(ctx), err))
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// TestIdempotentCreate checks that the creates of the resources go through api.createIdempotently, the idempotency
+// keys and the retries are tested in the api package.
+func TestIdempotentCreate(t *testing.T) {
+	var keys []string
+	var lookups int
+	created := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/squads":
+			keys = append(keys, r.Header.Get(api.IdempotencyKeyHeader))
+			if len(keys) == 1 {
+				// The squad is created but the response is lost.
+				created = true
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+			w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/squads/by-name":
+			lookups++
+			if !created {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"meta":{"status":404,"error_message":"squad not found"}}`))
+				return
+			}
+			w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"sre"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+
+	squad, err := client.CreateSquad(context.Background(), &api.CreateSquadReq{Name: "sre", TeamID: "613611c1eb22db455cfa789f"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.ID != "61305a8eb7a2fa0e44cfd0f5" {
+		t.Errorf("expected the squad created by the first attempt, got %#v", squad)
+	}
+	if len(keys) != 1 || keys[0] == "" || lookups != 1 {
+		t.Errorf("expected a single create with an idempotency key and a lookup, got keys %q and %d lookups", keys, lookups)
+	}
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// inventoryEntry is a resource managed by the provider, as written to the inventory file.
+type inventoryEntry struct {
+	Type           string `json:"type"`
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	ServiceID      string `json:"service_id,omitempty"`
+	TeamID         string `json:"team_id,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+}
+
+// key identifies the entry in the inventory. The service and the team are part of it, as some resources have the
+// same id in every service, e.g. the rules of a service.
+func (e inventoryEntry) key() string {
+	return strings.Join([]string{e.Type, e.ID, e.ServiceID, e.TeamID}, "/")
+}
+
+type inventory struct {
+	Resources []inventoryEntry `json:"resources"`
+}
+
+// inventoryMu serializes the updates of the inventory files, the resources are applied concurrently.
+var inventoryMu sync.Mutex
+
+// updateInventory applies update to the entries of the inventory file of the client, if any, and writes it back
+// sorted by type, id, service and team.
+func updateInventory(ctx context.Context, client *api.Client, update func(entries map[string]inventoryEntry)) error {
+	if client == nil || client.InventoryFile == "" {
+		return nil
+	}
+
+	inventoryMu.Lock()
+	defer inventoryMu.Unlock()
+
+	var inv inventory
+	content, err := os.ReadFile(client.InventoryFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return err
+	default:
+		if err = json.Unmarshal(content, &inv); err != nil {
+			return fmt.Errorf("invalid inventory file %s: %w", client.InventoryFile, err)
+		}
+	}
+
+	entries := make(map[string]inventoryEntry, len(inv.Resources))
+	for _, entry := range inv.Resources {
+		entries[entry.key()] = entry
+	}
+	update(entries)
+
+	inv.Resources = make([]inventoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		inv.Resources = append(inv.Resources, entry)
+	}
+	sort.Slice(inv.Resources, func(i, j int) bool {
+		a, b := inv.Resources[i], inv.Resources[j]
+		switch {
+		case a.Type != b.Type:
+			return a.Type < b.Type
+		case a.ID != b.ID:
+			return a.ID < b.ID
+		case a.ServiceID != b.ServiceID:
+			return a.ServiceID < b.ServiceID
+		}
+		return a.TeamID < b.TeamID
+	})
+
+	content, err = json.MarshalIndent(inv, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// The file is replaced at once so that readers never see a partially written inventory.
+	tmp, err := os.CreateTemp(filepath.Dir(client.InventoryFile), filepath.Base(client.InventoryFile)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(append(content, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "Updated inventory", tf.M{
+		"path":      client.InventoryFile,
+		"resources": len(inv.Resources),
+	})
+	return os.Rename(tmp.Name(), client.InventoryFile)
+}
+
+// recordInventory adds or updates a resource in the inventory file.
+func recordInventory(ctx context.Context, client *api.Client, entry inventoryEntry) error {
+	entry.OrganizationID = client.OrganizationID
+
+	return updateInventory(ctx, client, func(entries map[string]inventoryEntry) {
+		entries[entry.key()] = entry
+	})
+}
+
+// removeFromInventory removes a resource from the inventory file.
+func removeFromInventory(ctx context.Context, client *api.Client, entry inventoryEntry) error {
+	return updateInventory(ctx, client, func(entries map[string]inventoryEntry) {
+		delete(entries, entry.key())
+	})
+}
+
+// updateFrameworkInventory records a resource of the framework provider in the inventory file, or removes it. The
+// inventory is left as it is when the operation failed, and a failure to update it is a warning.
+func updateFrameworkInventory(ctx context.Context, client *api.Client, diags *fwdiag.Diagnostics, entry inventoryEntry, remove bool) {
+	if client == nil || client.InventoryFile == "" || diags.HasError() {
+		return
+	}
+
+	var err error
+	if remove {
+		err = removeFromInventory(ctx, client, entry)
+	} else {
+		err = recordInventory(ctx, client, entry)
+	}
+	if err != nil {
+		diags.AddWarning("Unable to update the inventory file", err.Error())
+	}
+}
+
+// withInventory wraps the operations of a resource to keep the inventory file up to date: resources are recorded
+// when they are created or updated, and removed when they are deleted. Reads only write the inventory with
+// inventory_record_reads, so that a plan or a refresh leaves it as it is, unless it is used to fill the inventory.
+func withInventory(resourceType string, r *schema.Resource) *schema.Resource {
+	entry := func(d *schema.ResourceData, id string) inventoryEntry {
+		entry := inventoryEntry{Type: resourceType, ID: id}
+		if _, ok := r.Schema["name"]; ok {
+			entry.Name, _ = d.Get("name").(string)
+		}
+		if _, ok := r.Schema["service_id"]; ok {
+			entry.ServiceID, _ = d.Get("service_id").(string)
+		}
+		if _, ok := r.Schema["team_id"]; ok {
+			entry.TeamID, _ = d.Get("team_id").(string)
+		}
+		return entry
+	}
+
+	warn := func(diags diag.Diagnostics, err error) diag.Diagnostics {
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to update the inventory file",
+				Detail:   err.Error(),
+			})
+		}
+		return diags
+	}
+
+	wrap := func(op func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			diags := op(ctx, d, meta)
+			client, ok := meta.(*api.Client)
+			if !ok || client.InventoryFile == "" || diags.HasError() || d.Id() == "" {
+				return diags
+			}
+			return warn(diags, recordInventory(ctx, client, entry(d, d.Id())))
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = wrap(r.CreateContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap(r.UpdateContext)
+	}
+	if r.ReadContext != nil {
+		read := r.ReadContext
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			prior := entry(d, d.Id())
+			diags := read(ctx, d, meta)
+			client, ok := meta.(*api.Client)
+			if !ok || client.InventoryFile == "" || !client.InventoryRecordReads || diags.HasError() {
+				return diags
+			}
+			if d.Id() == "" {
+				return warn(diags, removeFromInventory(ctx, client, prior))
+			}
+			return warn(diags, recordInventory(ctx, client, entry(d, d.Id())))
+		}
+	}
+	if r.DeleteContext != nil {
+		del := r.DeleteContext
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			removed := entry(d, d.Id())
+			diags := del(ctx, d, meta)
+			client, ok := meta.(*api.Client)
+			if !ok || client.InventoryFile == "" || diags.HasError() {
+				return diags
+			}
+			return warn(diags, removeFromInventory(ctx, client, removed))
+		}
+	}
+
+	return r
+}
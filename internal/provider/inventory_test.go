@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestWithInventory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.json")
+	client := &api.Client{OrganizationID: "604592dabc35ea0008bb0584", InventoryFile: path}
+
+	r := withInventory("squadcast_squad", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"team_id": {Type: schema.TypeString, Required: true},
+			"name":    {Type: schema.TypeString, Required: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId(d.Get("name").(string) + "-id")
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId("")
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return nil
+		},
+	})
+
+	read := func() []inventoryEntry {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var inv inventory
+		if err = json.Unmarshal(content, &inv); err != nil {
+			t.Fatal(err)
+		}
+		return inv.Resources
+	}
+
+	resources := map[string]*schema.ResourceData{}
+	for _, name := range []string{"sre", "platform", "payments"} {
+		d := r.TestResourceData()
+		d.Set("team_id", "613611c1eb22db455cfa789f")
+		d.Set("name", name)
+		if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatal(diags)
+		}
+		resources[name] = d
+	}
+
+	want := []inventoryEntry{
+		{Type: "squadcast_squad", ID: "payments-id", Name: "payments", TeamID: "613611c1eb22db455cfa789f", OrganizationID: "604592dabc35ea0008bb0584"},
+		{Type: "squadcast_squad", ID: "platform-id", Name: "platform", TeamID: "613611c1eb22db455cfa789f", OrganizationID: "604592dabc35ea0008bb0584"},
+		{Type: "squadcast_squad", ID: "sre-id", Name: "sre", TeamID: "613611c1eb22db455cfa789f", OrganizationID: "604592dabc35ea0008bb0584"},
+	}
+	if got := read(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("inventory = %+v, want %+v", got, want)
+	}
+
+	if diags := r.DeleteContext(context.Background(), resources["platform"], client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := read(); !reflect.DeepEqual(got, []inventoryEntry{want[0], want[2]}) {
+		t.Errorf("inventory = %+v, want %+v", got, []inventoryEntry{want[0], want[2]})
+	}
+
+	// Reads, e.g. of a resource found gone during a plan, leave the inventory as it is.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.ReadContext(context.Background(), resources["payments"], client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the inventory not to be written by a read, got %v", err)
+	}
+
+	// Without an inventory file, nothing is written.
+	d := r.TestResourceData()
+	d.Set("name", "sre")
+	if diags := r.CreateContext(context.Background(), d, &api.Client{}); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 0 {
+		t.Errorf("expected no file, got %d files", len(entries))
+	}
+}
+
+func TestWithInventory_recordReads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.json")
+	client := &api.Client{InventoryFile: path, InventoryRecordReads: true}
+
+	r := withInventory("squadcast_squad", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if d.Id() == "gone-id" {
+				d.SetId("")
+			}
+			return nil
+		},
+	})
+
+	if err := recordInventory(context.Background(), client, inventoryEntry{Type: "squadcast_squad", ID: "gone-id", Name: "gone"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A refresh of an existing workspace fills the inventory with the resources left unchanged, and removes the ones
+	// deleted outside of Terraform.
+	for _, name := range []string{"sre", "gone"} {
+		d := r.TestResourceData()
+		d.SetId(name + "-id")
+		d.Set("name", name)
+		if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var inv inventory
+	if err = json.Unmarshal(content, &inv); err != nil {
+		t.Fatal(err)
+	}
+	want := []inventoryEntry{{Type: "squadcast_squad", ID: "sre-id", Name: "sre"}}
+	if !reflect.DeepEqual(inv.Resources, want) {
+		t.Errorf("inventory = %+v, want %+v", inv.Resources, want)
+	}
+}
+
+func TestWithInventory_serviceScoped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.json")
+	client := &api.Client{InventoryFile: path}
+
+	r := withInventory("squadcast_tagging_rules", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"team_id":    {Type: schema.TypeString, Required: true},
+			"service_id": {Type: schema.TypeString, Required: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId(taggingRulesID)
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return nil
+		},
+	})
+
+	resources := []*schema.ResourceData{}
+	for _, serviceID := range []string{"61305a8eb7a2fa0e44cfd0f5", "61305a8eb7a2fa0e44cfd0f6"} {
+		d := r.TestResourceData()
+		d.Set("team_id", "613611c1eb22db455cfa789f")
+		d.Set("service_id", serviceID)
+		if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatal(diags)
+		}
+		resources = append(resources, d)
+	}
+	if diags := r.DeleteContext(context.Background(), resources[0], client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var inv inventory
+	if err = json.Unmarshal(content, &inv); err != nil {
+		t.Fatal(err)
+	}
+	want := []inventoryEntry{{Type: "squadcast_tagging_rules", ID: taggingRulesID, ServiceID: "61305a8eb7a2fa0e44cfd0f6", TeamID: "613611c1eb22db455cfa789f"}}
+	if !reflect.DeepEqual(inv.Resources, want) {
+		t.Errorf("inventory = %+v, want %+v", inv.Resources, want)
+	}
+}
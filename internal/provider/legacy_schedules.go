@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The legacy schedules of squadcast_schedule have object ids, the schedules of squadcast_schedule_v2 have numeric ids.
+// A legacy schedule has no rotations, so its id can not be used where the id of a v2 schedule is expected, e.g. in the
+// schedule_id of squadcast_schedule_rotation_v2, and the API rejects it at apply with an error that does not say why.
+
+var (
+	legacyScheduleIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+	scheduleV2IDRegexp     = regexp.MustCompile(`^[0-9]+$`)
+)
+
+const legacySchedulePairingDetail = "Legacy schedules (squadcast_schedule) have no rotations and can not be paired with " +
+	"squadcast_schedule_rotation_v2 or with escalation policy targets of type schedulev2. " +
+	"Create the schedule with squadcast_schedule_v2 and its rotations with squadcast_schedule_rotation_v2, " +
+	"or keep targeting the legacy schedule with an escalation policy target of type schedule."
+
+// legacySchedulePairingError is returned at plan time when the id of a legacy schedule is used where the id of a v2
+// schedule is expected.
+func legacySchedulePairingError(attribute string, id string) error {
+	return fmt.Errorf("%s: %s is the id of a legacy squadcast_schedule, a squadcast_schedule_v2 id is expected. %s", attribute, id, legacySchedulePairingDetail)
+}
+
+// validateScheduleV2ID validates the id of a v2 schedule, with a targeted diagnostic for the id of a legacy schedule.
+func validateScheduleV2ID(v any, path cty.Path) diag.Diagnostics {
+	id, ok := v.(string)
+	if !ok {
+		return diag.Errorf("expected type of schedule id to be string")
+	}
+
+	switch {
+	case legacyScheduleIDRegexp.MatchString(id):
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s is the id of a legacy squadcast_schedule, a squadcast_schedule_v2 id is expected", id),
+			Detail:        legacySchedulePairingDetail,
+			AttributePath: path,
+		}}
+	case !scheduleV2IDRegexp.MatchString(id):
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "must be a numeric schedule id",
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// validateScheduleTarget validates the pairing of the id of an escalation policy target with its type.
+func validateScheduleTarget(attribute string, targetType string, id string, rotationID string) error {
+	switch targetType {
+	case "schedulev2":
+		if legacyScheduleIDRegexp.MatchString(id) {
+			return legacySchedulePairingError(attribute+".id", id)
+		}
+	case "schedule":
+		if scheduleV2IDRegexp.MatchString(id) {
+			return fmt.Errorf("%s.id: %s is the id of a squadcast_schedule_v2, targets of type schedule are legacy squadcast_schedule, set the type to schedulev2", attribute, id)
+		}
+		if rotationID != "" {
+			return fmt.Errorf("%s.rotation_id: targets of type schedule are legacy squadcast_schedule, which have no rotations. %s", attribute, legacySchedulePairingDetail)
+		}
+	}
+
+	return nil
+}
+
+// scheduleRotationV2CustomizeDiff rejects the id of a legacy schedule that was not known yet when the configuration
+// was validated, e.g. when the legacy schedule is created in the same apply.
+func scheduleRotationV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("schedule_id") {
+		return nil
+	}
+
+	if id := d.Get("schedule_id").(string); legacyScheduleIDRegexp.MatchString(id) {
+		return legacySchedulePairingError("schedule_id", id)
+	}
+
+	return nil
+}
+
+// escalationPolicyScheduleTargetsCustomizeDiff rejects the schedule targets whose id does not match their type.
+func escalationPolicyScheduleTargetsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for i, rule := range d.Get("rules").([]any) {
+		mrule, ok := rule.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		targets, _ := mrule["targets"].([]any)
+		for j, target := range targets {
+			mtarget, ok := target.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			attribute := fmt.Sprintf("rules.%d.targets.%d", i, j)
+			if !d.NewValueKnown(attribute+".id") || !d.NewValueKnown(attribute+".type") || !d.NewValueKnown(attribute+".rotation_id") {
+				continue
+			}
+
+			targetType, _ := mtarget["type"].(string)
+			id, _ := mtarget["id"].(string)
+			rotationID, _ := mtarget["rotation_id"].(string)
+			if err := validateScheduleTarget(attribute, targetType, id, rotationID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
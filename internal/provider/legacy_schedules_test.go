@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateScheduleV2ID(t *testing.T) {
+	if diags := validateScheduleV2ID("12345", cty.GetAttrPath("schedule_id")); diags.HasError() {
+		t.Errorf("expected no error for a v2 schedule id, got %#v", diags)
+	}
+
+	diags := validateScheduleV2ID("5f9a1b2c3d4e5f6a7b8c9d0e", cty.GetAttrPath("schedule_id"))
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "legacy squadcast_schedule") || diags[0].Detail == "" {
+		t.Errorf("expected a legacy schedule diagnostic, got %#v", diags)
+	}
+
+	diags = validateScheduleV2ID("primary", cty.GetAttrPath("schedule_id"))
+	if len(diags) != 1 || diags[0].Summary != "must be a numeric schedule id" {
+		t.Errorf("expected a numeric schedule id diagnostic, got %#v", diags)
+	}
+}
+
+func TestValidateScheduleTarget(t *testing.T) {
+	legacyID := "5f9a1b2c3d4e5f6a7b8c9d0e"
+
+	tests := []struct {
+		name       string
+		targetType string
+		id         string
+		rotationID string
+		err        string
+	}{
+		{name: "v2 schedule", targetType: "schedulev2", id: "123", rotationID: "456"},
+		{name: "legacy schedule", targetType: "schedule", id: legacyID},
+		{name: "user", targetType: "user", id: legacyID},
+		{name: "legacy id on schedulev2", targetType: "schedulev2", id: legacyID, err: "rules.0.targets.1.id: " + legacyID + " is the id of a legacy squadcast_schedule"},
+		{name: "v2 id on schedule", targetType: "schedule", id: "123", err: "set the type to schedulev2"},
+		{name: "rotation on legacy schedule", targetType: "schedule", id: legacyID, rotationID: "456", err: "rules.0.targets.1.rotation_id: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScheduleTarget("rules.0.targets.1", tt.targetType, tt.id, tt.rotationID)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Errorf("expected no error, got %s", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Errorf("expected an error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
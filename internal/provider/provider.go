@@ -6,19 +6,22 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hasura/go-graphql-client"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-// initGraphQLClient initializes the graphql client.
-func initGraphQLClient(client api.Client) {
-	graphQLURL := fmt.Sprintf("https://api.%s/v3/graphql", client.Host)
-	bearerToken := fmt.Sprintf("Bearer %s", client.AccessToken)
-	api.GraphQLClient = graphql.NewClient(graphQLURL, nil).WithRequestModifier(func(req *http.Request) {
-		req.Header.Set("Authorization", bearerToken)
+// initGraphQLClient initializes the graphql client of the given client.
+func initGraphQLClient(client *api.Client) {
+	graphQLURL := fmt.Sprintf("%s/graphql", client.BaseURLV3)
+	client.GraphQLClient = graphql.NewClient(graphQLURL, client.HTTPClient()).WithRequestModifier(func(req *http.Request) {
+		// The token is renewed by api.GraphQLRequest before the request, so this returns the current one.
+		accessToken, _ := client.EnsureAccessToken(req.Context())
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
 	})
 }
 
@@ -42,136 +45,432 @@ func New(version string) func() *schema.Provider {
 	return func() *schema.Provider {
 		p := &schema.Provider{
 			DataSourcesMap: map[string]*schema.Resource{
-				"squadcast_squad":             dataSourceSquad(),
-				"squadcast_service":           dataSourceService(),
-				"squadcast_escalation_policy": dataSourceEscalationPolicy(),
+				"squadcast_alert_sources":       dataSourceAlertSources(),
+				"squadcast_squad":               dataSourceSquad(),
+				"squadcast_service":             dataSourceService(),
+				"squadcast_escalation_policy":   dataSourceEscalationPolicy(),
+				"squadcast_escalation_policies": dataSourceEscalationPolicies(),
+				"squadcast_default_object":      dataSourceDefaultObject(),
+				"squadcast_incidents":           dataSourceIncidents(),
 				// "squadcast_teams": dataSourceTeams(),
-				"squadcast_team":        dataSourceTeam(),
-				"squadcast_team_role":   dataSourceTeamRole(),
-				"squadcast_user":        dataSourceUser(),
-				"squadcast_schedule":    dataSourceSchedule(),
-				"squadcast_schedule_v2": dataSourceScheduleV2(),
-				"squadcast_runbook":     dataSourceRunbook(),
-				"squadcast_webform":     dataSourceWebform(),
+				"squadcast_team":                             dataSourceTeam(),
+				"squadcast_team_role":                        dataSourceTeamRole(),
+				"squadcast_user":                             dataSourceUser(),
+				"squadcast_schedule":                         dataSourceSchedule(),
+				"squadcast_schedule_v2":                      dataSourceScheduleV2(),
+				"squadcast_schedule_v2_migration":            dataSourceScheduleV2Migration(),
+				"squadcast_schedule_export":                  dataSourceScheduleExport(),
+				"squadcast_on_call":                          dataSourceOnCall(),
+				"squadcast_schedule_conflicts":               dataSourceScheduleConflicts(),
+				"squadcast_schedule_forecast":                dataSourceScheduleForecast(),
+				"squadcast_oncall_shift_history":             dataSourceOncallShiftHistory(),
+				"squadcast_rotation_participants":            dataSourceRotationParticipants(),
+				"squadcast_runbook":                          dataSourceRunbook(),
+				"squadcast_webform":                          dataSourceWebform(),
+				"squadcast_public_webform_submission_schema": dataSourcePublicWebformSubmissionSchema(),
+				"squadcast_analytics":                        dataSourceAnalytics(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"squadcast_deduplication_rules":        resourceDeduplicationRules(),
-				"squadcast_escalation_policy":          resourceEscalationPolicy(),
-				"squadcast_ger":                        resourceGER(),
-				"squadcast_ger_ruleset":                resourceGERRuleset(),
-				"squadcast_ger_ruleset_rule":           resourceGERRulesetRule(),
-				"squadcast_ger_ruleset_rules_ordering": resourceGERRulesetRulesOrdering(),
-				"squadcast_routing_rules":              resourceRoutingRules(),
-				"squadcast_runbook":                    resourceRunbook(),
-				"squadcast_schedule":                   resourceSchedule(),
-				"squadcast_schedule_v2":                resourceScheduleV2(),
-				"squadcast_schedule_rotation_v2":       resourceScheduleRotationV2(),
-				"squadcast_service_maintenance":        resourceServiceMaintenance(),
-				"squadcast_service":                    resourceService(),
-				"squadcast_squad":                      resourceSquad(),
-				"squadcast_status_page":                resourceStatusPage(),
-				"squadcast_status_page_component":      resourceStatusPageComponent(),
-				"squadcast_status_page_group":          resourceStatusPageGroup(),
-				"squadcast_suppression_rules":          resourceSuppressionRules(),
-				"squadcast_tagging_rules":              resourceTaggingRules(),
-				"squadcast_team_member":                resourceTeamMember(),
-				"squadcast_team_role":                  resourceTeamRole(),
-				"squadcast_team":                       resourceTeam(),
-				"squadcast_user":                       resourceUser(),
-				"squadcast_slo":                        resourceSlo(),
-				"squadcast_webform":                    resourceWebform(),
+				"squadcast_alert_rules":                         resourceAlertRules(),
+				"squadcast_deduplication_rules":                 resourceDeduplicationRules(),
+				"squadcast_deduplication_ml_settings":           resourceDeduplicationMLSettings(),
+				"squadcast_incident_reopen_policy":              resourceIncidentReopenPolicy(),
+				"squadcast_alert_grouping_window":               resourceAlertGroupingWindow(),
+				"squadcast_escalation_policy":                   resourceEscalationPolicy(),
+				"squadcast_escalation_policy_template":          resourceEscalationPolicyTemplate(),
+				"squadcast_escalation_policy_template_instance": resourceEscalationPolicyTemplateInstance(),
+				"squadcast_escalation_policy_round_robin_group": resourceEscalationPolicyRoundRobinGroup(),
+				"squadcast_escalation_repeat_cap_policy":        resourceEscalationRepeatCapPolicy(),
+				"squadcast_ger":                                 resourceGER(),
+				"squadcast_ger_ruleset":                         resourceGERRuleset(),
+				"squadcast_ger_ruleset_rule":                    resourceGERRulesetRule(),
+				"squadcast_ger_ruleset_rules_ordering":          resourceGERRulesetRulesOrdering(),
+				"squadcast_incident_summary_distribution":       resourceIncidentSummaryDistribution(),
+				"squadcast_maintenance_calendar":                resourceMaintenanceCalendar(),
+				"squadcast_notification_language":               resourceNotificationLanguage(),
+				"squadcast_oncall_compensation_tier":            resourceOncallCompensationTier(),
+				"squadcast_postmortem_template":                 resourcePostmortemTemplate(),
+				"squadcast_routing_rules":                       resourceRoutingRules(),
+				"squadcast_routing_rule_v2":                     resourceRoutingRuleV2(),
+				"squadcast_runbook":                             resourceRunbook(),
+				"squadcast_schedule":                            resourceSchedule(),
+				"squadcast_schedule_v2":                         resourceScheduleV2(),
+				"squadcast_schedule_rotation_v2":                resourceScheduleRotationV2(),
+				"squadcast_schedule_export":                     resourceScheduleExport(),
+				"squadcast_oncall_handoff_notes_template":       resourceOncallHandoffNotesTemplate(),
+				"squadcast_service_alert_source":                resourceServiceAlertSource(),
+				"squadcast_service_checklist":                   resourceServiceChecklist(),
+				"squadcast_service_maintenance":                 resourceServiceMaintenance(),
+				"squadcast_service":                             resourceService(),
+				"squadcast_squad":                               resourceSquad(),
+				"squadcast_stakeholder_group":                   resourceStakeholderGroup(),
+				"squadcast_status_page":                         resourceStatusPage(),
+				"squadcast_status_page_component":               resourceStatusPageComponent(),
+				"squadcast_status_page_group":                   resourceStatusPageGroup(),
+				"squadcast_status_page_subscriber_import":       resourceStatusPageSubscriberImport(),
+				"squadcast_suppression_rules":                   resourceSuppressionRules(),
+				"squadcast_suppression_rule_v2":                 resourceSuppressionRuleV2(),
+				"squadcast_tagging_rules":                       resourceTaggingRules(),
+				"squadcast_team_member":                         resourceTeamMember(),
+				"squadcast_team_members":                        resourceTeamMembers(),
+				"squadcast_team_role":                           resourceTeamRole(),
+				"squadcast_team":                                resourceTeam(),
+				"squadcast_test_incident":                       resourceTestIncident(),
+				"squadcast_user":                                resourceUser(),
+				"squadcast_user_api_token":                      resourceUserAPIToken(),
+				"squadcast_user_permissions":                    resourceUserPermissions(),
+				"squadcast_user_notification_rules":             resourceUserNotificationRules(),
+				"squadcast_slo":                                 resourceSlo(),
+				"squadcast_slack_integration":                   resourceSlackIntegration(),
+				"squadcast_msteams_integration":                 resourceMSTeamsIntegration(),
+				"squadcast_jira_cloud_integration":              resourceJiraCloudIntegration(),
+				"squadcast_webform":                             resourceWebform(),
+				"squadcast_webform_domain_verification":         resourceWebformDomainVerification(),
+				"squadcast_response_play":                       resourceResponsePlay(),
+				"squadcast_heartbeat":                           resourceHeartbeat(),
+				"squadcast_api_token":                           resourceAPIToken(),
 			},
 			Schema: map[string]*schema.Schema{
 				"region": {
-					Description: "The region you are currently hosted on." +
-						"Supported values are \"us\" and \"eu\"",
+					Description: "The region you are currently hosted on, it selects the API endpoints of the provider. " +
+						"Supported values are \"us\" and \"eu\". Use provider aliases to manage organizations of both regions in the same configuration.",
 					Type:         schema.TypeString,
 					Optional:     true,
 					DefaultFunc:  schema.EnvDefaultFunc("SQUADCAST_REGION", "us"),
 					ValidateFunc: validation.StringInSlice([]string{"us", "eu", "internal", "staging", "dev"}, false),
 				},
 				"refresh_token": {
-					Description: "The refresh token, This can be created from user profile",
+					Description: "The refresh token, This can be created from user profile. Defaults to the `SQUADCAST_REFRESH_TOKEN` environment variable when no other authentication method is configured.",
 					Type:        schema.TypeString,
 					Sensitive:   true,
 					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_REFRESH_TOKEN", nil),
+				},
+				"token_file": {
+					Description: "Path of a file containing the refresh token, e.g. a mounted secret, so that it does not go through Terraform variables.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_TOKEN_FILE", nil),
+				},
+				"token_command": {
+					Description: "Shell command printing the refresh token on its standard output, e.g. `vault kv get -field=token secret/squadcast`, run when the provider is configured.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_TOKEN_COMMAND", nil),
+				},
+				"client_id": {
+					Description: "The OAuth client id, to authenticate with client credentials instead of a refresh token, e.g. in CI pipelines.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_CLIENT_ID", nil),
+				},
+				"client_secret": {
+					Description: "The OAuth client secret, required with `client_id`.",
+					Type:        schema.TypeString,
+					Sensitive:   true,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_CLIENT_SECRET", nil),
+				},
+				"service_account_token": {
+					Description: "A long-lived service account token, to authenticate without a refresh token, e.g. in CI pipelines.",
+					Type:        schema.TypeString,
+					Sensitive:   true,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_SERVICE_ACCOUNT_TOKEN", nil),
+				},
+				"skip_analytics_refresh": {
+					Description: "Skip reading the computed analytics fields (`mttr` and `incident_count` of webforms, `metrics` of services) to speed up refreshes when they are not used. " +
+						"These fields keep their last known value while skipped.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_SKIP_ANALYTICS_REFRESH", false),
+				},
+				"validate_references": {
+					Description: "Verify during plan that the teams, users, squads, schedules and services referenced by `squadcast_schedule_v2`, `squadcast_schedule_rotation_v2` and `squadcast_webform` exist, " +
+						"so that a typo in an id fails the plan instead of the apply. Ids that are only known after apply are not verified.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_VALIDATE_REFERENCES", false),
+				},
+				"adopt_existing_on_conflict": {
+					Description: "When the creation of a resource fails because an object with the same name already exists, e.g. after a partially failed apply, " +
+						"adopt the existing object into the state and update it to the configuration instead of failing, with a warning. " +
+						"Supported by the resources that can be imported by name, e.g. `squadcast_service`, `squadcast_squad` and `squadcast_escalation_policy`.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_ADOPT_EXISTING_ON_CONFLICT", false),
+				},
+				"inventory_file": {
+					Description: "Path of a JSON file listing the resources managed by the provider, with their type, id, name, service, team and organization, e.g. for a CMDB sync job. " +
+						"The file is updated as resources are created, updated and deleted, refreshing the resources during a plan leaves it as it is, " +
+						"so the resources left unchanged since the file was enabled are missing until `inventory_record_reads` is set. " +
+						"Use a different file for each provider configuration.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_INVENTORY_FILE", ""),
+				},
+				"inventory_record_reads": {
+					Description: "Also record the resources in the `inventory_file` as they are read, and remove the ones found deleted. " +
+						"Set it for a `terraform apply -refresh-only` run to fill the inventory with the resources of an existing workspace.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_INVENTORY_RECORD_READS", false),
+				},
+				"proxy_url": {
+					Description: "URL of the HTTP or HTTPS proxy the requests to the API go through, e.g. a corporate egress proxy. " +
+						"Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("SQUADCAST_PROXY_URL", ""),
+					ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+				},
+				"ca_bundle_file": {
+					Description: "Path of a PEM file of certificate authorities to trust in addition to the ones of the system, e.g. the authority of a TLS-intercepting proxy or of an on-prem gateway.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_CA_BUNDLE_FILE", ""),
+				},
+				"insecure_skip_verify": {
+					Description: "Skip the verification of the TLS certificates of the API, e.g. for an on-prem gateway with a self-signed certificate. Prefer `ca_bundle_file`, this exposes the credentials to man-in-the-middle attacks.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_INSECURE_SKIP_VERIFY", false),
+				},
+				"headers": {
+					Description: "Extra HTTP headers sent with every request to the API, e.g. the key of an API gateway. They do not override the headers set by the provider, e.g. `Authorization`, and are redacted from the logs.",
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
 				},
 			},
 		}
 
+		for name, r := range p.ResourcesMap {
+			withInventory(name, withTimeouts(withCompanionNames(r)))
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
 	}
 }
 
+// providerConfig is the configuration of the provider, read by both the SDKv2 and the framework providers.
+type providerConfig struct {
+	Region                  string
+	RefreshToken            string
+	TokenFile               string
+	TokenCommand            string
+	ClientID                string
+	ClientSecret            string
+	ServiceAccountToken     string
+	SkipAnalyticsRefresh    bool
+	ValidateReferences      bool
+	AdoptExistingOnConflict bool
+	InventoryFile           string
+	InventoryRecordReads    bool
+	ProxyURL                string
+	CABundleFile            string
+	InsecureSkipVerify      bool
+	Headers                 map[string]string
+}
+
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
-	return func(ctx context.Context, rd *schema.ResourceData) (c any, diags diag.Diagnostics) {
-		client := &api.Client{}
-		client.UserAgent = p.UserAgent("terraform-provider-squadcast", version)
+	return func(ctx context.Context, rd *schema.ResourceData) (any, diag.Diagnostics) {
+		client, diags := newClient(ctx, p.UserAgent("terraform-provider-squadcast", version), providerConfig{
+			Region:                  rd.Get("region").(string),
+			RefreshToken:            rd.Get("refresh_token").(string),
+			TokenFile:               rd.Get("token_file").(string),
+			TokenCommand:            rd.Get("token_command").(string),
+			ClientID:                rd.Get("client_id").(string),
+			ClientSecret:            rd.Get("client_secret").(string),
+			ServiceAccountToken:     rd.Get("service_account_token").(string),
+			SkipAnalyticsRefresh:    rd.Get("skip_analytics_refresh").(bool),
+			ValidateReferences:      rd.Get("validate_references").(bool),
+			AdoptExistingOnConflict: rd.Get("adopt_existing_on_conflict").(bool),
+			InventoryFile:           rd.Get("inventory_file").(string),
+			InventoryRecordReads:    rd.Get("inventory_record_reads").(bool),
+			ProxyURL:                rd.Get("proxy_url").(string),
+			CABundleFile:            rd.Get("ca_bundle_file").(string),
+			InsecureSkipVerify:      rd.Get("insecure_skip_verify").(bool),
+			Headers:                 tf.ExpandStringMap(rd.Get("headers")),
+		})
+		if diags.HasError() {
+			return nil, diags
+		}
 
-		region := rd.Get("region").(string)
-		refreshToken := rd.Get("refresh_token").(string)
+		return client, diags
+	}
+}
 
-		if refreshToken == "" {
-			refreshToken = os.Getenv("SQUADCAST_REFRESH_TOKEN")
-		}
-		if refreshToken == "" {
-			return nil, diag.Errorf("refresh_token is required")
-		}
+// newClient authenticates a client with the given provider configuration.
+func newClient(ctx context.Context, userAgent string, config providerConfig) (*api.Client, diag.Diagnostics) {
+	client := &api.Client{}
+	client.UserAgent = userAgent
 
-		client.RefreshToken = refreshToken
-
-		switch region {
-		case "us":
-			client.Host = "squadcast.com"
-		case "eu":
-			client.Host = "eu.squadcast.com"
-		case "internal":
-			client.Host = "squadcast.xyz"
-		case "staging":
-			client.Host = "squadcast.tech"
-		case "dev":
-			client.Host = "localhost"
-		}
+	region := config.Region
+	refreshToken := config.RefreshToken
+	clientID := config.ClientID
+	clientSecret := config.ClientSecret
+	serviceAccountToken := config.ServiceAccountToken
+	tokenFile := config.TokenFile
+	tokenCommand := config.TokenCommand
+
+	// The refresh token of the environment is only a fallback, so that it does not conflict with the other methods.
+	if refreshToken == "" && tokenFile == "" && tokenCommand == "" && clientID == "" && clientSecret == "" && serviceAccountToken == "" {
+		refreshToken = os.Getenv("SQUADCAST_REFRESH_TOKEN")
+	}
 
-		if region == "dev" {
-			client.BaseURLV4 = fmt.Sprintf("http://%s:8081/v4", client.Host)
-			client.BaseURLV3 = fmt.Sprintf("http://%s:8081/v3", client.Host)
-			client.BaseURLV2 = fmt.Sprintf("http://%s:8080/v2", client.Host)
-			client.AuthBaseURL = fmt.Sprintf("http://%s:8081/v3", client.Host)
-			client.IngestionBaseURL = fmt.Sprintf("http://%s:8458", client.Host)
-		} else {
-			client.BaseURLV4 = fmt.Sprintf("https://api.%s/v4", client.Host)
-			client.BaseURLV3 = fmt.Sprintf("https://api.%s/v3", client.Host)
-			client.BaseURLV2 = fmt.Sprintf("https://platform-backend.%s/v2", client.Host)
-			client.AuthBaseURL = fmt.Sprintf("https://api.%s/v3", client.Host)
-			client.IngestionBaseURL = fmt.Sprintf("https://api.%s", client.Host)
+	methods := 0
+	for _, set := range []bool{refreshToken != "", tokenFile != "", tokenCommand != "", clientID != "" || clientSecret != "", serviceAccountToken != ""} {
+		if set {
+			methods++
 		}
+	}
+	switch {
+	case methods == 0:
+		return nil, diag.Errorf("one of refresh_token, token_file, token_command, client_id and client_secret or service_account_token is required")
+	case methods > 1:
+		return nil, diag.Errorf("only one of refresh_token, token_file, token_command, client_id and client_secret or service_account_token can be set")
+	case (clientID == "") != (clientSecret == ""):
+		return nil, diag.Errorf("client_id and client_secret must be set together")
+	}
 
-		token, err := client.GetAccessToken(ctx)
+	var err error
+	switch {
+	case tokenFile != "":
+		refreshToken, err = readTokenFile(tokenFile)
+	case tokenCommand != "":
+		refreshToken, err = runTokenCommand(ctx, tokenCommand)
+	}
+	if err != nil {
+		return nil, diagFromErr(err)
+	}
+
+	client.RefreshToken = refreshToken
+	client.ClientID = clientID
+	client.ClientSecret = clientSecret
+	client.ServiceAccountToken = serviceAccountToken
+	client.Region = region
+	client.SkipAnalyticsRefresh = config.SkipAnalyticsRefresh
+	client.ValidateReferences = config.ValidateReferences
+	client.AdoptExistingOnConflict = config.AdoptExistingOnConflict
+	client.InventoryFile = config.InventoryFile
+	client.InventoryRecordReads = config.InventoryRecordReads
+
+	var diags diag.Diagnostics
+	if config.ProxyURL != "" || config.CABundleFile != "" || config.InsecureSkipVerify || len(config.Headers) > 0 {
+		httpConfig := api.HTTPConfig{
+			ProxyURL:           config.ProxyURL,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+			Headers:            config.Headers,
+		}
+		if config.CABundleFile != "" {
+			httpConfig.CABundle, err = os.ReadFile(config.CABundleFile)
+			if err != nil {
+				return nil, diag.Errorf("failed to read ca_bundle_file: %s", err)
+			}
+		}
+		httpClient, err := api.NewHTTPClient(httpConfig)
 		if err != nil {
-			return nil, append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred while fetching the access token.",
-				Detail:   err.Error(),
-			})
+			return nil, diagFromErr(err)
 		}
-		client.AccessToken = token.AccessToken
+		api.WithHTTPClient(httpClient)(client)
 
-		org, err := client.GetCurrentOrganization(ctx)
-		if err != nil {
-			return nil, append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred while fetching the organization.",
-				Detail:   err.Error(),
+		if config.InsecureSkipVerify {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The TLS certificates of the API are not verified",
+				Detail:   "insecure_skip_verify is set, the credentials of the provider are exposed to man-in-the-middle attacks. Trust the certificate authority of the gateway with ca_bundle_file instead.",
 			})
 		}
-		client.OrganizationID = org.ID
+	}
+
+	switch region {
+	case "us":
+		client.Host = "squadcast.com"
+	case "eu":
+		client.Host = "eu.squadcast.com"
+	case "internal":
+		client.Host = "squadcast.xyz"
+	case "staging":
+		client.Host = "squadcast.tech"
+	case "dev":
+		client.Host = "localhost"
+	}
+
+	if region == "dev" {
+		client.BaseURLV4 = fmt.Sprintf("http://%s:8081/v4", client.Host)
+		client.BaseURLV3 = fmt.Sprintf("http://%s:8081/v3", client.Host)
+		client.BaseURLV2 = fmt.Sprintf("http://%s:8080/v2", client.Host)
+		client.AuthBaseURL = fmt.Sprintf("http://%s:8081/v3", client.Host)
+		client.IngestionBaseURL = fmt.Sprintf("http://%s:8458", client.Host)
+	} else {
+		client.BaseURLV4 = fmt.Sprintf("https://api.%s/v4", client.Host)
+		client.BaseURLV3 = fmt.Sprintf("https://api.%s/v3", client.Host)
+		client.BaseURLV2 = fmt.Sprintf("https://platform-backend.%s/v2", client.Host)
+		client.AuthBaseURL = fmt.Sprintf("https://api.%s/v3", client.Host)
+		client.IngestionBaseURL = fmt.Sprintf("https://api.%s", client.Host)
+	}
+
+	diags = append(diags, connectClient(ctx, client)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return client, diags
+}
+
+// connectClient fetches the access token and the organization of a configured client and sets up its graphql client.
+func connectClient(ctx context.Context, client *api.Client) (diags diag.Diagnostics) {
+	_, err := client.EnsureAccessToken(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "An error occurred while fetching the access token.",
+			Detail:   err.Error(),
+		})
+	}
+
+	org, err := client.GetCurrentOrganization(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "An error occurred while fetching the organization.",
+			Detail:   err.Error(),
+		})
+	}
+	client.OrganizationID = org.ID
+
+	initGraphQLClient(client)
+
+	return nil
+}
+
+// NewTestProvider returns a provider whose client is built from the given options instead of the provider configuration,
+// e.g. to run against a mock server.
+func NewTestProvider(opts ...api.ClientOption) *schema.Provider {
+	p := New("test")()
+	p.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (any, diag.Diagnostics) {
+		client := &api.Client{}
+		client.UserAgent = p.UserAgent("terraform-provider-squadcast", "test")
+		for _, opt := range opts {
+			opt(client)
+		}
 
-		initGraphQLClient(*client)
+		if diags := connectClient(ctx, client); diags.HasError() {
+			return nil, diags
+		}
 
 		return client, nil
 	}
+
+	return p
+}
+
+// NewTestProviderServer returns the protocol version 6 server of the test provider, see NewTestProvider.
+func NewTestProviderServer(opts ...api.ClientOption) (tfprotov6.ProviderServer, error) {
+	return NewProviderServer(context.Background(), "test", NewTestProvider(opts...))
 }
@@ -2,20 +2,37 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProvider = New("dev")()
 
-// providerFactories are used to instantiate a provider during acceptance testing.
+// testAccResourcePrefix starts the names of all the objects created by the acceptance tests, so that the sweepers
+// can find the ones left behind.
+const testAccResourcePrefix = "tf-acc-test"
+
+// testAccName returns a random name for an object of the acceptance tests, e.g. tf-acc-test-squad-1234567890.
+func testAccName(name string) string {
+	return acctest.RandomWithPrefix(testAccResourcePrefix + "-" + name)
+}
+
+// protoV6ProviderFactories are used to instantiate a provider during acceptance testing.
 // The factory function will be invoked for every Terraform CLI command executed
 // to create a provider server to which the CLI can reattach.
-var providerFactories = map[string]func() (*schema.Provider, error){
-	"squadcast": func() (*schema.Provider, error) {
-		return New("dev")(), nil
+var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"squadcast": func() (tfprotov6.ProviderServer, error) {
+		return NewProviderServer(context.Background(), "dev", New("dev")())
 	},
 }
 
@@ -25,6 +42,161 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderServer(t *testing.T) {
+	server, err := NewProviderServer(context.Background(), "dev", New("dev")())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mux server reports differences between the provider schemas and duplicated resources here.
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	if _, ok := resp.ResourceSchemas["squadcast_incident_chat_transcript_export"]; !ok {
+		t.Errorf("expected the framework resources to be served")
+	}
+	if _, ok := resp.ResourceSchemas["squadcast_service"]; !ok {
+		t.Errorf("expected the SDKv2 resources to be served")
+	}
+	if s, ok := resp.ResourceSchemas["squadcast_sso_configuration"]; !ok || s.Version != 1 {
+		t.Errorf("expected the migrated resources to be served by the framework provider, got %+v", s)
+	}
+}
+
+// TestProviderEnabledUpdatesInPlace enforces that toggling the enablement of an object never recreates it, as
+// objects are routinely disabled during incidents.
+func TestProviderEnabledUpdatesInPlace(t *testing.T) {
+	var walk func(path string, s map[string]*schema.Schema)
+	walk = func(path string, s map[string]*schema.Schema) {
+		for name, attribute := range s {
+			if (name == "enabled" || name == "disabled") && attribute.ForceNew {
+				t.Errorf("%s.%s forces the recreation of the resource", path, name)
+			}
+			if elem, ok := attribute.Elem.(*schema.Resource); ok {
+				walk(path+"."+name, elem.Schema)
+			}
+		}
+	}
+	for name, r := range New("dev")().ResourcesMap {
+		walk(name, r.Schema)
+	}
+
+	ctx := context.Background()
+	for _, newResource := range (&frameworkProvider{}).Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "squadcast"}, metadata)
+		resp := &fwresource.SchemaResponse{}
+		r.Schema(ctx, fwresource.SchemaRequest{}, resp)
+
+		for name, attribute := range resp.Schema.Attributes {
+			attribute, ok := attribute.(fwschema.BoolAttribute)
+			if !ok || (name != "enabled" && name != "disabled") {
+				continue
+			}
+			for _, modifier := range attribute.PlanModifiers {
+				if strings.Contains(modifier.Description(ctx), "destroy and recreate") {
+					t.Errorf("%s.%s forces the recreation of the resource", metadata.TypeName, name)
+				}
+			}
+		}
+	}
+}
+
+// TestProviderOptionalBoolsAreExplicit enforces that the optional booleans either have an explicit default or keep the
+// value of the object when they are not set, so that an unset boolean doesn't silently disable a feature.
+func TestProviderOptionalBoolsAreExplicit(t *testing.T) {
+	var walk func(path string, s map[string]*schema.Schema)
+	walk = func(path string, s map[string]*schema.Schema) {
+		for name, attribute := range s {
+			if attribute.Type == schema.TypeBool && attribute.Optional && attribute.Default == nil && attribute.DefaultFunc == nil && !attribute.Computed {
+				t.Errorf("%s.%s is an optional boolean without a default", path, name)
+			}
+			if elem, ok := attribute.Elem.(*schema.Resource); ok {
+				walk(path+"."+name, elem.Schema)
+			}
+		}
+	}
+	for name, r := range New("dev")().ResourcesMap {
+		walk(name, r.Schema)
+	}
+}
+
+func TestProviderResourcesHaveTimeouts(t *testing.T) {
+	for name, r := range New("dev")().ResourcesMap {
+		timeouts := map[string]bool{
+			"create": r.Timeouts.Create != nil,
+			"read":   r.Timeouts.Read != nil,
+			"update": r.Timeouts.Update != nil,
+			"delete": r.Timeouts.Delete != nil,
+		}
+		operations := map[string]bool{
+			"create": r.CreateContext != nil,
+			"read":   r.ReadContext != nil,
+			"update": r.UpdateContext != nil,
+			"delete": r.DeleteContext != nil,
+		}
+		for operation, defined := range operations {
+			if timeouts[operation] != defined {
+				t.Errorf("%s: expected a %s timeout to be configurable: %v, got %v", name, operation, defined, timeouts[operation])
+			}
+		}
+	}
+
+	r := New("dev")().ResourcesMap["squadcast_schedule_v2"]
+	if *r.Timeouts.Create != 5*time.Minute || *r.Timeouts.Update != defaultResourceTimeout {
+		t.Errorf("expected the timeouts set by the resource to be kept, got create %s and update %s", *r.Timeouts.Create, *r.Timeouts.Update)
+	}
+
+	// The framework resources have the same timeouts block.
+	ctx := context.Background()
+	for _, newResource := range (&frameworkProvider{}).Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "squadcast"}, metadata)
+		resp := &fwresource.SchemaResponse{}
+		r.Schema(ctx, fwresource.SchemaRequest{}, resp)
+
+		if _, ok := resp.Schema.Blocks["timeouts"]; !ok {
+			t.Errorf("%s: expected the timeouts to be configurable", metadata.TypeName)
+		}
+	}
+
+	timeouts := types.ObjectValueMust(frameworkTimeoutsAttrTypes, map[string]attr.Value{
+		"create": types.StringValue("30s"),
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringNull(),
+	})
+	for operation, want := range map[string]time.Duration{"create": 30 * time.Second, "read": defaultResourceTimeout} {
+		timeoutCtx, cancel := withFrameworkTimeout(ctx, timeouts, operation)
+		deadline, _ := timeoutCtx.Deadline()
+		cancel()
+		if timeout := time.Until(deadline); timeout > want || timeout < want-time.Minute {
+			t.Errorf("expected a %s timeout of %s, got %s", operation, want, timeout)
+		}
+	}
+}
+
+// TestProviderStateUpgradersAcceptSparseStates enforces that the state upgraders don't expect the optional attributes
+// to be set, as states written by earlier releases may miss them.
+func TestProviderStateUpgradersAcceptSparseStates(t *testing.T) {
+	for name, r := range New("dev")().ResourcesMap {
+		for _, upgrader := range r.StateUpgraders {
+			if _, err := upgrader.Upgrade(context.Background(), map[string]any{"id": "1"}, nil); err != nil {
+				t.Errorf("%s: upgrading a version %d state: %s", name, upgrader.Version, err)
+			}
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -36,3 +208,53 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestProviderConfigureAuthentication(t *testing.T) {
+	t.Setenv("SQUADCAST_REFRESH_TOKEN", "")
+	t.Setenv("SQUADCAST_CLIENT_ID", "")
+	t.Setenv("SQUADCAST_CLIENT_SECRET", "")
+	t.Setenv("SQUADCAST_SERVICE_ACCOUNT_TOKEN", "")
+	t.Setenv("SQUADCAST_TOKEN_FILE", "")
+	t.Setenv("SQUADCAST_TOKEN_COMMAND", "")
+
+	cases := map[string]map[string]any{
+		"none":                {},
+		"refresh and service": {"refresh_token": "refresh", "service_account_token": "token"},
+		"refresh and oauth":   {"refresh_token": "refresh", "client_id": "id", "client_secret": "secret"},
+		"client id only":      {"client_id": "id"},
+		"client secret only":  {"client_secret": "secret"},
+		"file and command":    {"token_file": "token", "token_command": "echo token"},
+	}
+
+	for name, config := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := New("dev")().Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, "client_") {
+				t.Fatalf("expected an authentication configuration error, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureAuthentication_refreshTokenFromEnv(t *testing.T) {
+	t.Setenv("SQUADCAST_REFRESH_TOKEN", "refresh")
+	t.Setenv("SQUADCAST_CLIENT_ID", "")
+	t.Setenv("SQUADCAST_CLIENT_SECRET", "")
+	t.Setenv("SQUADCAST_SERVICE_ACCOUNT_TOKEN", "")
+	t.Setenv("SQUADCAST_TOKEN_FILE", "")
+	t.Setenv("SQUADCAST_TOKEN_COMMAND", "")
+
+	// The refresh token of the environment does not count as a method when another one is configured, and is used
+	// without one. The dev region has no API to connect to, the configuration is checked before connecting.
+	for name, config := range map[string]providerConfig{
+		"service account": {Region: "dev", ServiceAccountToken: "token"},
+		"oauth":           {Region: "dev", ClientID: "id", ClientSecret: "secret"},
+		"token file":      {Region: "dev", TokenFile: "token"},
+		"environment":     {Region: "dev"},
+	} {
+		_, diags := newClient(context.Background(), "test", config)
+		if diags.HasError() && (strings.HasPrefix(diags[0].Summary, "only one of") || strings.HasPrefix(diags[0].Summary, "one of")) {
+			t.Errorf("%s: expected a single authentication method, got %v", name, diags)
+		}
+	}
+}
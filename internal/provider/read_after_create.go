@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// readAfterCreateRetries is the number of times the read of a resource after its creation is retried when it fails.
+const readAfterCreateRetries = 3
+
+// readAfterCreateDelay is the delay before the first retry of a read after creation, it grows with every retry.
+var readAfterCreateDelay = time.Second
+
+// readAfterCreate reads a resource after its creation, and retries the read when it fails or does not find the
+// resource, e.g. after a transient error of the API or while the API creates it asynchronously. When the read keeps
+// failing, its errors are returned as warnings: the resource was created, it is kept in the state with the configured
+// attributes rather than being tainted and replaced by the next apply, and it is read again by the next refresh.
+func readAfterCreate(ctx context.Context, d *schema.ResourceData, meta any, read schema.ReadContextFunc) diag.Diagnostics {
+	id := d.Id()
+
+	var diags diag.Diagnostics
+	for attempt := 0; ; attempt++ {
+		diags = read(ctx, d, meta)
+		if d.Id() == "" {
+			d.SetId(id)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Resource not found after its creation",
+			})
+		}
+		if !diags.HasError() || attempt == readAfterCreateRetries || ctx.Err() != nil {
+			break
+		}
+
+		tflog.Info(ctx, "Retrying read after creation", tf.M{
+			"id":      id,
+			"attempt": attempt + 1,
+		})
+		select {
+		case <-ctx.Done():
+		case <-time.After(readAfterCreateDelay * time.Duration(attempt+1)):
+		}
+	}
+	if !diags.HasError() {
+		return diags
+	}
+
+	warnings := make(diag.Diagnostics, 0, len(diags)+1)
+	warnings = append(warnings, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Resource created but not read",
+		Detail: fmt.Sprintf("The resource was created with the id %s, but it could not be read back. It is kept in the state with its configured attributes, "+
+			"and is read again by the next refresh, the errors of the read follow.", id),
+	})
+	for _, d := range diags {
+		d.Severity = diag.Warning
+		warnings = append(warnings, d)
+	}
+
+	return warnings
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReadAfterCreate(t *testing.T) {
+	readAfterCreateDelay = 0
+
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+	}}
+
+	// read fails the given number of times, or clears the id when notFound is set, before it succeeds.
+	read := func(failures int, notFound bool, attempts *int) schema.ReadContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			*attempts++
+			if *attempts > failures {
+				return nil
+			}
+			if notFound {
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("502 Bad Gateway")
+		}
+	}
+
+	for name, c := range map[string]struct {
+		failures int
+		notFound bool
+		attempts int
+		warnings int
+	}{
+		"read":                 {failures: 0, attempts: 1},
+		"transient error":      {failures: 2, attempts: 3},
+		"not found yet":        {failures: 1, notFound: true, attempts: 2},
+		"persistent error":     {failures: 10, attempts: readAfterCreateRetries + 1, warnings: 2},
+		"persistent not found": {failures: 10, notFound: true, attempts: readAfterCreateRetries + 1, warnings: 2},
+	} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "test"})
+		d.SetId("61305a8eb7a2fa0e44cfd0f5")
+
+		var attempts int
+		diags := readAfterCreate(context.Background(), d, nil, read(c.failures, c.notFound, &attempts))
+		if diags.HasError() {
+			t.Errorf("%s: expected no error, got %v", name, diags)
+		}
+		if len(diags) != c.warnings {
+			t.Errorf("%s: expected %d warnings, got %v", name, c.warnings, diags)
+		}
+		if attempts != c.attempts {
+			t.Errorf("%s: expected %d reads, got %d", name, c.attempts, attempts)
+		}
+		if d.Id() != "61305a8eb7a2fa0e44cfd0f5" || d.Get("name") != "test" {
+			t.Errorf("%s: expected the resource to be kept in the state, got %q %v", name, d.Id(), d.Get("name"))
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceAlertGroupingWindow() *schema.Resource {
+	return &schema.Resource{
+		Description: "Alert grouping windows override, for a single alert source of a service, the time window in which the alerts of the service are grouped into the same incident, " +
+			"e.g. to group the alerts of Prometheus and CloudWatch on the same service with different windows. " +
+			"Only the alert sources for which the API supports it can have their own window, the API rejects the others. " +
+			"Destroying this resource groups the alerts of the alert source with the window of the service again.",
+
+		CreateContext: resourceAlertGroupingWindowCreate,
+		ReadContext:   resourceAlertGroupingWindowRead,
+		UpdateContext: resourceAlertGroupingWindowUpdate,
+		DeleteContext: resourceAlertGroupingWindowDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlertGroupingWindowImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Alert source id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"alert_source": {
+				Description: "Alert source name. Find all alert sources supported on Squadcast [here](https://www.squadcast.com/integrations).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": enabledSchema("Whether the alerts of the alert source are grouped with this window rather than with the window of the service."),
+			"time_window": {
+				Description:  "Length of the grouping window, in time_unit.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"time_unit": {
+				Description:  "Time unit of time_window (minute or hour).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "minute",
+				ValidateFunc: validation.StringInSlice([]string{"minute", "hour"}, false),
+			},
+		},
+	}
+}
+
+func resourceAlertGroupingWindowImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	_, serviceID, alertSourceName, err := parse3PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, alertSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("service_id", serviceID)
+	d.Set("alert_source", alertSourceName)
+	d.SetId(alertSource.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlertGroupingWindowCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, d.Get("alert_source").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(alertSource.ID)
+
+	return resourceAlertGroupingWindowUpdate(ctx, d, meta)
+}
+
+func resourceAlertGroupingWindowRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading alert grouping window", tf.M{
+		"service_id":   d.Get("service_id").(string),
+		"alert_source": d.Get("alert_source").(string),
+	})
+	window, err := client.GetAlertGroupingWindow(ctx, d.Get("service_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(window, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAlertGroupingWindowUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Updating alert grouping window", tf.M{
+		"service_id":   d.Get("service_id").(string),
+		"alert_source": d.Get("alert_source").(string),
+	})
+	_, err := client.UpdateAlertGroupingWindow(ctx, d.Get("service_id").(string), d.Id(), &api.UpdateAlertGroupingWindowReq{
+		Enabled:    d.Get("enabled").(bool),
+		TimeWindow: d.Get("time_window").(int),
+		TimeUnit:   d.Get("time_unit").(string),
+	})
+	if err != nil {
+		if d.IsNewResource() {
+			d.SetId("")
+		}
+		return diagFromErr(err)
+	}
+
+	return resourceAlertGroupingWindowRead(ctx, d, meta)
+}
+
+func resourceAlertGroupingWindowDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteAlertGroupingWindow(ctx, d.Get("service_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceAlertGroupingWindow(t *testing.T) {
+	prometheusName := "squadcast_alert_grouping_window.prometheus"
+	cloudwatchName := "squadcast_alert_grouping_window.cloudwatch"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertGroupingWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertGroupingWindowConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(prometheusName, "id"),
+					resource.TestCheckResourceAttr(prometheusName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(prometheusName, "alert_source", "prometheus"),
+					resource.TestCheckResourceAttr(prometheusName, "enabled", "true"),
+					resource.TestCheckResourceAttr(prometheusName, "time_window", "5"),
+					resource.TestCheckResourceAttr(prometheusName, "time_unit", "minute"),
+					resource.TestCheckResourceAttr(cloudwatchName, "alert_source", "amazon-cloudwatch"),
+					resource.TestCheckResourceAttr(cloudwatchName, "time_window", "1"),
+					resource.TestCheckResourceAttr(cloudwatchName, "time_unit", "hour"),
+				),
+			},
+			{
+				Config: testAccResourceAlertGroupingWindowConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prometheusName, "enabled", "false"),
+					resource.TestCheckResourceAttr(prometheusName, "time_window", "5"),
+				),
+			},
+			{
+				ResourceName:      prometheusName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd:prometheus",
+			},
+		},
+	})
+}
+
+func testAccCheckAlertGroupingWindowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_alert_grouping_window" {
+			continue
+		}
+
+		window, err := client.GetAlertGroupingWindow(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.ID)
+		if err == nil && window.Enabled {
+			return fmt.Errorf("expected alert grouping window to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if err != nil && !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceAlertGroupingWindowConfig(prometheusEnabled bool) string {
+	return fmt.Sprintf(`
+resource "squadcast_alert_grouping_window" "prometheus" {
+	service_id = "61361611c2fc70c3101ca7dd"
+	alert_source = "prometheus"
+	enabled = %t
+	time_window = 5
+}
+
+resource "squadcast_alert_grouping_window" "cloudwatch" {
+	service_id = "61361611c2fc70c3101ca7dd"
+	alert_source = "amazon-cloudwatch"
+	time_window = 1
+	time_unit = "hour"
+}
+	`, prometheusEnabled)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const alertRulesID = "alert_rules"
+
+// alertRulesStageSchema reuses the `rules` schema of a standalone rules resource as an optional stage of the pipeline.
+func alertRulesStageSchema(r *schema.Resource, description string) *schema.Schema {
+	s := *r.Schema["rules"]
+	s.Description = description
+	s.Required = false
+	s.Optional = true
+
+	return &s
+}
+
+func resourceAlertRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to manage the complete alert rule pipeline of a service in a single place. " +
+			"The rules are applied in the order in which Squadcast evaluates them: tagging, deduplication, suppression and routing. " +
+			"A stage that is not configured is emptied, so this resource must not be used together with `squadcast_tagging_rules`, `squadcast_deduplication_rules`, `squadcast_suppression_rules` or `squadcast_routing_rules` for the same service.",
+
+		CreateContext: resourceAlertRulesCreate,
+		ReadContext:   resourceAlertRulesRead,
+		UpdateContext: resourceAlertRulesUpdate,
+		DeleteContext: resourceAlertRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlertRulesImport,
+		},
+
+		CustomizeDiff: resourceAlertRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"tagging_rules":       alertRulesStageSchema(resourceTaggingRules(), "Tagging rules, evaluated first. Same format as the `rules` of `squadcast_tagging_rules`."),
+			"deduplication_rules": alertRulesStageSchema(resourceDeduplicationRules(), "Deduplication rules, evaluated after tagging. Same format as the `rules` of `squadcast_deduplication_rules`."),
+			"suppression_rules":   alertRulesStageSchema(resourceSuppressionRules(), "Suppression rules, evaluated after deduplication. Same format as the `rules` of `squadcast_suppression_rules`."),
+			"routing_rules":       alertRulesStageSchema(resourceRoutingRules(), "Routing rules, evaluated last. Same format as the `rules` of `squadcast_routing_rules`."),
+		},
+	}
+}
+
+func resourceAlertRulesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, serviceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.SetId(alertRulesID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlertRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return validateSuppressionRulesTimeSlots("suppression_rules", d.Get("suppression_rules").([]any))
+}
+
+type alertRulesStages struct {
+	tagging       []api.TaggingRule
+	deduplication []api.DeduplicationRule
+	suppression   []api.SuppressionRule
+	routing       []api.RoutingRule
+}
+
+func decodeAlertRulesStages(get func(key string) any) (*alertRulesStages, error) {
+	var err error
+	stages := &alertRulesStages{}
+
+	if stages.tagging, err = decodeTaggingRules(get("tagging_rules").([]any)); err != nil {
+		return nil, err
+	}
+	if err = Decode(get("deduplication_rules"), &stages.deduplication); err != nil {
+		return nil, err
+	}
+	if stages.suppression, err = decodeSuppressionRules(get("suppression_rules").([]any)); err != nil {
+		return nil, err
+	}
+	if err = Decode(get("routing_rules"), &stages.routing); err != nil {
+		return nil, err
+	}
+
+	return stages, nil
+}
+
+// updateAlertRules writes every stage of the pipeline, one after the other, in evaluation order.
+// When base is set, each stage is merged with the rules changed concurrently by others instead of being replaced.
+func updateAlertRules(ctx context.Context, client *api.Client, teamID, serviceID string, base, local *alertRulesStages) diag.Diagnostics {
+	var err error
+
+	if base == nil {
+		_, err = client.UpdateTaggingRules(ctx, serviceID, teamID, &api.UpdateTaggingRulesReq{Rules: emptyIfNil(local.tagging)})
+	} else {
+		err = client.MergeUpdateTaggingRules(ctx, serviceID, teamID, base.tagging, emptyIfNil(local.tagging))
+	}
+	if err != nil {
+		return diag.Errorf("updating tagging rules: %s", err)
+	}
+
+	if base == nil {
+		_, err = client.UpdateDeduplicationRules(ctx, serviceID, teamID, &api.UpdateDeduplicationRulesReq{Rules: emptyIfNil(local.deduplication)})
+	} else {
+		err = client.MergeUpdateDeduplicationRules(ctx, serviceID, teamID, base.deduplication, emptyIfNil(local.deduplication))
+	}
+	if err != nil {
+		return diag.Errorf("updating deduplication rules: %s", err)
+	}
+
+	if base == nil {
+		_, err = client.UpdateSuppressionRules(ctx, serviceID, teamID, &api.UpdateSuppressionRulesReq{Rules: emptyIfNil(local.suppression)})
+	} else {
+		err = client.MergeUpdateSuppressionRules(ctx, serviceID, teamID, base.suppression, emptyIfNil(local.suppression))
+	}
+	if err != nil {
+		return diag.Errorf("updating suppression rules: %s", err)
+	}
+
+	if base == nil {
+		_, err = client.UpdateRoutingRules(ctx, serviceID, teamID, &api.UpdateRoutingRulesReq{Rules: emptyIfNil(local.routing)})
+	} else {
+		err = client.MergeUpdateRoutingRules(ctx, serviceID, teamID, base.routing, emptyIfNil(local.routing))
+	}
+	if err != nil {
+		return diag.Errorf("updating routing rules: %s", err)
+	}
+
+	return nil
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func resourceAlertRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating alert_rules", tf.M{
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	local, err := decodeAlertRulesStages(d.Get)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if diags := updateAlertRules(ctx, client, d.Get("team_id").(string), d.Get("service_id").(string), nil, local); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(alertRulesID)
+
+	return readAfterCreate(ctx, d, meta, resourceAlertRulesRead)
+}
+
+func resourceAlertRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	serviceID, ok := d.GetOk("service_id")
+	if !ok {
+		return diag.Errorf("invalid service id provided")
+	}
+
+	tflog.Info(ctx, "Reading alert_rules", tf.M{
+		"id":         d.Id(),
+		"team_id":    teamID.(string),
+		"service_id": serviceID.(string),
+	})
+
+	taggingRules, err := client.GetTaggingRules(ctx, serviceID.(string), teamID.(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	deduplicationRules, err := client.GetDeduplicationRules(ctx, serviceID.(string), teamID.(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	suppressionRules, err := client.GetSuppressionRules(ctx, serviceID.(string), teamID.(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	routingRules, err := client.GetRoutingRules(ctx, serviceID.(string), teamID.(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	stages := map[string]tf.StateEncoder{
+		"tagging_rules":       taggingRules,
+		"deduplication_rules": deduplicationRules,
+		"suppression_rules":   suppressionRules,
+		"routing_rules":       routingRules,
+	}
+	for key, stage := range stages {
+		m, err := stage.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = d.Set(key, m["rules"]); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlertRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	base, err := decodeAlertRulesStages(func(key string) any {
+		old, _ := d.GetChange(key)
+		return old
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	local, err := decodeAlertRulesStages(d.Get)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if diags := updateAlertRules(ctx, client, d.Get("team_id").(string), d.Get("service_id").(string), base, local); diags.HasError() {
+		return diags
+	}
+
+	return resourceAlertRulesRead(ctx, d, meta)
+}
+
+func resourceAlertRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	base, err := decodeAlertRulesStages(d.Get)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Tear the pipeline down in the reverse of the evaluation order.
+	if err = client.MergeUpdateRoutingRules(ctx, serviceID, teamID, base.routing, []api.RoutingRule{}); err != nil {
+		return diagFromErr(err)
+	}
+	if err = client.MergeUpdateSuppressionRules(ctx, serviceID, teamID, base.suppression, []api.SuppressionRule{}); err != nil {
+		return diagFromErr(err)
+	}
+	if err = client.MergeUpdateDeduplicationRules(ctx, serviceID, teamID, base.deduplication, []api.DeduplicationRule{}); err != nil {
+		return diagFromErr(err)
+	}
+	if err = client.MergeUpdateTaggingRules(ctx, serviceID, teamID, base.tagging, []api.TaggingRule{}); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
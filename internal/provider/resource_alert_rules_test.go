@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceAlertRules(t *testing.T) {
+	resourceName := "squadcast_alert_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAlertRulesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "tagging_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tagging_rules.0.tags.0.key", "MyTag"),
+					resource.TestCheckResourceAttr(resourceName, "deduplication_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_rules.0.description", "not basic"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceAlertRulesConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tagging_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deduplication_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deduplication_rules.0.description", "not basic"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rules.0.route_to_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rules.0.route_to_id", "5f8891527f735f0a6646f3b6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd",
+			},
+		},
+	})
+}
+
+func testAccCheckAlertRulesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_alert_rules" {
+			continue
+		}
+
+		serviceID, teamID := rs.Primary.Attributes["service_id"], rs.Primary.Attributes["team_id"]
+
+		taggingRules, err := client.GetTaggingRules(context.Background(), serviceID, teamID)
+		if err != nil {
+			return err
+		}
+		deduplicationRules, err := client.GetDeduplicationRules(context.Background(), serviceID, teamID)
+		if err != nil {
+			return err
+		}
+		suppressionRules, err := client.GetSuppressionRules(context.Background(), serviceID, teamID)
+		if err != nil {
+			return err
+		}
+		routingRules, err := client.GetRoutingRules(context.Background(), serviceID, teamID)
+		if err != nil {
+			return err
+		}
+
+		count := len(taggingRules.Rules) + len(deduplicationRules.Rules) + len(suppressionRules.Rules) + len(routingRules.Rules)
+		if count > 0 {
+			return fmt.Errorf("expected all alert rules to be destroyed, %d found", count)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceAlertRulesConfig() string {
+	return `
+resource "squadcast_alert_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	tagging_rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+
+		tags {
+			key = "MyTag"
+			value = "foo"
+			color = "#ababab"
+		}
+	}
+
+	suppression_rules {
+		is_basic = false
+		description = "not basic"
+		expression = "payload[\"event_id\"] == 40"
+	}
+}
+	`
+}
+
+func testAccResourceAlertRulesConfig_update() string {
+	return `
+resource "squadcast_alert_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	tagging_rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+
+		tags {
+			key = "MyTag"
+			value = "foo"
+			color = "#ababab"
+		}
+	}
+
+	deduplication_rules {
+		is_basic = false
+		description = "not basic"
+		expression = "payload[\"event_id\"] == 40"
+	}
+
+	routing_rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+		route_to_id = "5f8891527f735f0a6646f3b6"
+		route_to_type = "user"
+	}
+}
+	`
+}
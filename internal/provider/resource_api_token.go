@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceAPIToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to mint an API token of the organization for automation, e.g. for the downstream webhook consumers of a team, without sharing the refresh token of a user. " +
+			"The token is revoked when the resource is destroyed. It is replaced when its settings or `keepers` change, e.g. with the id of a `time_rotating` resource to rotate it on a schedule. " +
+			"Use the `create_before_destroy` lifecycle setting for the new token to be created before the previous one is revoked.",
+
+		CreateContext: resourceAPITokenCreate,
+		ReadContext:   resourceAPITokenRead,
+		DeleteContext: resourceAPITokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the token.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				ForceNew:     true,
+			},
+			"scope": {
+				Description:  "Scope of the token. (read-only, analytics or full) `read-only` can read all the objects, `analytics` can only read the analytics and `full` can read and write all the objects.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"read-only", "analytics", "full"}, false),
+				ForceNew:     true,
+			},
+			"expires_in_days": {
+				Description:  "Number of days the token is valid for, between 1 and 365. The token never expires when not set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 365),
+				ForceNew:     true,
+			},
+			"keepers": {
+				Description: "Arbitrary values replacing the token when they change, e.g. the id of a `time_rotating` resource.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token": {
+				Description: "The token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "Creation time of the token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "Expiry time of the token, empty when it never expires.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating api token", tf.M{
+		"name":  d.Get("name").(string),
+		"scope": d.Get("scope").(string),
+	})
+	token, err := client.CreateAPIToken(ctx, &api.CreateAPITokenReq{
+		Name:          d.Get("name").(string),
+		Scope:         d.Get("scope").(string),
+		ExpiresInDays: d.Get("expires_in_days").(int),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(token.ID)
+	if err = d.Set("token", token.Token); err != nil {
+		return diagFromErr(err)
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceAPITokenRead)
+}
+
+func resourceAPITokenRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading api token", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	token, err := client.GetAPITokenByID(ctx, d.Id())
+	if err != nil {
+		// Expired and revoked tokens are not found, they are created again.
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(token, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAPITokenDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteAPIToken(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAPIToken(t *testing.T) {
+	tokenName := testAccName("token")
+	resourceName := "squadcast_api_token.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAPITokenConfig(tokenName, "2024-03"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", tokenName),
+					resource.TestCheckResourceAttr(resourceName, "scope", "read-only"),
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "2024-03"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+			{
+				// A change of the keepers replaces the token.
+				Config:             testAccResourceAPITokenConfig(tokenName, "2024-04"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAPITokenConfig(tokenName string, rotation string) string {
+	return fmt.Sprintf(`
+resource "squadcast_api_token" "test" {
+	name = "%s"
+	scope = "read-only"
+	expires_in_days = 30
+
+	keepers = {
+		rotation = "%s"
+	}
+}
+	`, tokenName, rotation)
+}
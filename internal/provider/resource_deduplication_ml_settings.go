@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const deduplicationMLSettingsID = "deduplication_ml_settings"
+
+func resourceDeduplicationMLSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "ML deduplication settings tune how similar alerts of a service are grouped into the same incident, in addition to the `squadcast_deduplication_rules`. " +
+			"Destroying this resource resets the settings of the service to the defaults of the organization.",
+
+		CreateContext: resourceDeduplicationMLSettingsCreate,
+		ReadContext:   resourceDeduplicationMLSettingsRead,
+		UpdateContext: resourceDeduplicationMLSettingsUpdate,
+		DeleteContext: resourceDeduplicationMLSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeduplicationMLSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"enabled": {
+				Description: "Whether similar alerts are deduplicated.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"similarity_threshold": {
+				Description:  "Minimum similarity, between 0.5 and 1, of an alert to the alerts of an open incident to be deduplicated into it. Higher values group fewer alerts.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.8,
+				ValidateFunc: validation.FloatBetween(0.5, 1),
+			},
+			"time_window": {
+				Description:  "integer for time_unit",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"time_unit": {
+				Description:  "time unit (minute or hour)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hour",
+				ValidateFunc: validation.StringInSlice([]string{"minute", "hour"}, false),
+			},
+		},
+	}
+}
+
+func resourceDeduplicationMLSettingsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	_, serviceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("service_id", serviceID)
+	d.SetId(deduplicationMLSettingsID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDeduplicationMLSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Updating deduplication ml settings", tf.M{
+		"service_id": d.Get("service_id").(string),
+	})
+	_, err := client.UpdateDeduplicationMLSettings(ctx, d.Get("service_id").(string), &api.UpdateDeduplicationMLSettingsReq{
+		Enabled:             d.Get("enabled").(bool),
+		SimilarityThreshold: d.Get("similarity_threshold").(float64),
+		TimeWindow:          d.Get("time_window").(int),
+		TimeUnit:            d.Get("time_unit").(string),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(deduplicationMLSettingsID)
+
+	return readAfterCreate(ctx, d, meta, resourceDeduplicationMLSettingsRead)
+}
+
+func resourceDeduplicationMLSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading deduplication ml settings", tf.M{
+		"service_id": d.Get("service_id").(string),
+	})
+	settings, err := client.GetDeduplicationMLSettings(ctx, d.Get("service_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(settings, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDeduplicationMLSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceDeduplicationMLSettingsCreate(ctx, d, meta)
+}
+
+func resourceDeduplicationMLSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteDeduplicationMLSettings(ctx, d.Get("service_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDeduplicationMLSettings(t *testing.T) {
+	resourceName := "squadcast_deduplication_ml_settings.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDeduplicationMLSettingsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "deduplication_ml_settings"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "similarity_threshold", "0.8"),
+					resource.TestCheckResourceAttr(resourceName, "time_window", "1"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "hour"),
+				),
+			},
+			{
+				Config: testAccResourceDeduplicationMLSettingsConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "deduplication_ml_settings"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "similarity_threshold", "0.9"),
+					resource.TestCheckResourceAttr(resourceName, "time_window", "30"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "minute"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd",
+			},
+		},
+	})
+}
+
+func testAccResourceDeduplicationMLSettingsConfig() string {
+	return `
+resource "squadcast_deduplication_ml_settings" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+}
+	`
+}
+
+func testAccResourceDeduplicationMLSettingsConfig_update() string {
+	return `
+resource "squadcast_deduplication_ml_settings" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+	similarity_threshold = 0.9
+	time_window = 30
+	time_unit = "minute"
+}
+	`
+}
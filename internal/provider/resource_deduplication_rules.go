@@ -14,7 +14,7 @@ const deduplicationRulesID = "deduplication_rules"
 
 func resourceDeduplicationRules() *schema.Resource {
 	return &schema.Resource{
-		Description: "[Deduplication rules](https://support.squadcast.com/docs/de-duplication-rules) can help you reduce alert noise by organising and grouping alerts. This also provides easy access to similar alerts when needed. When these rules evaluate to true for an incoming incident, alerts will get deduplicated.",
+		Description: "[Deduplication rules](https://support.squadcast.com/docs/de-duplication-rules) can help you reduce alert noise by organising and grouping alerts. This also provides easy access to similar alerts when needed. When these rules evaluate to true for an incoming incident, alerts will get deduplicated. This resource manages all the deduplication rules of the service: rules created outside of it are removed on its next update.",
 
 		CreateContext: resourceDeduplicationRulesCreate,
 		ReadContext:   resourceDeduplicationRulesRead,
@@ -49,6 +49,7 @@ func resourceDeduplicationRules() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"enabled": enabledSchema("Whether the rule deduplicates the matching alerts, a disabled rule is kept but skipped."),
 						"is_basic": {
 							Description: "is_basic will be true when users use the drop down selectors which will have lhs, op & rhs value, whereas it will be false when they use the advanced mode and it would have the expression for it's value",
 							Type:        schema.TypeBool,
@@ -133,7 +134,7 @@ func resourceDeduplicationRulesCreate(ctx context.Context, d *schema.ResourceDat
 	var rules []api.DeduplicationRule
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, "Creating deduplication_rules", tf.M{
@@ -143,12 +144,12 @@ func resourceDeduplicationRulesCreate(ctx context.Context, d *schema.ResourceDat
 
 	_, err = client.UpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateDeduplicationRulesReq{Rules: rules})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(deduplicationRulesID)
 
-	return resourceDeduplicationRulesRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceDeduplicationRulesRead)
 }
 
 func resourceDeduplicationRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -171,11 +172,11 @@ func resourceDeduplicationRulesRead(ctx context.Context, d *schema.ResourceData,
 	})
 	deduplicationRules, err := client.GetDeduplicationRules(ctx, serviceID.(string), teamID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(deduplicationRules, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -187,12 +188,19 @@ func resourceDeduplicationRulesUpdate(ctx context.Context, d *schema.ResourceDat
 	var rules []api.DeduplicationRule
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = client.UpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateDeduplicationRulesReq{Rules: rules})
+	oldRules, _ := d.GetChange("rules")
+	var base []api.DeduplicationRule
+	err = Decode(oldRules, &base)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceDeduplicationRulesRead(ctx, d, meta)
@@ -201,9 +209,15 @@ func resourceDeduplicationRulesUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceDeduplicationRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	_, err := client.UpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateDeduplicationRulesReq{Rules: []api.DeduplicationRule{}})
+	var base []api.DeduplicationRule
+	err := Decode(d.Get("rules"), &base)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, []api.DeduplicationRule{})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -24,6 +27,11 @@ func resourceEscalationPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceEscalationPolicyImport,
 		},
+		CustomizeDiff: customdiff.All(
+			validateEntityRefs("entity_owner", "rules.*.targets"),
+			escalationPolicyScheduleTargetsCustomizeDiff,
+			escalationPolicyChannelsCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -57,132 +65,158 @@ func resourceEscalationPolicy() *schema.Resource {
 				Computed:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Description:  "Escalation policy owner id.",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: tf.ValidateObjectID,
-						},
-						"type": {
-							Description:  "Escalation policy owner type. (user or squad or team)",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
-						},
-					},
+					Schema: entityRefSchema("Escalation policy owner", []string{"user", "squad", "team"}, tf.ValidateObjectID),
 				},
 			},
-			"repeat": {
-				Description: "You can choose to repeate the entire policy, if no one acknowledges the incident even after the Escalation Policy has been executed fully once",
-				Type:        schema.TypeList,
+			"tags": {
+				Description: "Escalation policy tags, by key, e.g. the date of the last annual review of the policy. Removing a tag removes it from the policy.",
+				Type:        schema.TypeMap,
 				Optional:    true,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"times": {
-							Description: "The number of times you want this escalation policy to be repeated, maximum allowed to repeat 3 times",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-						"delay_minutes": {
-							Description: "The number of minutes to wait before repeating the escalation policy",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-					},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
 				},
 			},
-			"rules": {
-				Description: "Rules will have the details of who to notify and when to notify and how to notify them.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"delay_minutes": {
-							Type:     schema.TypeInt,
-							Required: true,
-						},
-						"targets": {
-							Type:     schema.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"user", "squad", "schedule", "schedulev2"}, false),
-									},
-								},
-							},
-						},
-						"notification_channels": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{"SMS", "Phone", "Email", "Push"}, false),
+			"repeat": escalationPolicyRepeatSchema(),
+			"rules":  escalationPolicyRulesSchema(escalationPolicyTargetTypes),
+		},
+	}
+}
+
+// escalationPolicyTargetTypes are the types of the targets of the rules of an escalation policy.
+var escalationPolicyTargetTypes = []string{"user", "squad", "schedule", "schedulev2"}
+
+func escalationPolicyRepeatSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "You can choose to repeate the entire policy, if no one acknowledges the incident even after the Escalation Policy has been executed fully once",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"times": {
+					Description: "The number of times you want this escalation policy to be repeated, maximum allowed to repeat 3 times",
+					Type:        schema.TypeInt,
+					Required:    true,
+				},
+				"delay_minutes": {
+					Description: "The number of minutes to wait before repeating the escalation policy",
+					Type:        schema.TypeInt,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// escalationPolicyRulesSchema returns the schema of the rules of an escalation policy, whose targets are of the given types.
+// escalationPolicyTargetSchema is the schema of the targets of the rules of an escalation policy, the entities
+// notified by a rule and how they are notified.
+func escalationPolicyTargetSchema(targetTypes []string) map[string]*schema.Schema {
+	s := entityRefSchema("Target", targetTypes, nil)
+	s["rotation_id"] = &schema.Schema{
+		Description:  "Id of the rotation (layer) of the schedule to notify, e.g. the primary or the secondary rotation. Only for targets of type schedulev2, all the rotations of the schedule are notified when unset.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric rotation id"),
+	}
+	s["display_name"] = &schema.Schema{
+		Description: "Name of the target as shown in Squadcast, e.g. the name of the schedule or the full name of the user, resolved from its id.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["notification_channels"] = &schema.Schema{
+		Description: "Notification channels used for this target, overriding the channels of the rule. SMS and Phone are only available on plans that support them.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"SMS", "Phone", "Email", "Push"}, false),
+		},
+	}
+
+	return s
+}
+
+func escalationPolicyRulesSchema(targetTypes []string) *schema.Schema {
+	return &schema.Schema{
+		Description: "Rules will have the details of who to notify and when to notify and how to notify them.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"delay_minutes": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"targets": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: escalationPolicyTargetSchema(targetTypes),
+					},
+				},
+				"notification_channels": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{"SMS", "Phone", "Email", "Push"}, false),
+					},
+				},
+				"round_robin": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Description: "Enables Round Robin escalation within this layer",
+								Type:        schema.TypeBool,
+								Required:    true,
 							},
-						},
-						"round_robin": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MinItems: 1,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"enabled": {
-										Description: "Enables Round Robin escalation within this layer",
-										Type:        schema.TypeBool,
-										Required:    true,
-									},
-									"rotation": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"enabled": {
-													Description: "enable rotation within",
-													Type:        schema.TypeBool,
-													Optional:    true,
-												},
-												"delay_minutes": {
-													Description: "repeat after minutes",
-													Type:        schema.TypeInt,
-													Optional:    true,
-												},
-											},
+							"rotation": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 1,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"enabled": {
+											Description: "enable rotation within. Defaults to `false`.",
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Default:     false,
+										},
+										"delay_minutes": {
+											Description: "repeat after minutes",
+											Type:        schema.TypeInt,
+											Optional:    true,
 										},
 									},
 								},
 							},
 						},
-						"repeat": {
-							Description: "repeat this rule",
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"times": {
-										Description: "repeat times",
-										Type:        schema.TypeInt,
-										Required:    true,
-									},
-									"delay_minutes": {
-										Description: "repeat after minutes",
-										Type:        schema.TypeInt,
-										Required:    true,
-									},
-								},
+					},
+				},
+				"repeat": {
+					Description: "repeat this rule",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"times": {
+								Description: "repeat times",
+								Type:        schema.TypeInt,
+								Required:    true,
+							},
+							"delay_minutes": {
+								Description: "repeat after minutes",
+								Type:        schema.TypeInt,
+								Required:    true,
 							},
 						},
 					},
@@ -247,16 +281,26 @@ func decodeEscalationPolicyRules(mrules []tf.M) ([]api.EscalationPolicyRule, err
 			ID := mtarget["id"].(string)
 			target := api.EscalationPolicyTarget{
 				Type: targetType,
+				Via:  tf.ListToSlice[string](mtarget["notification_channels"]),
 			}
 			if targetType == "schedulev2" {
 				id, err := strconv.Atoi(ID)
 				if err != nil {
-					diag.Errorf("unable to convert schedule ID to int")
+					if legacyScheduleIDRegexp.MatchString(ID) {
+						return nil, legacySchedulePairingError(fmt.Sprintf("rule %d: target", i), ID)
+					}
+					return nil, fmt.Errorf("rule %d: unable to convert schedule ID %s to int", i, ID)
 				}
 				target.PID = id
 			} else {
 				target.ID = ID
 			}
+			if rotationID := mtarget["rotation_id"].(string); rotationID != "" {
+				if targetType != "schedulev2" && targetType != "parameter" {
+					return nil, fmt.Errorf("rule %d: rotation_id can only be set on targets of type schedulev2, got %s", i, targetType)
+				}
+				target.RotationID, _ = strconv.Atoi(rotationID)
+			}
 			targets = append(targets, &target)
 		}
 		rule.Targets = targets
@@ -269,8 +313,39 @@ func decodeEscalationPolicyRules(mrules []tf.M) ([]api.EscalationPolicyRule, err
 	return rules, nil
 }
 
-func decodeEscalationPolicy(d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyReq, error) {
-	rules, err := decodeEscalationPolicyRules(tf.ListToSlice[tf.M](d.Get("rules")))
+// expandEscalationPolicyTags returns the tags of an escalation policy, sorted by key, and an empty list rather than nil
+// without tags so that the removed tags are cleared.
+func expandEscalationPolicyTags(mtags map[string]any) *[]api.ServiceTag {
+	tags := make([]api.ServiceTag, 0, len(mtags))
+	for key, value := range mtags {
+		tags = append(tags, api.ServiceTag{Key: key, Value: value.(string)})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+
+	return &tags
+}
+
+// flattenEscalationPolicyTags returns the tags of an escalation policy by key.
+func flattenEscalationPolicyTags(tags []api.ServiceTag) tf.M {
+	mtags := make(tf.M, len(tags))
+	for _, tag := range tags {
+		mtags[tag.Key] = tag.Value
+	}
+
+	return mtags
+}
+
+func decodeEscalationPolicy(ctx context.Context, client *api.Client, d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyReq, error) {
+	mrules := d.Get("rules")
+	mentityOwner := d.Get("entity_owner").([]interface{})
+	err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"rules": mrules, "entity_owner": mentityOwner}, "rules.*.targets", "entity_owner")
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := decodeEscalationPolicyRules(tf.ListToSlice[tf.M](mrules))
 	if err != nil {
 		return nil, fmt.Errorf("escalation policy `%s` is invalid: %s", d.Get("name").(string), err.Error())
 	}
@@ -282,9 +357,9 @@ func decodeEscalationPolicy(d *schema.ResourceData) (*api.CreateUpdateEscalation
 		RepeatAfterMinutes: d.Get("repeat.0.delay_minutes").(int),
 		Rules:              rules,
 		IsUsingNewFields:   true,
+		Tags:               expandEscalationPolicyTags(d.Get("tags").(map[string]any)),
 	}
 
-	mentityOwner := d.Get("entity_owner").([]interface{})
 	if len(mentityOwner) > 0 {
 		entityOwnerMap, ok := mentityOwner[0].(map[string]interface{})
 		if !ok {
@@ -299,6 +374,67 @@ func decodeEscalationPolicy(d *schema.ResourceData) (*api.CreateUpdateEscalation
 	return req, nil
 }
 
+// escalationPolicyChannelsCustomizeDiff rejects during plan the SMS and Phone notification channels of the rules and
+// of their targets when the plan of the organization does not support them.
+func escalationPolicyChannelsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client, ok := meta.(*api.Client)
+	if !ok {
+		return nil
+	}
+
+	return validateEscalationPolicyChannels(ctx, client, escalationPolicyChannelPaths(d.Get("rules")))
+}
+
+// escalationPolicyChannelPaths returns the notification channels used by the rules and their targets, with the path
+// of the first attribute using each of them.
+func escalationPolicyChannelPaths(rules any) map[string]string {
+	paths := map[string]string{}
+	add := func(channels any, path string) {
+		for _, channel := range tf.ListToSlice[string](channels) {
+			if _, ok := paths[channel]; !ok {
+				paths[channel] = path
+			}
+		}
+	}
+
+	for i, mrule := range tf.ListToSlice[tf.M](rules) {
+		add(mrule["notification_channels"], fmt.Sprintf("rules.%d.notification_channels", i))
+		for j, mtarget := range tf.ListToSlice[tf.M](mrule["targets"]) {
+			add(mtarget["notification_channels"], fmt.Sprintf("rules.%d.targets.%d.notification_channels", i, j))
+		}
+	}
+
+	return paths
+}
+
+// validateEscalationPolicyChannels makes sure the SMS and Phone notification channels, used at the given paths by
+// channel, are only used when the plan of the organization supports them. Channels the plan does not restrict are
+// allowed, and so are all the channels when the plan can not be fetched: the API has the final say.
+func validateEscalationPolicyChannels(ctx context.Context, client *api.Client, paths map[string]string) error {
+	if paths["SMS"] == "" && paths["Phone"] == "" {
+		return nil
+	}
+
+	plan, err := client.GetCurrentOrganizationPlan(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to fetch the plan of the organization, the notification channels are not validated", tf.M{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	for _, feature := range []struct {
+		channel string
+		allowed *bool
+	}{{"SMS", plan.Features.SMS}, {"Phone", plan.Features.Phone}} {
+		if path := paths[feature.channel]; path != "" && feature.allowed != nil && !*feature.allowed {
+			return fmt.Errorf("%s: the `%s` plan of your organization does not support %s notifications, please remove it from the notification channels or upgrade your plan", path, plan.Name, feature.channel)
+		}
+	}
+
+	return nil
+}
+
 func resourceEscalationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -306,19 +442,29 @@ func resourceEscalationPolicyCreate(ctx context.Context, d *schema.ResourceData,
 		"name": d.Get("name").(string),
 	})
 
-	req, err := decodeEscalationPolicy(d)
+	req, err := decodeEscalationPolicy(ctx, client, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	escalationPolicy, err := client.CreateEscalationPolicy(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "escalation policy", req.Name, func() (string, error) {
+			existing, err := client.GetEscalationPolicyByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceEscalationPolicyUpdate(ctx, d, meta)...)
 	}
 
 	d.SetId(escalationPolicy.ID)
 
-	return resourceEscalationPolicyRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceEscalationPolicyRead)
 }
 
 func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -341,11 +487,18 @@ func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(escalationPolicy, d); err != nil {
-		return diag.FromErr(err)
+	m, err := escalationPolicy.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "entity_owner", "rules.*.targets")
+	setEntityRefDisplayNames(ctx, client, teamID.(string), m, "rules.*.targets")
+	m["tags"] = flattenEscalationPolicyTags(escalationPolicy.Tags)
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -354,14 +507,14 @@ func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m
 func resourceEscalationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	req, err := decodeEscalationPolicy(d)
+	req, err := decodeEscalationPolicy(ctx, client, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = client.UpdateEscalationPolicy(ctx, d.Id(), req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceEscalationPolicyRead(ctx, d, meta)
@@ -376,7 +529,7 @@ func resourceEscalationPolicyDelete(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
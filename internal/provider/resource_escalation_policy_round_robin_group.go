@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceEscalationPolicyRoundRobinGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Round robin groups define the pool of members among which incidents are distributed in a round robin enabled escalation rule, and whether the assignment sticks to the same responder for a while. This makes load balanced escalation reproducible across teams.",
+
+		CreateContext: resourceEscalationPolicyRoundRobinGroupCreate,
+		ReadContext:   resourceEscalationPolicyRoundRobinGroupRead,
+		UpdateContext: resourceEscalationPolicyRoundRobinGroupUpdate,
+		DeleteContext: resourceEscalationPolicyRoundRobinGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEscalationPolicyRoundRobinGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Round robin group id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"escalation_policy_id": {
+				Description:  "Escalation policy id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"rule_index": {
+				Description:  "Index of the escalation rule (starting at 0) the round robin group applies to. The rule must have round robin enabled.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				ForceNew:     true,
+			},
+			"members": {
+				Description: "Ordered pool of members among which incidents are distributed.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description:  "Member id.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"type": {
+							Description:  "Member type. (user or squad)",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "squad"}, false),
+						},
+					},
+				},
+			},
+			"sticky_assignment": {
+				Description: "Assign incidents of the same alert to the last assigned member while the stickiness window lasts.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"stickiness_window_minutes": {
+				Description:  "Duration for which an assignment sticks to the same member. Only applicable if sticky_assignment is enabled.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+		},
+	}
+}
+
+func resourceEscalationPolicyRoundRobinGroupImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	escalationPolicyID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("escalation_policy_id", escalationPolicyID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeEscalationPolicyRoundRobinGroup(d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyRoundRobinGroupReq, diag.Diagnostics) {
+	req := &api.CreateUpdateEscalationPolicyRoundRobinGroupReq{
+		RuleIndex:               d.Get("rule_index").(int),
+		StickyAssignment:        d.Get("sticky_assignment").(bool),
+		StickinessWindowMinutes: d.Get("stickiness_window_minutes").(int),
+	}
+
+	if !req.StickyAssignment && req.StickinessWindowMinutes != 0 {
+		return nil, diag.Errorf("stickiness_window_minutes can only be set when sticky_assignment is enabled")
+	}
+
+	err := Decode(d.Get("members"), &req.Members)
+	if err != nil {
+		return nil, diagFromErr(err)
+	}
+
+	return req, nil
+}
+
+func resourceEscalationPolicyRoundRobinGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, diags := decodeEscalationPolicyRoundRobinGroup(d)
+	if diags != nil {
+		return diags
+	}
+
+	tflog.Info(ctx, "Creating escalation policy round robin group", tf.M{
+		"escalation_policy_id": d.Get("escalation_policy_id").(string),
+		"rule_index":           req.RuleIndex,
+	})
+	group, err := client.CreateEscalationPolicyRoundRobinGroup(ctx, d.Get("escalation_policy_id").(string), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(group.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceEscalationPolicyRoundRobinGroupRead)
+}
+
+func resourceEscalationPolicyRoundRobinGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading escalation policy round robin group", tf.M{
+		"id": d.Id(),
+	})
+	group, err := client.GetEscalationPolicyRoundRobinGroupById(ctx, d.Get("escalation_policy_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(group, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEscalationPolicyRoundRobinGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, diags := decodeEscalationPolicyRoundRobinGroup(d)
+	if diags != nil {
+		return diags
+	}
+
+	_, err := client.UpdateEscalationPolicyRoundRobinGroup(ctx, d.Get("escalation_policy_id").(string), d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceEscalationPolicyRoundRobinGroupRead(ctx, d, meta)
+}
+
+func resourceEscalationPolicyRoundRobinGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteEscalationPolicyRoundRobinGroup(ctx, d.Get("escalation_policy_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
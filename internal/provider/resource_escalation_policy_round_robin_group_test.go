@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceEscalationPolicyRoundRobinGroup(t *testing.T) {
+	escalationPolicyName := testAccName("escalation_policy")
+
+	resourceName := "squadcast_escalation_policy_round_robin_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyRoundRobinGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEscalationPolicyRoundRobinGroupConfig(escalationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "escalation_policy_id", "squadcast_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.id", "5f8891527f735f0a6646f3b7"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "members.1.id", "5eb26b36ec9f070550204c85"),
+					resource.TestCheckResourceAttr(resourceName, "members.1.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "sticky_assignment", "false"),
+					resource.TestCheckResourceAttr(resourceName, "stickiness_window_minutes", "0"),
+				),
+			},
+			{
+				Config: testAccResourceEscalationPolicyRoundRobinGroupConfig_update(escalationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.id", "5f8891527f735f0a6646f3b7"),
+					resource.TestCheckResourceAttr(resourceName, "sticky_assignment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stickiness_window_minutes", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEscalationPolicyRoundRobinGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_escalation_policy_round_robin_group" {
+			continue
+		}
+
+		_, err := client.GetEscalationPolicyRoundRobinGroupById(context.Background(), rs.Primary.Attributes["escalation_policy_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected round robin group to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceEscalationPolicyRoundRobinGroupConfig(escalationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b7"
+			type = "user"
+		}
+
+		round_robin {
+			enabled = true
+		}
+	}
+}
+
+resource "squadcast_escalation_policy_round_robin_group" "test" {
+	escalation_policy_id = squadcast_escalation_policy.test.id
+	rule_index = 0
+
+	members {
+		id = "5f8891527f735f0a6646f3b7"
+		type = "user"
+	}
+
+	members {
+		id = "5eb26b36ec9f070550204c85"
+		type = "user"
+	}
+}
+	`, escalationPolicyName)
+}
+
+func testAccResourceEscalationPolicyRoundRobinGroupConfig_update(escalationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b7"
+			type = "user"
+		}
+
+		round_robin {
+			enabled = true
+		}
+	}
+}
+
+resource "squadcast_escalation_policy_round_robin_group" "test" {
+	escalation_policy_id = squadcast_escalation_policy.test.id
+	rule_index = 0
+
+	members {
+		id = "5f8891527f735f0a6646f3b7"
+		type = "user"
+	}
+
+	sticky_assignment = true
+	stickiness_window_minutes = 60
+}
+	`, escalationPolicyName)
+}
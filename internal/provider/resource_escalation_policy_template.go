@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceEscalationPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Escalation policy templates are escalation policy designs shared by the teams of the organization. " +
+			"The targets of type `parameter` of the rules are placeholders, e.g. for the primary schedule of a team, " +
+			"that are replaced by the schedule, squad or user of each team when the template is instantiated with `squadcast_escalation_policy_template_instance`.",
+
+		CreateContext: resourceEscalationPolicyTemplateCreate,
+		ReadContext:   resourceEscalationPolicyTemplateRead,
+		UpdateContext: resourceEscalationPolicyTemplateUpdate,
+		DeleteContext: resourceEscalationPolicyTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEscalationPolicyTemplateImport,
+		},
+		CustomizeDiff: customdiff.All(
+			validateEntityRefs("rules.*.targets"),
+			escalationPolicyScheduleTargetsCustomizeDiff,
+			customdiff.ComputedIf("version", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChanges("name", "description", "parameters", "repeat", "rules")
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Escalation policy template id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the escalation policy template.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the escalation policy template.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "id of the team that maintains the template, the template can be instantiated for any team.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"parameters": {
+				Description: "Parameters of the template, referenced by the targets of type `parameter` of the rules.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name of the parameter, used as the id of the targets of type `parameter`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9_]*$`), "must be lowercase letters, digits and underscores"),
+						},
+						"type": {
+							Description:  "Type of the targets the parameter is replaced by. (user or squad or schedule or schedulev2)",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(escalationPolicyTargetTypes, false),
+						},
+						"description": {
+							Description: "Description of the parameter.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"repeat": escalationPolicyRepeatSchema(),
+			"rules":  escalationPolicyRulesSchema(append(escalationPolicyTargetTypes, "parameter")),
+			"version": {
+				Description: "Version of the template, incremented on every update. Set the `template_version` of the instances to it to update them in the same apply as the template.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceEscalationPolicyTemplateImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := client.GetEscalationPolicyTemplateByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(template.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeEscalationPolicyTemplate(ctx context.Context, client *api.Client, d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyTemplateReq, error) {
+	parameters := make([]*api.EscalationPolicyTemplateParameter, 0)
+	for _, mparameter := range tf.ListToSlice[tf.M](d.Get("parameters")) {
+		parameters = append(parameters, &api.EscalationPolicyTemplateParameter{
+			Name:        mparameter["name"].(string),
+			Type:        mparameter["type"].(string),
+			Description: mparameter["description"].(string),
+		})
+	}
+
+	mrules := d.Get("rules")
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"rules": mrules}, "rules.*.targets"); err != nil {
+		return nil, err
+	}
+
+	rules, err := decodeEscalationPolicyRules(tf.ListToSlice[tf.M](mrules))
+	if err != nil {
+		return nil, fmt.Errorf("escalation policy template `%s` is invalid: %s", d.Get("name").(string), err.Error())
+	}
+
+	if err := validateEscalationPolicyTemplateParameters(parameters, rules); err != nil {
+		return nil, fmt.Errorf("escalation policy template `%s` is invalid: %s", d.Get("name").(string), err.Error())
+	}
+
+	return &api.CreateUpdateEscalationPolicyTemplateReq{
+		TeamID:             d.Get("team_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Parameters:         parameters,
+		RepeatTimes:        d.Get("repeat.0.times").(int),
+		RepeatAfterMinutes: d.Get("repeat.0.delay_minutes").(int),
+		Rules:              rules,
+	}, nil
+}
+
+// validateEscalationPolicyTemplateParameters makes sure that the parameters are unique and declared, and that the
+// rotation_id of a target of type parameter is only set for a parameter of type schedulev2.
+func validateEscalationPolicyTemplateParameters(parameters []*api.EscalationPolicyTemplateParameter, rules []api.EscalationPolicyRule) error {
+	types := map[string]string{}
+	for _, parameter := range parameters {
+		if _, ok := types[parameter.Name]; ok {
+			return fmt.Errorf("parameter %s is declared more than once", parameter.Name)
+		}
+		types[parameter.Name] = parameter.Type
+	}
+
+	for i, rule := range rules {
+		for _, target := range rule.Targets {
+			if target.Type != "parameter" {
+				continue
+			}
+
+			parameterType, ok := types[target.ID]
+			if !ok {
+				return fmt.Errorf("rule %d: parameter %s is not declared", i, target.ID)
+			}
+			if target.RotationID != 0 && parameterType != "schedulev2" {
+				return fmt.Errorf("rule %d: rotation_id can only be set on targets of type schedulev2, parameter %s is of type %s", i, target.ID, parameterType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceEscalationPolicyTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeEscalationPolicyTemplate(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating escalation policy template", tf.M{
+		"name": req.Name,
+	})
+	template, err := client.CreateEscalationPolicyTemplate(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "escalation policy template", req.Name, func() (string, error) {
+			existing, err := client.GetEscalationPolicyTemplateByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceEscalationPolicyTemplateUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(template.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceEscalationPolicyTemplateRead)
+}
+
+func resourceEscalationPolicyTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading escalation policy template", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	template, err := client.GetEscalationPolicyTemplateById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	m, err := template.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "rules.*.targets")
+	setEntityRefDisplayNames(ctx, client, d.Get("team_id").(string), m, "rules.*.targets")
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEscalationPolicyTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeEscalationPolicyTemplate(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateEscalationPolicyTemplate(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceEscalationPolicyTemplateRead(ctx, d, meta)
+}
+
+func resourceEscalationPolicyTemplateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteEscalationPolicyTemplate(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
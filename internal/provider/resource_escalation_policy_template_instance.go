@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceEscalationPolicyTemplateInstance() *schema.Resource {
+	return &schema.Resource{
+		Description: "Escalation policy template instances stamp an escalation policy template onto a team: an escalation policy is created in the team with the rules of the template, " +
+			"whose targets of type `parameter` are replaced by the values of the parameters. The escalation policy is updated when the template changes, " +
+			"changes made to it outside of the template are overwritten.",
+
+		CreateContext: resourceEscalationPolicyTemplateInstanceCreate,
+		ReadContext:   resourceEscalationPolicyTemplateInstanceRead,
+		UpdateContext: resourceEscalationPolicyTemplateInstanceUpdate,
+		DeleteContext: resourceEscalationPolicyTemplateInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEscalationPolicyTemplateInstanceImport,
+		},
+		CustomizeDiff: resourceEscalationPolicyTemplateInstanceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id of the escalation policy created from the template.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"template_id": {
+				Description:  "Escalation policy template id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"template_version": {
+				Description: "Version of the template the escalation policy was created from. Defaults to the latest version, which is refreshed during plan. " +
+					"Set it to the `version` of the `squadcast_escalation_policy_template` to update the escalation policy in the same apply as the template.",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"team_id": {
+				Description:  "id of the team the escalation policy is created in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the escalation policy.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the escalation policy. Defaults to the description of the template.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"parameters": {
+				Description: "Values of the parameters of the template, by parameter name, e.g. the id of the primary schedule of the team.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rules": escalationPolicyComputedRulesSchema("Rules of the escalation policy, rendered from the template. Rules changed outside of the template are planned to be rendered again."),
+		},
+	}
+}
+
+// renderEscalationPolicyTemplate returns the rules of the template whose targets of type parameter are replaced by
+// the targets of the values of the parameters.
+func renderEscalationPolicyTemplate(template *api.EscalationPolicyTemplate, values map[string]string) ([]api.EscalationPolicyRule, error) {
+	parameters := map[string]*api.EscalationPolicyTemplateParameter{}
+	missing := []string{}
+	for _, parameter := range template.Parameters {
+		parameters[parameter.Name] = parameter
+		if values[parameter.Name] == "" {
+			missing = append(missing, parameter.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("parameters: missing values for the parameters %v of the template `%s`", missing, template.Name)
+	}
+
+	unknown := []string{}
+	for name := range values {
+		if _, ok := parameters[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("parameters: the template `%s` has no parameters %v", template.Name, unknown)
+	}
+
+	rules := make([]api.EscalationPolicyRule, 0, len(template.Rules))
+	for i, templateRule := range template.Rules {
+		rule := *templateRule
+		rule.Targets = make([]*api.EscalationPolicyTarget, 0, len(templateRule.Targets))
+
+		for _, templateTarget := range templateRule.Targets {
+			target := *templateTarget
+			if target.Type == "parameter" {
+				parameter, ok := parameters[templateTarget.ID]
+				if !ok {
+					return nil, fmt.Errorf("rule %d: parameter %s is not declared by the template `%s`", i, templateTarget.ID, template.Name)
+				}
+
+				value := values[parameter.Name]
+				attribute := fmt.Sprintf("parameters.%s", parameter.Name)
+				if err := validateScheduleTarget(attribute, parameter.Type, value, ""); err != nil {
+					return nil, err
+				}
+
+				target.Type = parameter.Type
+				target.ID = ""
+				if parameter.Type == "schedulev2" {
+					pid, err := strconv.Atoi(value)
+					if err != nil {
+						return nil, fmt.Errorf("%s: must be a numeric schedule id, got %s", attribute, value)
+					}
+					target.PID = pid
+				} else {
+					target.ID = value
+				}
+			}
+			rule.Targets = append(rule.Targets, &target)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func resourceEscalationPolicyTemplateInstanceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// encodeEscalationPolicyRules encodes the rules of an escalation policy as they are read back from the API.
+func encodeEscalationPolicyRules(rules []api.EscalationPolicyRule) ([]any, error) {
+	refs := make([]*api.EscalationPolicyRule, len(rules))
+	for i := range rules {
+		refs[i] = &rules[i]
+	}
+
+	return tf.EncodeSlice(refs)
+}
+
+// resourceEscalationPolicyTemplateInstanceCustomizeDiff refreshes the template_version to the latest version of the
+// template when it is not configured, and validates the parameters and the notification channels of the template.
+// The rules are planned as rendered from the template, so that the rules changed outside of the template are
+// rendered again, and are unknown when they can not be rendered yet.
+func resourceEscalationPolicyTemplateInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client, ok := meta.(*api.Client)
+	if !ok {
+		return nil
+	}
+
+	versionConfigured := !d.GetRawConfig().GetAttr("template_version").IsNull()
+	if !d.NewValueKnown("template_id") || !d.NewValueKnown("parameters") || (versionConfigured && !d.NewValueKnown("template_version")) {
+		return setEscalationPolicyTemplateInstanceRulesComputed(d)
+	}
+
+	template, err := client.GetEscalationPolicyTemplateById(ctx, d.Get("template_id").(string))
+	if err != nil {
+		return fmt.Errorf("template_id: %w", err)
+	}
+
+	if !versionConfigured && d.Get("template_version").(int) != template.Version {
+		if err := d.SetNew("template_version", template.Version); err != nil {
+			return err
+		}
+	}
+
+	// A configured version that is not the latest one is the version of a template updated in the same apply.
+	if d.Get("template_version").(int) != template.Version {
+		return setEscalationPolicyTemplateInstanceRulesComputed(d)
+	}
+
+	rules, err := renderEscalationPolicyTemplate(template, tf.ExpandStringMap(d.Get("parameters")))
+	if err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		mrules, err := encodeEscalationPolicyRules(rules)
+		if err != nil {
+			return err
+		}
+		if err := d.SetNew("rules", mrules); err != nil {
+			return err
+		}
+	}
+
+	// The notification channels of the rules and their targets come from the template.
+	paths := map[string]string{}
+	for _, rule := range rules {
+		for _, channel := range rule.Via {
+			paths[channel] = "template_id"
+		}
+		for _, target := range rule.Targets {
+			for _, channel := range target.Via {
+				paths[channel] = "template_id"
+			}
+		}
+	}
+
+	return validateEscalationPolicyChannels(ctx, client, paths)
+}
+
+// setEscalationPolicyTemplateInstanceRulesComputed marks the rules of an existing escalation policy as unknown.
+func setEscalationPolicyTemplateInstanceRulesComputed(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	return d.SetNewComputed("rules")
+}
+
+func decodeEscalationPolicyTemplateInstance(ctx context.Context, client *api.Client, d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyReq, *api.EscalationPolicyTemplate, error) {
+	template, err := client.GetEscalationPolicyTemplateById(ctx, d.Get("template_id").(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := renderEscalationPolicyTemplate(template, tf.ExpandStringMap(d.Get("parameters")))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	description := d.Get("description").(string)
+	if description == "" {
+		description = template.Description
+	}
+
+	return &api.CreateUpdateEscalationPolicyReq{
+		TeamID:             d.Get("team_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        description,
+		RepeatTimes:        template.RepeatTimes,
+		RepeatAfterMinutes: template.RepeatAfterMinutes,
+		Rules:              rules,
+		IsUsingNewFields:   true,
+	}, template, nil
+}
+
+func resourceEscalationPolicyTemplateInstanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, template, err := decodeEscalationPolicyTemplateInstance(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating escalation policy from template", tf.M{
+		"name":             req.Name,
+		"template_id":      template.ID,
+		"template_version": template.Version,
+	})
+	escalationPolicy, err := client.CreateEscalationPolicy(ctx, req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(escalationPolicy.ID)
+	d.Set("template_version", template.Version)
+
+	return readAfterCreate(ctx, d, meta, resourceEscalationPolicyTemplateInstanceRead)
+}
+
+func resourceEscalationPolicyTemplateInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading escalation policy created from template", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	escalationPolicy, err := client.GetEscalationPolicyById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	d.Set("name", escalationPolicy.Name)
+	if _, ok := d.GetOk("description"); ok {
+		d.Set("description", escalationPolicy.Description)
+	}
+
+	rules, err := tf.EncodeSlice(escalationPolicy.Rules)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("rules", rules); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEscalationPolicyTemplateInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, template, err := decodeEscalationPolicyTemplateInstance(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateEscalationPolicy(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.Set("template_version", template.Version)
+
+	return resourceEscalationPolicyTemplateInstanceRead(ctx, d, meta)
+}
+
+func resourceEscalationPolicyTemplateInstanceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteEscalationPolicy(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestRenderEscalationPolicyTemplate(t *testing.T) {
+	template := &api.EscalationPolicyTemplate{
+		Name: "standard",
+		Parameters: []*api.EscalationPolicyTemplateParameter{
+			{Name: "primary", Type: "schedulev2"},
+			{Name: "escalation_squad", Type: "squad"},
+		},
+		Rules: []*api.EscalationPolicyRule{
+			{Targets: []*api.EscalationPolicyTarget{{Type: "parameter", ID: "primary", RotationID: 7, Via: []string{"Push"}}}},
+			{EscalateAfterMinutes: 5, Targets: []*api.EscalationPolicyTarget{
+				{Type: "parameter", ID: "escalation_squad"},
+				{Type: "user", ID: "5f9a1b2c3d4e5f6a7b8c9d0e"},
+			}},
+		},
+	}
+
+	rules, err := renderEscalationPolicyTemplate(template, map[string]string{
+		"primary":          "123",
+		"escalation_squad": "613611c1eb22db455cfa7890",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 2 || rules[1].EscalateAfterMinutes != 5 {
+		t.Fatalf("expected the rules of the template, got %#v", rules)
+	}
+	if target := rules[0].Targets[0]; target.Type != "schedulev2" || target.PID != 123 || target.ID != "" || target.RotationID != 7 || target.Via[0] != "Push" {
+		t.Errorf("expected the primary schedule, got %#v", target)
+	}
+	if target := rules[1].Targets[0]; target.Type != "squad" || target.ID != "613611c1eb22db455cfa7890" {
+		t.Errorf("expected the escalation squad, got %#v", target)
+	}
+	if target := rules[1].Targets[1]; target.Type != "user" || target.ID != "5f9a1b2c3d4e5f6a7b8c9d0e" {
+		t.Errorf("expected the user target to be unchanged, got %#v", target)
+	}
+	if template.Rules[0].Targets[0].Type != "parameter" {
+		t.Errorf("expected the template to be unchanged")
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]string
+		err    string
+	}{
+		{name: "missing", values: map[string]string{"primary": "123"}, err: "missing values for the parameters [escalation_squad]"},
+		{name: "unknown", values: map[string]string{"primary": "123", "escalation_squad": "613611c1eb22db455cfa7890", "lead": "x"}, err: "has no parameters [lead]"},
+		{name: "legacy schedule", values: map[string]string{"primary": "5f9a1b2c3d4e5f6a7b8c9d0e", "escalation_squad": "613611c1eb22db455cfa7890"}, err: "parameters.primary.id: 5f9a1b2c3d4e5f6a7b8c9d0e is the id of a legacy squadcast_schedule"},
+		{name: "not numeric", values: map[string]string{"primary": "primary", "escalation_squad": "613611c1eb22db455cfa7890"}, err: "parameters.primary: must be a numeric schedule id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := renderEscalationPolicyTemplate(template, tt.values)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected an error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestResourceEscalationPolicyTemplateInstanceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/escalation-policies/61305a8eb7a2fa0e44cfd0f5" || r.URL.Query().Get("owner_id") != "613611c1eb22db455cfa789f" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f5","name":"SRE","description":"Standard escalation","rules":[` +
+			`{"escalationTime":0,"entities":[{"type":"schedulev2","pid":123,"rotationID":7,"via":["Push"]}]},` +
+			`{"escalationTime":5,"via":["Email"],"entities":[{"type":"squad","id":"613611c1eb22db455cfa7890"}]}]}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceEscalationPolicyTemplateInstance()
+
+	d := r.TestResourceData()
+	d.SetId("613611c1eb22db455cfa789f:61305a8eb7a2fa0e44cfd0f5")
+	if _, err := r.Importer.StateContext(context.Background(), d, client); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "61305a8eb7a2fa0e44cfd0f5" || d.Get("team_id") != "613611c1eb22db455cfa789f" {
+		t.Fatalf("unexpected import of %s in the team %s", d.Id(), d.Get("team_id"))
+	}
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("name") != "SRE" || d.Get("rules.#") != 2 {
+		t.Fatalf("unexpected name %v and rules %v", d.Get("name"), d.Get("rules"))
+	}
+	if d.Get("rules.0.targets.0.id") != "123" || d.Get("rules.0.targets.0.rotation_id") != "7" || d.Get("rules.1.delay_minutes") != 5 {
+		t.Errorf("unexpected rules %v", d.Get("rules"))
+	}
+
+	// The rules rendered from the template are planned the same way, the rules read back don't differ from them.
+	rendered, err := encodeEscalationPolicyRules([]api.EscalationPolicyRule{
+		{Targets: []*api.EscalationPolicyTarget{{Type: "schedulev2", PID: 123, RotationID: 7, Via: []string{"Push"}}}},
+		{EscalateAfterMinutes: 5, Via: []string{"Email"}, Targets: []*api.EscalationPolicyTarget{{Type: "squad", ID: "613611c1eb22db455cfa7890"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	planned := r.TestResourceData()
+	if err = planned.Set("rules", rendered); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(planned.Get("rules"), d.Get("rules")) {
+		t.Errorf("expected the rendered rules %v to match the rules read back %v", planned.Get("rules"), d.Get("rules"))
+	}
+}
+
+func TestAccResourceEscalationPolicyTemplateInstance(t *testing.T) {
+	templateName := testAccName("ep-template")
+	escalationPolicyName := testAccName("escalation-policy")
+
+	resourceName := "squadcast_escalation_policy_template_instance.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyTemplateInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceEscalationPolicyTemplateInstanceConfig(templateName, escalationPolicyName, 5, `primary = "100"`),
+				ExpectError: regexp.MustCompile("missing values for the parameters"),
+			},
+			{
+				Config: testAccResourceEscalationPolicyTemplateInstanceConfig(templateName, escalationPolicyName, 5, `primary = "100"
+		escalation_squad = "61305a8eb7a2fa0e44cfd0f5"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", escalationPolicyName),
+					resource.TestCheckResourceAttr(resourceName, "template_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					testAccCheckEscalationPolicyTemplateInstanceRules(resourceName, 5),
+				),
+			},
+			{
+				Config: testAccResourceEscalationPolicyTemplateInstanceConfig(templateName, escalationPolicyName, 10, `primary = "100"
+		escalation_squad = "61305a8eb7a2fa0e44cfd0f5"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.delay_minutes", "10"),
+					testAccCheckEscalationPolicyTemplateInstanceRules(resourceName, 10),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccEscalationPolicyTemplateInstanceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"template_id", "template_version", "parameters"},
+			},
+		},
+	})
+}
+
+func testAccEscalationPolicyTemplateInstanceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("%s not found", resourceName)
+		}
+
+		return rs.Primary.Attributes["team_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+// testAccCheckEscalationPolicyTemplateInstanceRules checks the rendered rules of the escalation policy.
+func testAccCheckEscalationPolicyTemplateInstanceRules(resourceName string, delayMinutes int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		escalationPolicy, err := client.GetEscalationPolicyById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(escalationPolicy.Rules) != 2 {
+			return fmt.Errorf("expected 2 rules, got %d", len(escalationPolicy.Rules))
+		}
+		if target := escalationPolicy.Rules[0].Targets[0]; target.Type != "schedulev2" || target.PID != 100 {
+			return fmt.Errorf("expected the first rule to target the schedule 100, got %s %d", target.Type, target.PID)
+		}
+		if target := escalationPolicy.Rules[1].Targets[0]; target.Type != "squad" || target.ID != "61305a8eb7a2fa0e44cfd0f5" {
+			return fmt.Errorf("expected the second rule to target the squad, got %s %s", target.Type, target.ID)
+		}
+		if escalationPolicy.Rules[1].EscalateAfterMinutes != delayMinutes {
+			return fmt.Errorf("expected the second rule to escalate after %d minutes, got %d", delayMinutes, escalationPolicy.Rules[1].EscalateAfterMinutes)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEscalationPolicyTemplateInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_escalation_policy_template_instance" {
+			continue
+		}
+
+		_, err := client.GetEscalationPolicyById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected escalation policy to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return testAccCheckEscalationPolicyTemplateDestroy(s)
+}
+
+func testAccResourceEscalationPolicyTemplateInstanceConfig(templateName, escalationPolicyName string, delayMinutes int, parameters string) string {
+	return testAccResourceEscalationPolicyTemplateConfig(templateName, "primary", delayMinutes) + fmt.Sprintf(`
+resource "squadcast_escalation_policy_template_instance" "test" {
+	template_id = squadcast_escalation_policy_template.test.id
+	template_version = squadcast_escalation_policy_template.test.version
+	team_id = "613611c1eb22db455cfa789f"
+	name = "%s"
+
+	parameters = {
+		%s
+	}
+}
+	`, escalationPolicyName, parameters)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestValidateEscalationPolicyTemplateParameters(t *testing.T) {
+	parameters := []*api.EscalationPolicyTemplateParameter{
+		{Name: "primary", Type: "schedulev2"},
+		{Name: "lead", Type: "user"},
+	}
+
+	rules := []api.EscalationPolicyRule{
+		{Targets: []*api.EscalationPolicyTarget{{Type: "parameter", ID: "primary", RotationID: 10}}},
+		{Targets: []*api.EscalationPolicyTarget{{Type: "parameter", ID: "lead"}, {Type: "user", ID: "5f9a1b2c3d4e5f6a7b8c9d0e"}}},
+	}
+	if err := validateEscalationPolicyTemplateParameters(parameters, rules); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+
+	rules = []api.EscalationPolicyRule{
+		{Targets: []*api.EscalationPolicyTarget{{Type: "parameter", ID: "secondary"}}},
+	}
+	if err := validateEscalationPolicyTemplateParameters(parameters, rules); err == nil || err.Error() != "rule 0: parameter secondary is not declared" {
+		t.Errorf("expected an undeclared parameter error, got %v", err)
+	}
+
+	rules = []api.EscalationPolicyRule{
+		{Targets: []*api.EscalationPolicyTarget{{Type: "parameter", ID: "lead", RotationID: 10}}},
+	}
+	if err := validateEscalationPolicyTemplateParameters(parameters, rules); err == nil {
+		t.Errorf("expected a rotation_id error for a parameter of type user")
+	}
+
+	if err := validateEscalationPolicyTemplateParameters(append(parameters, &api.EscalationPolicyTemplateParameter{Name: "lead", Type: "squad"}), nil); err == nil {
+		t.Errorf("expected a duplicated parameter error")
+	}
+}
+
+func TestAccResourceEscalationPolicyTemplate(t *testing.T) {
+	templateName := testAccName("ep-template")
+
+	resourceName := "squadcast_escalation_policy_template.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceEscalationPolicyTemplateConfig(templateName, "secondary", 5),
+				ExpectError: regexp.MustCompile("parameter secondary is not declared"),
+			},
+			{
+				Config: testAccResourceEscalationPolicyTemplateConfig(templateName, "primary", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", templateName),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.name", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.type", "schedulev2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.type", "parameter"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.id", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.delay_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.0.type", "parameter"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.0.id", "escalation_squad"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccResourceEscalationPolicyTemplateConfig(templateName, "primary", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.1.delay_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + templateName,
+			},
+		},
+	})
+}
+
+func testAccCheckEscalationPolicyTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_escalation_policy_template" {
+			continue
+		}
+
+		_, err := client.GetEscalationPolicyTemplateById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected escalation policy template to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceEscalationPolicyTemplateConfig(templateName, firstTarget string, delayMinutes int) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy_template" "test" {
+	name = "%s"
+	description = "Standard two level escalation"
+	team_id = "613611c1eb22db455cfa789f"
+
+	parameters {
+		name = "primary"
+		type = "schedulev2"
+		description = "Primary on-call schedule of the team"
+	}
+
+	parameters {
+		name = "escalation_squad"
+		type = "squad"
+	}
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "%s"
+			type = "parameter"
+		}
+	}
+
+	rules {
+		delay_minutes = %d
+
+		targets {
+			id = "escalation_squad"
+			type = "parameter"
+		}
+
+		notification_channels = ["Email", "Push"]
+	}
+
+	repeat {
+		times = 2
+		delay_minutes = 10
+	}
+}
+	`, templateName, firstTarget, delayMinutes)
+}
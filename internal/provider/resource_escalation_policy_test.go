@@ -3,22 +3,110 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
+func TestEscalationPolicyTags(t *testing.T) {
+	tags := expandEscalationPolicyTags(map[string]any{"owner": "sre", "last_reviewed": "2026-03"})
+	want := []api.ServiceTag{{Key: "last_reviewed", Value: "2026-03"}, {Key: "owner", Value: "sre"}}
+	if !reflect.DeepEqual(*tags, want) {
+		t.Errorf("unexpected tags %v", *tags)
+	}
+	if tags := expandEscalationPolicyTags(map[string]any{}); tags == nil || len(*tags) != 0 {
+		t.Errorf("expected an empty list to clear the tags, got %v", tags)
+	}
+
+	if m := flattenEscalationPolicyTags(want); len(m) != 2 || m["last_reviewed"] != "2026-03" {
+		t.Errorf("unexpected tags %v", m)
+	}
+}
+
+func TestValidateEscalationPolicyChannels(t *testing.T) {
+	for features, valid := range map[string]bool{
+		``:                            true,
+		`{}`:                          true,
+		`{"sms":true,"phone":true}`:   true,
+		`{"sms":false,"phone":true}`:  false,
+		`{"sms":true,"phone":false}`:  true,
+		`{"sms":false,"phone":false}`: false,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v3/organization/plan" {
+				t.Errorf("unexpected request %s", r.URL)
+			}
+			// An empty body is a plan that can not be fetched.
+			if features == "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"data":{"plan_name":"free","features":` + features + `}}`))
+		}))
+
+		client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+		err := validateEscalationPolicyChannels(context.Background(), client, map[string]string{
+			"SMS":   "rules.1.targets.0.notification_channels",
+			"Email": "rules.0.targets.0.notification_channels",
+		})
+		switch {
+		case valid && err != nil:
+			t.Errorf("%s: unexpected error %s", features, err)
+		case !valid && (err == nil || !strings.HasPrefix(err.Error(), "rules.1.targets.0.notification_channels: ")):
+			t.Errorf("%s: expected an error about the SMS channel, got %v", features, err)
+		}
+
+		server.Close()
+	}
+
+	if err := validateEscalationPolicyChannels(context.Background(), &api.Client{}, map[string]string{"Email": "rules.0.targets.0.notification_channels"}); err != nil {
+		t.Errorf("expected the plan not to be fetched without SMS or Phone, got %s", err)
+	}
+}
+
+func TestEscalationPolicyChannelPaths(t *testing.T) {
+	paths := escalationPolicyChannelPaths([]any{
+		map[string]any{
+			"notification_channels": []any{"Email"},
+			"targets": []any{
+				map[string]any{"notification_channels": []any{"Push", "Email"}},
+			},
+		},
+		map[string]any{
+			"notification_channels": []any{"SMS"},
+			"targets": []any{
+				map[string]any{"notification_channels": []any{}},
+				map[string]any{"notification_channels": []any{"Phone", "SMS"}},
+			},
+		},
+	})
+
+	expected := map[string]string{
+		"Email": "rules.0.notification_channels",
+		"Push":  "rules.0.targets.0.notification_channels",
+		"SMS":   "rules.1.notification_channels",
+		"Phone": "rules.1.targets.1.notification_channels",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected the paths %v, got %v", expected, paths)
+	}
+}
+
 func TestAccResourceEscalationPolicy(t *testing.T) {
-	escalationPolicyName := acctest.RandomWithPrefix("escalation_policy")
+	escalationPolicyName := testAccName("escalation_policy")
 
 	resourceName := "squadcast_escalation_policy.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceEscalationPolicyConfig(escalationPolicyName),
@@ -29,6 +117,7 @@ func TestAccResourceEscalationPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "It's an amazing policy"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.type", "team"),
+					resource.TestCheckResourceAttr(resourceName, "tags.last_reviewed", "2026-03"),
 					resource.TestCheckResourceAttr(resourceName, "repeat.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "repeat.0.times", "2"),
 					resource.TestCheckResourceAttr(resourceName, "repeat.0.delay_minutes", "10"),
@@ -38,6 +127,7 @@ func TestAccResourceEscalationPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.id", "5f8891527f735f0a6646f3b7"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.type", "user"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.targets.0.display_name"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.1.id", "5eb26b36ec9f070550204c85"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.1.type", "user"),
 				),
@@ -70,6 +160,9 @@ func TestAccResourceEscalationPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.0.type", "user"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.1.id", "5ef5de4259c32c7ca25b0bfa"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.1.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.1.notification_channels.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.1.notification_channels.0", "Email"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.targets.1.notification_channels.1", "Push"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.repeat.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.repeat.0.times", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.repeat.0.delay_minutes", "5"),
@@ -131,6 +224,33 @@ func TestAccResourceEscalationPolicy(t *testing.T) {
 	})
 }
 
+func TestAccResourceEscalationPolicy_scheduleRotation(t *testing.T) {
+	escalationPolicyName := testAccName("escalation_policy")
+
+	resourceName := "squadcast_escalation_policy.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceEscalationPolicyConfig_scheduleRotation(escalationPolicyName, "user", "5f8891527f735f0a6646f3b7"),
+				ExpectError: regexp.MustCompile("rotation_id can only be set on targets of type schedulev2"),
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_scheduleRotation(escalationPolicyName, "schedulev2", "100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.type", "schedulev2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.rotation_id", "200"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEscalationPolicyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -165,6 +285,10 @@ resource "squadcast_escalation_policy" "test" {
 			type = "team"
 	}
 
+	tags = {
+		last_reviewed = "2026-03"
+	}
+
 	rules {
 		delay_minutes = 0
 
@@ -220,6 +344,7 @@ resource "squadcast_escalation_policy" "test" {
         targets {
             id = "5ef5de4259c32c7ca25b0bfa"
             type = "user"
+            notification_channels = ["Email", "Push"]
         }
 
         notification_channels = ["Phone"]
@@ -311,3 +436,24 @@ resource "squadcast_escalation_policy" "test" {
 }
 	`, escalationPolicyName)
 }
+
+func testAccResourceEscalationPolicyConfig_scheduleRotation(escalationPolicyName, targetType, targetID string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	description = "It's an amazing policy"
+
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "%s"
+			type = "%s"
+			rotation_id = "200"
+		}
+	}
+}
+	`, escalationPolicyName, targetID, targetType)
+}
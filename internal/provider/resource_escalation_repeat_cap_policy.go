@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceEscalationRepeatCapPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Escalation repeat cap policies limit how many times the escalation policies of a team (and their levels) are repeated, " +
+			"overriding the `repeat` settings of the escalation policies, to prevent paging storms. There can be only one policy per team.",
+
+		CreateContext: resourceEscalationRepeatCapPolicyCreate,
+		ReadContext:   resourceEscalationRepeatCapPolicyRead,
+		UpdateContext: resourceEscalationRepeatCapPolicyUpdate,
+		DeleteContext: resourceEscalationRepeatCapPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEscalationRepeatCapPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"max_repeats": {
+				Description:  "Maximum number of times an escalation policy of the team is repeated.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 3),
+			},
+			"max_rule_repeats": {
+				Description:  "Maximum number of times a level of an escalation policy of the team is repeated.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 3),
+			},
+			"timezone": {
+				Description:           "Timezone of the quiet hours exceptions.",
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Default:               "UTC",
+				DiffSuppressFunc:      tf.SuppressEquivalentTimezones,
+				DiffSuppressOnRefresh: true,
+			},
+			"quiet_hours_exceptions": {
+				Description: "Timeslots where a different cap applies, e.g. to stop repeating pages overnight.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_hour": {
+							Description:  "Defines the start hour of the timeslot in the policy timezone.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute": {
+							Description:  "Defines the start minute of the timeslot in the policy timezone.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"duration": {
+							Description:  "Defines the duration of the timeslot. (in minutes)",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 1440),
+						},
+						"day_of_week": {
+							Description:           "Defines the day of the week of the timeslot. If not specified, the timeslot is active on all days of the week.",
+							Type:                  schema.TypeString,
+							Optional:              true,
+							ValidateFunc:          validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, true),
+							DiffSuppressFunc:      tf.SuppressCaseDifferences,
+							DiffSuppressOnRefresh: true,
+						},
+						"max_repeats": {
+							Description:  "Maximum number of times an escalation policy of the team is repeated during the timeslot.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 3),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEscalationRepeatCapPolicyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeEscalationRepeatCapPolicy(d *schema.ResourceData) (*api.UpdateEscalationRepeatCapPolicyReq, error) {
+	var exceptions []api.EscalationRepeatCapQuietHoursException
+	err := Decode(d.Get("quiet_hours_exceptions"), &exceptions)
+	if err != nil {
+		return nil, err
+	}
+	if exceptions == nil {
+		exceptions = []api.EscalationRepeatCapQuietHoursException{}
+	}
+
+	return &api.UpdateEscalationRepeatCapPolicyReq{
+		MaxRepeats:           d.Get("max_repeats").(int),
+		MaxRuleRepeats:       d.Get("max_rule_repeats").(int),
+		Timezone:             d.Get("timezone").(string),
+		QuietHoursExceptions: exceptions,
+	}, nil
+}
+
+func resourceEscalationRepeatCapPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeEscalationRepeatCapPolicy(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating escalation repeat cap policy", tf.M{
+		"team_id": d.Get("team_id").(string),
+	})
+	_, err = client.UpdateEscalationRepeatCapPolicy(ctx, d.Get("team_id").(string), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(d.Get("team_id").(string))
+
+	return readAfterCreate(ctx, d, meta, resourceEscalationRepeatCapPolicyRead)
+}
+
+func resourceEscalationRepeatCapPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading escalation repeat cap policy", tf.M{
+		"team_id": d.Id(),
+	})
+	policy, err := client.GetEscalationRepeatCapPolicy(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(policy, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEscalationRepeatCapPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceEscalationRepeatCapPolicyCreate(ctx, d, meta)
+}
+
+func resourceEscalationRepeatCapPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteEscalationRepeatCapPolicy(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
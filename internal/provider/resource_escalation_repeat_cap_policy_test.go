@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceEscalationRepeatCapPolicy(t *testing.T) {
+	resourceName := "squadcast_escalation_repeat_cap_policy.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEscalationRepeatCapPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEscalationRepeatCapPolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "max_repeats", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_rule_repeats", "3"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceEscalationRepeatCapPolicyConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "max_repeats", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_rule_repeats", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Asia/Kolkata"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.0.start_hour", "22"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.0.start_minute", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.0.duration", "480"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.0.day_of_week", ""),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.0.max_repeats", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.1.day_of_week", "sunday"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_hours_exceptions.1.duration", "1440"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckEscalationRepeatCapPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_escalation_repeat_cap_policy" {
+			continue
+		}
+
+		_, err := client.GetEscalationRepeatCapPolicy(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected escalation repeat cap policy to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceEscalationRepeatCapPolicyConfig() string {
+	return `
+resource "squadcast_escalation_repeat_cap_policy" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	max_repeats = 2
+}
+	`
+}
+
+func testAccResourceEscalationRepeatCapPolicyConfig_update() string {
+	return `
+resource "squadcast_escalation_repeat_cap_policy" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	max_repeats = 1
+	max_rule_repeats = 1
+	timezone = "Asia/Kolkata"
+
+	quiet_hours_exceptions {
+		start_hour = 22
+		start_minute = 0
+		duration = 480
+		max_repeats = 0
+	}
+
+	quiet_hours_exceptions {
+		start_hour = 0
+		start_minute = 0
+		duration = 1440
+		day_of_week = "sunday"
+		max_repeats = 0
+	}
+}
+	`
+}
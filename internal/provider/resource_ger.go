@@ -23,6 +23,7 @@ func resourceGER() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGERImport,
 		},
+		CustomizeDiff: validateEntityRefs("entity_owner"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -58,20 +59,7 @@ func resourceGER() *schema.Resource {
 				Required:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Description:  "GER owner id.",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: tf.ValidateObjectID,
-						},
-						"type": {
-							Description:  "GER owner type. (user or squad or team)",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
-						},
-					},
+					Schema: entityRefSchema("GER owner", []string{"user", "squad", "team"}, tf.ValidateObjectID),
 				},
 			},
 		},
@@ -94,6 +82,9 @@ func resourceGERCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	entityOwner := d.Get("entity_owner").([]interface{})
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"entity_owner": entityOwner}, "entity_owner"); err != nil {
+		return diagFromErr(err)
+	}
 	if len(entityOwner) > 0 {
 		entityOwnerMap, ok := entityOwner[0].(map[string]interface{})
 		if !ok {
@@ -110,13 +101,13 @@ func resourceGERCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	})
 	ger, err := client.CreateGER(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gerID := strconv.FormatUint(uint64(ger.ID), 10)
 	d.SetId(gerID)
 
-	return resourceGERRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceGERRead)
 }
 
 func resourceGERRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -133,11 +124,16 @@ func resourceGERRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(ger, d); err != nil {
-		return diag.FromErr(err)
+	m, err := ger.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "entity_owner")
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -156,6 +152,9 @@ func resourceGERUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	entityOwner := d.Get("entity_owner").([]interface{})
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"entity_owner": entityOwner}, "entity_owner"); err != nil {
+		return diagFromErr(err)
+	}
 	if len(entityOwner) > 0 {
 		entityOwnerMap, ok := entityOwner[0].(map[string]interface{})
 		if !ok {
@@ -172,7 +171,7 @@ func resourceGERUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 	})
 	_, err := client.UpdateGER(ctx, d.Id(), req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceGERRead(ctx, d, meta)
@@ -187,7 +186,7 @@ func resourceGERDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -89,7 +89,7 @@ func resourceGERRulesetCreate(ctx context.Context, d *schema.ResourceData, meta
 	alertSourceName := d.Get("alert_source").(string)
 	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, alertSourceName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	req.AlertSourceShortName = alertSource.ShortName
 	req.AlertSourceVersion = alertSource.Version
@@ -109,7 +109,7 @@ func resourceGERRulesetCreate(ctx context.Context, d *schema.ResourceData, meta
 	})
 	gerRuleset, err := client.CreateGERRuleset(ctx, d.Get("ger_id").(string), req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gerRulesetID := strconv.FormatUint(uint64(gerRuleset.ID), 10)
@@ -117,7 +117,7 @@ func resourceGERRulesetCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("alert_source_shortname", req.AlertSourceShortName)
 	d.Set("alert_source_version", req.AlertSourceVersion)
 
-	return resourceGERRulesetRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceGERRulesetRead)
 }
 
 func resourceGERRulesetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -138,11 +138,11 @@ func resourceGERRulesetRead(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(gerRuleset, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -180,7 +180,7 @@ func resourceGERRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta
 		Version: d.Get("alert_source_version").(string),
 	}, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceGERRulesetRead(ctx, d, meta)
@@ -200,7 +200,7 @@ func resourceGERRulesetDelete(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
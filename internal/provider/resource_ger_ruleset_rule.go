@@ -59,6 +59,7 @@ func resourceGERRulesetRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"enabled": enabledSchema("Whether the rule routes the matching events, a disabled rule is kept in the ruleset but skipped."),
 			"action": {
 				Description: "Rule Action refers to the designated destination service to which an event should be directed towards, whenever a rule expression is true.",
 				Type:        schema.TypeMap,
@@ -96,12 +97,13 @@ func resourceGERRulesetRuleCreate(ctx context.Context, d *schema.ResourceData, m
 	req := &api.GER_Ruleset_Rules{
 		Description: d.Get("description").(string),
 		Expression:  d.Get("expression").(string),
+		Disabled:    !d.Get("enabled").(bool),
 	}
 
 	alertSourceName := d.Get("alert_source").(string)
 	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, alertSourceName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	mAction := d.Get("action").(map[string]interface{})
@@ -120,7 +122,7 @@ func resourceGERRulesetRuleCreate(ctx context.Context, d *schema.ResourceData, m
 		Version: alertSource.Version,
 	}, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gerRulesetRulesID := strconv.FormatUint(uint64(gerRulesetRules.ID), 10)
@@ -128,7 +130,7 @@ func resourceGERRulesetRuleCreate(ctx context.Context, d *schema.ResourceData, m
 	d.Set("alert_source_shortname", alertSource.ShortName)
 	d.Set("alert_source_version", alertSource.Version)
 
-	return resourceGERRulesetRuleRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceGERRulesetRuleRead)
 }
 
 func resourceGERRulesetRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -149,11 +151,11 @@ func resourceGERRulesetRuleRead(ctx context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(gerRulesetRules, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -165,6 +167,7 @@ func resourceGERRulesetRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 	req := &api.GER_Ruleset_Rules{
 		Description: d.Get("description").(string),
 		Expression:  d.Get("expression").(string),
+		Disabled:    !d.Get("enabled").(bool),
 	}
 
 	if d.HasChange("alert_source") {
@@ -194,7 +197,7 @@ func resourceGERRulesetRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 		Version: d.Get("alert_source_version").(string),
 	}, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceGERRulesetRuleRead(ctx, d, meta)
@@ -214,7 +217,7 @@ func resourceGERRulesetRuleDelete(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
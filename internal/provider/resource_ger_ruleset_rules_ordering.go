@@ -98,7 +98,7 @@ func resourceGERRulesetRulesOrderingRead(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	gerRulesetRulesOrdering := &api.GERReorderRulesetRules{
 		ID:       gerRulesetRules.ID,
@@ -106,7 +106,7 @@ func resourceGERRulesetRulesOrderingRead(ctx context.Context, d *schema.Resource
 		Ordering: gerRulesetRules.Ordering,
 	}
 	if err = tf.EncodeAndSet(gerRulesetRulesOrdering, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -120,7 +120,7 @@ func resourceGERRulesetRulesOrderingUpdate(ctx context.Context, d *schema.Resour
 	alertSourceName := d.Get("alert_source").(string)
 	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, alertSourceName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ordering := d.Get("ordering").([]interface{})
@@ -144,7 +144,7 @@ func resourceGERRulesetRulesOrderingUpdate(ctx context.Context, d *schema.Resour
 		Version: alertSource.Version,
 	}, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id := strconv.FormatUint(uint64(gerRulesetRulesOrdering.ID), 10)
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceHeartbeat() *schema.Resource {
+	return &schema.Resource{
+		Description: "Heartbeats are dead man's switches of a service: the cron jobs and batch pipelines monitored ping the heartbeat `url` every run, and an incident is triggered on the service when no ping is received within the `interval` and the `grace_period`.",
+
+		CreateContext: resourceHeartbeatCreate,
+		ReadContext:   resourceHeartbeatRead,
+		UpdateContext: resourceHeartbeatUpdate,
+		DeleteContext: resourceHeartbeatDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHeartbeatImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Heartbeat id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "id of the service the incidents are triggered on.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the heartbeat, unique within the service.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Description: "Description of the heartbeat, e.g. the job it monitors.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"interval": {
+				Description:  "Expected time between two pings. (in minutes)",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 10080),
+			},
+			"grace_period": {
+				Description:  "Additional time allowed for a ping to arrive after the interval before triggering an incident, e.g. for jobs of varying duration. (in minutes) Defaults to `0`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"enabled": enabledSchema("Whether missing pings trigger incidents, e.g. disable it during the maintenance of the job."),
+			"url": {
+				Description: "URL to ping, with a GET or POST request, at the end of each run of the job. Anyone knowing it can keep the heartbeat alive.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": {
+				Description: "Status of the heartbeat (pending until the first ping, up or down).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceHeartbeatImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	serviceID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeat, err := client.GetHeartbeatByName(ctx, serviceID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(heartbeat.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeHeartbeat(d *schema.ResourceData) *api.CreateUpdateHeartbeatReq {
+	return &api.CreateUpdateHeartbeatReq{
+		ServiceID:   d.Get("service_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Interval:    d.Get("interval").(int),
+		GracePeriod: d.Get("grace_period").(int),
+		Enabled:     d.Get("enabled").(bool),
+	}
+}
+
+func resourceHeartbeatCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req := decodeHeartbeat(d)
+
+	tflog.Info(ctx, "Creating heartbeat", tf.M{
+		"name": req.Name,
+	})
+	heartbeat, err := client.CreateHeartbeat(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "heartbeat", req.Name, func() (string, error) {
+			existing, err := client.GetHeartbeatByName(ctx, req.ServiceID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceHeartbeatUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(heartbeat.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceHeartbeatRead)
+}
+
+func resourceHeartbeatRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading heartbeat", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	heartbeat, err := client.GetHeartbeatById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(heartbeat, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceHeartbeatUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateHeartbeat(ctx, d.Id(), decodeHeartbeat(d))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceHeartbeatRead(ctx, d, meta)
+}
+
+func resourceHeartbeatDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteHeartbeat(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
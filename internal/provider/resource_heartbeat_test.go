@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestResourceHeartbeatRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/heartbeats/64a7f5c2e1b2c3d4e5f60718" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"id":"64a7f5c2e1b2c3d4e5f60718","service_id":"6389ba2ec31b7df1caecd579","name":"nightly-backup","interval":1440,"grace_period":60,"enabled":false,"url":"https://heartbeat.squadcast.com/v1/ping/secret","status":"down"}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceHeartbeat()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId("64a7f5c2e1b2c3d4e5f60718")
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("url") != "https://heartbeat.squadcast.com/v1/ping/secret" || d.Get("status") != "down" {
+		t.Errorf("unexpected url %v and status %v", d.Get("url"), d.Get("status"))
+	}
+	if d.Get("interval") != 1440 || d.Get("grace_period") != 60 || d.Get("enabled") != false {
+		t.Errorf("unexpected heartbeat %v", d.State().Attributes)
+	}
+}
+
+func TestAccResourceHeartbeat(t *testing.T) {
+	heartbeatName := testAccName("heartbeat")
+
+	resourceName := "squadcast_heartbeat.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHeartbeatDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceHeartbeatConfig(heartbeatName, 60, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "6389ba2ec31b7df1caecd579"),
+					resource.TestCheckResourceAttr(resourceName, "name", heartbeatName),
+					resource.TestCheckResourceAttr(resourceName, "interval", "60"),
+					resource.TestCheckResourceAttr(resourceName, "grace_period", "5"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending"),
+				),
+			},
+			{
+				Config: testAccResourceHeartbeatConfig(heartbeatName, 1440, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interval", "1440"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "6389ba2ec31b7df1caecd579:" + heartbeatName,
+			},
+		},
+	})
+}
+
+func testAccCheckHeartbeatDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_heartbeat" {
+			continue
+		}
+
+		_, err := client.GetHeartbeatById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected heartbeat to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceHeartbeatConfig(heartbeatName string, interval int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "squadcast_heartbeat" "test" {
+	service_id = "6389ba2ec31b7df1caecd579"
+	name = "%s"
+	description = "Nightly backup"
+	interval = %d
+	grace_period = 5
+	enabled = %t
+}
+	`, heartbeatName, interval, enabled)
+}
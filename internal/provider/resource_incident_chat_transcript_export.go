@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// incidentChatTranscriptExportResource is served by the framework provider.
+type incidentChatTranscriptExportResource struct {
+	client *api.Client
+}
+
+var (
+	_ resource.ResourceWithConfigure      = &incidentChatTranscriptExportResource{}
+	_ resource.ResourceWithImportState    = &incidentChatTranscriptExportResource{}
+	_ resource.ResourceWithValidateConfig = &incidentChatTranscriptExportResource{}
+)
+
+func newIncidentChatTranscriptExportResource() resource.Resource {
+	return &incidentChatTranscriptExportResource{}
+}
+
+type incidentChatTranscriptExportModel struct {
+	ID             types.String `tfsdk:"id"`
+	TeamID         types.String `tfsdk:"team_id"`
+	TeamName       types.String `tfsdk:"team_name"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Sources        types.Set    `tfsdk:"sources"`
+	Destination    types.String `tfsdk:"destination"`
+	DestinationURL types.String `tfsdk:"destination_url"`
+	RetentionDays  types.Int64  `tfsdk:"retention_days"`
+	Timeouts       types.Object `tfsdk:"timeouts"`
+}
+
+func (r *incidentChatTranscriptExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_chat_transcript_export"
+}
+
+func (r *incidentChatTranscriptExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Incident chat transcript export copies the Slack and Microsoft Teams conversations of the incidents of a team to the incident timeline or to an external store, " +
+			"and deletes the exported transcripts after the retention period. There can be only one export configuration per team.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "id.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team id.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(24, 24),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team, resolved from `team_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the transcripts are exported.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaultBool(true),
+				},
+			},
+			"sources": schema.SetAttribute{
+				MarkdownDescription: "Chat tools whose incident channels are exported. Supported values are slack and msteams.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("slack", "msteams")),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Where the transcripts are exported to. Supported values are incident_timeline, s3 and webhook.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("incident_timeline", "s3", "webhook"),
+				},
+				PlanModifiers: []planmodifier.String{
+					defaultString("incident_timeline"),
+				},
+			},
+			"destination_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the external store, e.g. s3://bucket/prefix for s3 or https://example.com/transcripts for webhook. Required unless destination is incident_timeline.",
+				Optional:            true,
+			},
+			"retention_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the exported transcripts are kept for. 0 keeps them indefinitely.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3650),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					defaultInt64(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": frameworkTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *incidentChatTranscriptExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider data is not set yet when the configuration is validated.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *api.Client, got %T.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *incidentChatTranscriptExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config incidentChatTranscriptExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Destination.IsUnknown() || config.DestinationURL.IsUnknown() {
+		return
+	}
+
+	destination := config.Destination.ValueString()
+	if config.Destination.IsNull() {
+		destination = "incident_timeline"
+	}
+
+	switch {
+	case destination == "incident_timeline" && !config.DestinationURL.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("destination_url"), "Invalid destination_url", "destination_url cannot be set when destination is incident_timeline")
+	case destination != "incident_timeline" && config.DestinationURL.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("destination_url"), "Missing destination_url", fmt.Sprintf("destination_url must be set when destination is %s", destination))
+	}
+}
+
+func (r *incidentChatTranscriptExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+func (r *incidentChatTranscriptExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan incidentChatTranscriptExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	tflog.Info(ctx, "Creating incident chat transcript export", tf.M{
+		"team_id": plan.TeamID.ValueString(),
+	})
+	export, err := r.update(ctx, plan)
+	if err != nil {
+		addFrameworkError(&resp.Diagnostics, "Unable to create the incident chat transcript export", err)
+		return
+	}
+
+	state, diags := flattenIncidentChatTranscriptExport(ctx, plan.TeamID.ValueString(), r.teamName(ctx, plan.TeamID.ValueString(), plan.TeamName), export, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.updateInventory(ctx, &resp.Diagnostics, state.ID.ValueString(), false)
+}
+
+func (r *incidentChatTranscriptExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state incidentChatTranscriptExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	tflog.Info(ctx, "Reading incident chat transcript export", tf.M{
+		"team_id": state.ID.ValueString(),
+	})
+	export, err := r.client.GetIncidentChatTranscriptExport(ctx, state.ID.ValueString())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			if r.client.InventoryRecordReads {
+				r.updateInventory(ctx, &resp.Diagnostics, state.ID.ValueString(), true)
+			}
+			return
+		}
+		addFrameworkError(&resp.Diagnostics, "Unable to read the incident chat transcript export", err)
+		return
+	}
+
+	state, diags := flattenIncidentChatTranscriptExport(ctx, state.ID.ValueString(), r.teamName(ctx, state.ID.ValueString(), state.TeamName), export, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if r.client.InventoryRecordReads {
+		r.updateInventory(ctx, &resp.Diagnostics, state.ID.ValueString(), false)
+	}
+}
+
+func (r *incidentChatTranscriptExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan incidentChatTranscriptExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	export, err := r.update(ctx, plan)
+	if err != nil {
+		addFrameworkError(&resp.Diagnostics, "Unable to update the incident chat transcript export", err)
+		return
+	}
+
+	state, diags := flattenIncidentChatTranscriptExport(ctx, plan.TeamID.ValueString(), r.teamName(ctx, plan.TeamID.ValueString(), plan.TeamName), export, plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.updateInventory(ctx, &resp.Diagnostics, state.ID.ValueString(), false)
+}
+
+func (r *incidentChatTranscriptExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state incidentChatTranscriptExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	_, err := r.client.DeleteIncidentChatTranscriptExport(ctx, state.ID.ValueString())
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		addFrameworkError(&resp.Diagnostics, "Unable to delete the incident chat transcript export", err)
+		return
+	}
+	r.updateInventory(ctx, &resp.Diagnostics, state.ID.ValueString(), true)
+}
+
+// teamName resolves the name of the team of the export, like withCompanionNames does for the SDKv2 resources. The name
+// is informative, a failure to resolve it is logged and the prior name is kept.
+func (r *incidentChatTranscriptExportResource) teamName(ctx context.Context, teamID string, prior types.String) types.String {
+	team := api.EntityRef{Type: "team", ID: teamID}
+	names, err := r.client.ResolveEntityNames(ctx, teamID, []api.EntityRef{team})
+	if err != nil {
+		tflog.Warn(ctx, "Unable to resolve the companion names", tf.M{
+			"error": err.Error(),
+		})
+		return types.StringValue(prior.ValueString())
+	}
+
+	return types.StringValue(names[team])
+}
+
+// updateInventory records the export, keyed by its team, in the inventory file of the provider, or removes it.
+func (r *incidentChatTranscriptExportResource) updateInventory(ctx context.Context, diags *diag.Diagnostics, teamID string, remove bool) {
+	updateFrameworkInventory(ctx, r.client, diags, inventoryEntry{Type: "squadcast_incident_chat_transcript_export", ID: teamID, TeamID: teamID}, remove)
+}
+
+func (r *incidentChatTranscriptExportResource) update(ctx context.Context, plan incidentChatTranscriptExportModel) (*api.IncidentChatTranscriptExport, error) {
+	var sources []string
+	if diags := plan.Sources.ElementsAs(ctx, &sources, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid sources")
+	}
+
+	return r.client.UpdateIncidentChatTranscriptExport(ctx, plan.TeamID.ValueString(), &api.UpdateIncidentChatTranscriptExportReq{
+		Enabled:        plan.Enabled.ValueBool(),
+		Sources:        sources,
+		Destination:    plan.Destination.ValueString(),
+		DestinationURL: plan.DestinationURL.ValueString(),
+		RetentionDays:  int(plan.RetentionDays.ValueInt64()),
+	})
+}
+
+// flattenIncidentChatTranscriptExport returns the state of the export, an empty destination_url is null since the
+// attribute is not computed.
+func flattenIncidentChatTranscriptExport(ctx context.Context, teamID string, teamName types.String, export *api.IncidentChatTranscriptExport, timeouts types.Object) (incidentChatTranscriptExportModel, diag.Diagnostics) {
+	sources, diags := types.SetValueFrom(ctx, types.StringType, export.Sources)
+
+	return incidentChatTranscriptExportModel{
+		ID:             types.StringValue(teamID),
+		TeamID:         types.StringValue(teamID),
+		TeamName:       teamName,
+		Enabled:        types.BoolValue(export.Enabled),
+		Sources:        sources,
+		Destination:    types.StringValue(export.Destination),
+		DestinationURL: stringOrNull(export.DestinationURL),
+		RetentionDays:  types.Int64Value(int64(export.RetentionDays)),
+		Timeouts:       timeouts,
+	}, diags
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestIncidentChatTranscriptExportTeamName(t *testing.T) {
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/teams" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if fail {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"613611c1eb22db455cfa789f","name":"SRE"}]}`))
+	}))
+	defer server.Close()
+
+	r := &incidentChatTranscriptExportResource{client: &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}}
+	if name := r.teamName(context.Background(), "613611c1eb22db455cfa789f", types.StringUnknown()); name.ValueString() != "SRE" {
+		t.Errorf("expected the team name, got %v", name)
+	}
+
+	fail = true
+	r.client = &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	if name := r.teamName(context.Background(), "613611c1eb22db455cfa789f", types.StringValue("Platform")); name.ValueString() != "Platform" {
+		t.Errorf("expected the prior team name to be kept, got %v", name)
+	}
+	if name := r.teamName(context.Background(), "613611c1eb22db455cfa789f", types.StringUnknown()); name.IsUnknown() || name.ValueString() != "" {
+		t.Errorf("expected an empty team name, got %v", name)
+	}
+}
+
+func TestAccResourceIncidentChatTranscriptExport(t *testing.T) {
+	resourceName := "squadcast_incident_chat_transcript_export.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIncidentChatTranscriptExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIncidentChatTranscriptExportConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrSet(resourceName, "team_name"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sources.*", "slack"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "incident_timeline"),
+					resource.TestCheckResourceAttr(resourceName, "destination_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+				),
+			},
+			{
+				Config:      testAccResourceIncidentChatTranscriptExportConfig_missingURL(),
+				ExpectError: regexp.MustCompile("destination_url must be set when destination is s3"),
+			},
+			{
+				Config: testAccResourceIncidentChatTranscriptExportConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sources.*", "msteams"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "destination_url", "s3://incident-transcripts/squadcast"),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "365"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckIncidentChatTranscriptExportDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_incident_chat_transcript_export" {
+			continue
+		}
+
+		_, err := client.GetIncidentChatTranscriptExport(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected incident chat transcript export to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceIncidentChatTranscriptExportConfig() string {
+	return `
+resource "squadcast_incident_chat_transcript_export" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	sources = ["slack"]
+}
+	`
+}
+
+func testAccResourceIncidentChatTranscriptExportConfig_missingURL() string {
+	return `
+resource "squadcast_incident_chat_transcript_export" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	sources = ["slack"]
+	destination = "s3"
+}
+	`
+}
+
+func testAccResourceIncidentChatTranscriptExportConfig_update() string {
+	return `
+resource "squadcast_incident_chat_transcript_export" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	sources = ["slack", "msteams"]
+	destination = "s3"
+	destination_url = "s3://incident-transcripts/squadcast"
+	retention_days = 365
+}
+	`
+}
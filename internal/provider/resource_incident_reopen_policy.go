@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const incidentReopenPolicyID = "incident_reopen_policy"
+
+func resourceIncidentReopenPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "The incident reopen policy of a service decides whether a resolved incident is reopened when its alert fires again shortly after, instead of a new incident being created, e.g. to standardize the handling of flapping alerts. " +
+			"Destroying this resource resets the policy of the service to the default of the organization.",
+
+		CreateContext: resourceIncidentReopenPolicyCreate,
+		ReadContext:   resourceIncidentReopenPolicyRead,
+		UpdateContext: resourceIncidentReopenPolicyUpdate,
+		DeleteContext: resourceIncidentReopenPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIncidentReopenPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"enabled": enabledSchema("Whether resolved incidents are reopened by repeated alerts."),
+			"window_minutes": {
+				Description:  "Number of minutes after its resolution during which an incident is reopened by a repeated alert, between 1 and 1440. Later alerts create a new incident. Defaults to `30`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 24*60),
+			},
+		},
+	}
+}
+
+func resourceIncidentReopenPolicyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	_, serviceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("service_id", serviceID)
+	d.SetId(incidentReopenPolicyID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIncidentReopenPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Updating incident reopen policy", tf.M{
+		"service_id": d.Get("service_id").(string),
+	})
+	_, err := client.UpdateIncidentReopenPolicy(ctx, d.Get("service_id").(string), &api.UpdateIncidentReopenPolicyReq{
+		Enabled:       d.Get("enabled").(bool),
+		WindowMinutes: d.Get("window_minutes").(int),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(incidentReopenPolicyID)
+
+	return readAfterCreate(ctx, d, meta, resourceIncidentReopenPolicyRead)
+}
+
+func resourceIncidentReopenPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading incident reopen policy", tf.M{
+		"service_id": d.Get("service_id").(string),
+	})
+	policy, err := client.GetIncidentReopenPolicy(ctx, d.Get("service_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(policy, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIncidentReopenPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceIncidentReopenPolicyCreate(ctx, d, meta)
+}
+
+func resourceIncidentReopenPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteIncidentReopenPolicy(ctx, d.Get("service_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
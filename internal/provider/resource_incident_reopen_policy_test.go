@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIncidentReopenPolicy(t *testing.T) {
+	resourceName := "squadcast_incident_reopen_policy.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIncidentReopenPolicyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "incident_reopen_policy"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "window_minutes", "30"),
+				),
+			},
+			{
+				Config: testAccResourceIncidentReopenPolicyConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "incident_reopen_policy"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "window_minutes", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd",
+			},
+		},
+	})
+}
+
+func testAccResourceIncidentReopenPolicyConfig() string {
+	return `
+resource "squadcast_incident_reopen_policy" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+}
+	`
+}
+
+func testAccResourceIncidentReopenPolicyConfig_update() string {
+	return `
+resource "squadcast_incident_reopen_policy" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+	enabled = false
+	window_minutes = 120
+}
+	`
+}
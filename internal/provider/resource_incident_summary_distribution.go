@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceIncidentSummaryDistribution() *schema.Resource {
+	return &schema.Resource{
+		Description: "Incident summary distributions automatically email a summary of an incident to an audience (stakeholder groups, users, squads or email addresses) once it is resolved, so stakeholders are informed without manual steps.",
+
+		CreateContext: resourceIncidentSummaryDistributionCreate,
+		ReadContext:   resourceIncidentSummaryDistributionRead,
+		UpdateContext: resourceIncidentSummaryDistributionUpdate,
+		DeleteContext: resourceIncidentSummaryDistributionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIncidentSummaryDistributionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Incident summary distribution id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the incident summary distribution.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_ids": {
+				Description: "Services whose resolved incidents are summarized. Defaults to all the services of the team.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"audience": {
+				Description: "Recipients of the incident summary.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Audience type. (stakeholder_group or user or squad or email)",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"stakeholder_group", "user", "squad", "email"}, false),
+						},
+						"id": {
+							Description:  "Stakeholder group, user or squad id, or the email address when type is email.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"subject": {
+				Description:  "Subject of the summary email.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"template": {
+				Description: "Body of the summary email, supports markdown formatting and incident placeholders.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"delay_minutes": {
+				Description:  "Minutes to wait after an incident is resolved before sending the summary, up to a day.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"enabled": {
+				Description: "Whether the summaries are sent.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceIncidentSummaryDistributionImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	distribution, err := client.GetIncidentSummaryDistributionByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(distribution.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeIncidentSummaryDistribution(d *schema.ResourceData) (*api.CreateUpdateIncidentSummaryDistributionReq, error) {
+	var audience []*api.IncidentSummaryAudience
+	if err := Decode(d.Get("audience"), &audience); err != nil {
+		return nil, err
+	}
+
+	for i, a := range audience {
+		switch a.Type {
+		case "email":
+			if _, err := mail.ParseAddress(a.ID); err != nil {
+				return nil, fmt.Errorf("audience.%d.id must be a valid email address when type is email, got: %s", i, a.ID)
+			}
+		default:
+			if _, errs := tf.ValidateObjectID(a.ID, fmt.Sprintf("audience.%d.id", i)); len(errs) > 0 {
+				return nil, errs[0]
+			}
+		}
+	}
+
+	return &api.CreateUpdateIncidentSummaryDistributionReq{
+		Name:         d.Get("name").(string),
+		TeamID:       d.Get("team_id").(string),
+		ServiceIDs:   tf.ExpandStringSet(d.Get("service_ids").(*schema.Set)),
+		Audience:     audience,
+		Subject:      d.Get("subject").(string),
+		Template:     d.Get("template").(string),
+		DelayMinutes: d.Get("delay_minutes").(int),
+		Enabled:      d.Get("enabled").(bool),
+	}, nil
+}
+
+func resourceIncidentSummaryDistributionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeIncidentSummaryDistribution(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating incident summary distribution", tf.M{
+		"name": req.Name,
+	})
+	distribution, err := client.CreateIncidentSummaryDistribution(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "incident summary distribution", req.Name, func() (string, error) {
+			existing, err := client.GetIncidentSummaryDistributionByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceIncidentSummaryDistributionUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(distribution.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceIncidentSummaryDistributionRead)
+}
+
+func resourceIncidentSummaryDistributionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading incident summary distribution", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	distribution, err := client.GetIncidentSummaryDistributionById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(distribution, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIncidentSummaryDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeIncidentSummaryDistribution(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateIncidentSummaryDistribution(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIncidentSummaryDistributionRead(ctx, d, meta)
+}
+
+func resourceIncidentSummaryDistributionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteIncidentSummaryDistribution(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
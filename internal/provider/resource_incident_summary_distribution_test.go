@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceIncidentSummaryDistribution(t *testing.T) {
+	distributionName := testAccName("incident-summary")
+
+	resourceName := "squadcast_incident_summary_distribution.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIncidentSummaryDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceIncidentSummaryDistributionConfig(distributionName, "not-an-email", 0),
+				ExpectError: regexp.MustCompile("audience.1.id must be a valid email address when type is email"),
+			},
+			{
+				Config: testAccResourceIncidentSummaryDistributionConfig(distributionName, "stakeholders@example.com", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", distributionName),
+					resource.TestCheckResourceAttr(resourceName, "service_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audience.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "audience.0.type", "stakeholder_group"),
+					resource.TestCheckResourceAttr(resourceName, "audience.0.id", "63bfabae865e9c93cd31756e"),
+					resource.TestCheckResourceAttr(resourceName, "audience.1.type", "email"),
+					resource.TestCheckResourceAttr(resourceName, "audience.1.id", "stakeholders@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Incident resolved"),
+					resource.TestCheckResourceAttr(resourceName, "delay_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceIncidentSummaryDistributionConfig(distributionName, "stakeholders@example.com", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "delay_minutes", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + distributionName,
+			},
+		},
+	})
+}
+
+func testAccCheckIncidentSummaryDistributionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_incident_summary_distribution" {
+			continue
+		}
+
+		_, err := client.GetIncidentSummaryDistributionById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected incident summary distribution to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceIncidentSummaryDistributionConfig(distributionName, email string, delayMinutes int) string {
+	return fmt.Sprintf(`
+resource "squadcast_incident_summary_distribution" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	service_ids = ["61361611c2fc70c3101ca7dd"]
+
+	audience {
+		type = "stakeholder_group"
+		id = "63bfabae865e9c93cd31756e"
+	}
+
+	audience {
+		type = "email"
+		id = "%s"
+	}
+
+	subject = "Incident resolved"
+	template = "The incident has been resolved."
+	delay_minutes = %d
+}
+	`, distributionName, email, delayMinutes)
+}
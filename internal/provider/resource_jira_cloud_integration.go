@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceJiraCloudIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to configure the [Jira Cloud integration](https://support.squadcast.com/integrations/outgoing-integrations/jira-cloud) of a team, i.e. the projects the issues of the incidents of its services are created in and what is kept in sync between them. " +
+			"The Jira Cloud account must have been connected to the team from the Squadcast web app first, the API rejects the configuration of a team without one. " +
+			"Destroying this resource resets the configuration, the account stays connected.",
+
+		CreateContext: resourceJiraCloudIntegrationCreate,
+		ReadContext:   resourceJiraCloudIntegrationRead,
+		UpdateContext: resourceJiraCloudIntegrationUpdate,
+		DeleteContext: resourceJiraCloudIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceJiraCloudIntegrationImport,
+		},
+		CustomizeDiff: validateReferences(jiraCloudIntegrationReferences),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"account_url": {
+				Description: "Url of the connected Jira Cloud site, e.g. `https://example.atlassian.net`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"project_mapping": {
+				Description: "Jira projects the issues are created in. The mapping without a service is the default of the team, used for the services that are not mapped.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Description:  "Service id, unset for the default project of the team.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"project_key": {
+							Description:  "Key of the Jira project, e.g. `OPS`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`), "must be a Jira project key, e.g. OPS"),
+						},
+						"issue_type": {
+							Description:  "Type of the issues created in the project, e.g. `Bug` or `Incident`. Defaults to `Task`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Task",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"auto_create_issue": {
+				Description: "Whether an issue is created for every new incident, otherwise issues are only created from the incidents on demand.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"sync_status": {
+				Description: "Whether the status of the issues and of their incidents is kept in sync, e.g. an incident is resolved when its issue is done. Enabled by default.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"sync_comments": {
+				Description: "Whether the notes of the incidents are added as comments to their issues.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func jiraCloudIntegrationReferences(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error) {
+	teamID := d.Get("team_id").(string)
+	if !d.NewValueKnown("team_id") {
+		return nil, nil
+	}
+
+	refs := []reference{{Attribute: "team_id", Type: "team", ID: teamID}}
+	refs = append(refs, serviceMappingReferences("project_mapping", d.Get("project_mapping").([]any), teamID)...)
+
+	return refs, nil
+}
+
+func resourceJiraCloudIntegrationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodeJiraCloudProjectMappings decodes the project mappings, rejecting services mapped more than once and more
+// than one default project.
+func decodeJiraCloudProjectMappings(d *schema.ResourceData) ([]api.JiraCloudProjectMapping, error) {
+	var mappings []api.JiraCloudProjectMapping
+	if err := tf.DecodeAt("project_mapping", d.Get("project_mapping").([]any), &mappings); err != nil {
+		return nil, err
+	}
+
+	services := map[string]bool{}
+	for i, mapping := range mappings {
+		if services[mapping.ServiceID] {
+			if mapping.ServiceID == "" {
+				return nil, fmt.Errorf("project_mapping.%d: the default project of the team is set more than once", i)
+			}
+			return nil, fmt.Errorf("project_mapping.%d: service %s is mapped more than once", i, mapping.ServiceID)
+		}
+		services[mapping.ServiceID] = true
+	}
+
+	return mappings, nil
+}
+
+func resourceJiraCloudIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	mappings, err := decodeJiraCloudProjectMappings(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Updating jira cloud integration", tf.M{
+		"team_id": teamID,
+	})
+	_, err = client.UpdateJiraCloudIntegration(ctx, teamID, &api.UpdateJiraCloudIntegrationReq{
+		ProjectMappings: mappings,
+		AutoCreateIssue: d.Get("auto_create_issue").(bool),
+		SyncStatus:      d.Get("sync_status").(bool),
+		SyncComments:    d.Get("sync_comments").(bool),
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return diag.Errorf("no Jira Cloud account is connected to the team %s, connect one from the Squadcast web app first", teamID)
+		}
+		return diagFromErr(err)
+	}
+
+	d.SetId(teamID)
+
+	return readAfterCreate(ctx, d, meta, resourceJiraCloudIntegrationRead)
+}
+
+func resourceJiraCloudIntegrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Reading jira cloud integration", tf.M{
+		"team_id": teamID,
+	})
+	integration, err := client.GetJiraCloudIntegration(ctx, teamID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(integration, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceJiraCloudIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceJiraCloudIntegrationCreate(ctx, d, meta)
+}
+
+func resourceJiraCloudIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteJiraCloudIntegration(ctx, d.Get("team_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
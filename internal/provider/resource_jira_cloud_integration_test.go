@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceJiraCloudIntegration(t *testing.T) {
+	resourceName := "squadcast_jira_cloud_integration.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckJiraCloudIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJiraCloudIntegrationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_url"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.0.project_key", "OPS"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.0.issue_type", "Task"),
+					resource.TestCheckResourceAttr(resourceName, "auto_create_issue", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sync_status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sync_comments", "false"),
+				),
+			},
+			{
+				Config: testAccResourceJiraCloudIntegrationConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.1.service_id", "61305a8eb7a2fa0e44cfd0f5"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.1.project_key", "PAY"),
+					resource.TestCheckResourceAttr(resourceName, "project_mapping.1.issue_type", "Bug"),
+					resource.TestCheckResourceAttr(resourceName, "auto_create_issue", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sync_comments", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckJiraCloudIntegrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_jira_cloud_integration" {
+			continue
+		}
+
+		integration, err := client.GetJiraCloudIntegration(context.Background(), rs.Primary.Attributes["team_id"])
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(integration.ProjectMappings) != 0 {
+			return fmt.Errorf("expected jira cloud integration to be reset, %d project mappings found", len(integration.ProjectMappings))
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceJiraCloudIntegrationConfig() string {
+	return `
+resource "squadcast_jira_cloud_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+
+	project_mapping {
+		project_key = "OPS"
+	}
+}
+	`
+}
+
+func testAccResourceJiraCloudIntegrationConfig_update() string {
+	return `
+resource "squadcast_jira_cloud_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+
+	project_mapping {
+		project_key = "OPS"
+	}
+
+	project_mapping {
+		service_id = "61305a8eb7a2fa0e44cfd0f5"
+		project_key = "PAY"
+		issue_type = "Bug"
+	}
+
+	auto_create_issue = true
+	sync_comments = true
+}
+	`
+}
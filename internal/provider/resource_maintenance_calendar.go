@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceMaintenanceCalendar() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance calendars are organization wide change-freeze or maintenance windows. During a window, only the incidents with one of the allowed priorities notify, on every service of the organization but the excluded ones; " +
+			"the other incidents are still created. Unlike `squadcast_service_maintenance`, a calendar doesn't need to be repeated on every service.",
+
+		CreateContext: resourceMaintenanceCalendarCreate,
+		ReadContext:   resourceMaintenanceCalendarRead,
+		UpdateContext: resourceMaintenanceCalendarUpdate,
+		DeleteContext: resourceMaintenanceCalendarDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMaintenanceCalendarImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Maintenance calendar id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the maintenance calendar.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description: "Description of the maintenance calendar.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"window": {
+				Description: "Windows of the calendar.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Description:  "Start of the window, in RFC3339 format.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"till": {
+							Description:  "End of the window, in RFC3339 format.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"repeat_frequency": {
+							Description:  "Frequency the window repeats at. ('day', 'week', '2 weeks', '3 weeks', 'month')",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"day", "week", "2 weeks", "3 weeks", "month"}, false),
+						},
+						"repeat_till": {
+							Description:  "Date the window stops repeating at, in RFC3339 format. Required with `repeat_frequency`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+			"allowed_priorities": {
+				Description: "Priorities of the incidents that still notify during the windows. (P1, P2, P3, P4, P5)",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"P1", "P2", "P3", "P4", "P5"}, false),
+				},
+			},
+			"excluded_service_ids": {
+				Description: "Ids of the services the calendar doesn't apply to.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+		},
+	}
+}
+
+func resourceMaintenanceCalendarImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	calendar, err := client.GetMaintenanceCalendarByName(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(calendar.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodeMaintenanceCalendar decodes the calendar, rejecting windows that end before they start and windows that
+// repeat without an end.
+func decodeMaintenanceCalendar(d *schema.ResourceData) (*api.CreateUpdateMaintenanceCalendarReq, error) {
+	var windows []api.MaintenanceCalendarWindow
+	if err := tf.DecodeAt("window", d.Get("window").([]any), &windows); err != nil {
+		return nil, err
+	}
+
+	for i, w := range windows {
+		from, _ := time.Parse(time.RFC3339, w.From)
+		till, _ := time.Parse(time.RFC3339, w.Till)
+		if !till.After(from) {
+			return nil, fmt.Errorf("window.%d: till must be after from", i)
+		}
+		switch {
+		case w.RepeatFrequency != "" && w.RepeatTill == "":
+			return nil, fmt.Errorf("window.%d: repeat_till must be set with repeat_frequency", i)
+		case w.RepeatFrequency == "" && w.RepeatTill != "":
+			return nil, fmt.Errorf("window.%d: repeat_till can only be set with repeat_frequency", i)
+		case w.RepeatTill != "":
+			repeatTill, _ := time.Parse(time.RFC3339, w.RepeatTill)
+			if repeatTill.Before(till) {
+				return nil, fmt.Errorf("window.%d: repeat_till must not be before till", i)
+			}
+		}
+	}
+
+	return &api.CreateUpdateMaintenanceCalendarReq{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Windows:            windows,
+		AllowedPriorities:  tf.ExpandStringSet(d.Get("allowed_priorities").(*schema.Set)),
+		ExcludedServiceIDs: tf.ExpandStringSet(d.Get("excluded_service_ids").(*schema.Set)),
+	}, nil
+}
+
+func resourceMaintenanceCalendarCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeMaintenanceCalendar(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating maintenance calendar", tf.M{
+		"name": req.Name,
+	})
+	calendar, err := client.CreateMaintenanceCalendar(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "maintenance calendar", req.Name, func() (string, error) {
+			existing, err := client.GetMaintenanceCalendarByName(ctx, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceMaintenanceCalendarUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(calendar.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceMaintenanceCalendarRead)
+}
+
+func resourceMaintenanceCalendarRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading maintenance calendar", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	calendar, err := client.GetMaintenanceCalendarById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(calendar, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceMaintenanceCalendarUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeMaintenanceCalendar(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateMaintenanceCalendar(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceMaintenanceCalendarRead(ctx, d, meta)
+}
+
+func resourceMaintenanceCalendarDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteMaintenanceCalendar(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestDecodeMaintenanceCalendar(t *testing.T) {
+	cases := map[string]struct {
+		window tf.M
+		err    string
+	}{
+		"once":             {window: tf.M{"from": "2032-12-20T00:00:00Z", "till": "2033-01-02T00:00:00Z"}},
+		"repeated":         {window: tf.M{"from": "2032-06-03T18:00:00Z", "till": "2032-06-06T08:00:00Z", "repeat_frequency": "week", "repeat_till": "2032-09-01T00:00:00Z"}},
+		"ends before":      {window: tf.M{"from": "2033-01-02T00:00:00Z", "till": "2032-12-20T00:00:00Z"}, err: "window.0: till must be after from"},
+		"repeats forever":  {window: tf.M{"from": "2032-06-03T18:00:00Z", "till": "2032-06-06T08:00:00Z", "repeat_frequency": "week"}, err: "repeat_till must be set with repeat_frequency"},
+		"repeat_till only": {window: tf.M{"from": "2032-06-03T18:00:00Z", "till": "2032-06-06T08:00:00Z", "repeat_till": "2032-09-01T00:00:00Z"}, err: "repeat_till can only be set with repeat_frequency"},
+		"repeat_till before till": {
+			window: tf.M{"from": "2032-06-03T18:00:00Z", "till": "2032-06-06T08:00:00Z", "repeat_frequency": "day", "repeat_till": "2032-06-05T00:00:00Z"},
+			err:    "repeat_till must not be before till",
+		},
+	}
+
+	for name, c := range cases {
+		d := resourceMaintenanceCalendar().TestResourceData()
+		d.Set("name", "freeze")
+		d.Set("window", []any{c.window})
+		d.Set("allowed_priorities", []any{"P1"})
+
+		req, err := decodeMaintenanceCalendar(d)
+		if c.err != "" {
+			if err == nil || !regexp.MustCompile(c.err).MatchString(err.Error()) {
+				t.Errorf("%s: expected an error matching %q, got %v", name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if len(req.Windows) != 1 || req.Windows[0].From != c.window["from"] || fmt.Sprint(req.AllowedPriorities) != "[P1]" {
+			t.Errorf("%s: unexpected request %+v", name, req)
+		}
+	}
+}
+
+func TestAccResourceMaintenanceCalendar(t *testing.T) {
+	calendarName := testAccName("maintenance_calendar")
+
+	resourceName := "squadcast_maintenance_calendar.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceCalendarDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceCalendarConfig(calendarName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", calendarName),
+					resource.TestCheckResourceAttr(resourceName, "window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "window.0.from", "2032-12-20T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "window.0.till", "2033-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_priorities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_priorities.*", "P1"),
+				),
+			},
+			{
+				Config: testAccResourceMaintenanceCalendarConfig_update(calendarName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "window.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "window.1.repeat_frequency", "week"),
+					resource.TestCheckResourceAttr(resourceName, "window.1.repeat_till", "2032-09-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_priorities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_service_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     calendarName,
+			},
+		},
+	})
+}
+
+func testAccCheckMaintenanceCalendarDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_maintenance_calendar" {
+			continue
+		}
+
+		_, err := client.GetMaintenanceCalendarById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected maintenance calendar to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceMaintenanceCalendarConfig(calendarName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_maintenance_calendar" "test" {
+	name = "%s"
+
+	window {
+		from = "2032-12-20T00:00:00Z"
+		till = "2033-01-02T00:00:00Z"
+	}
+
+	allowed_priorities = ["P1"]
+}
+	`, calendarName)
+}
+
+func testAccResourceMaintenanceCalendarConfig_update(calendarName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_maintenance_calendar" "test" {
+	name = "%s"
+	description = "Year end freeze and weekend maintenance"
+
+	window {
+		from = "2032-12-20T00:00:00Z"
+		till = "2033-01-02T00:00:00Z"
+	}
+
+	window {
+		from = "2032-06-05T00:00:00Z"
+		till = "2032-06-07T00:00:00Z"
+		repeat_frequency = "week"
+		repeat_till = "2032-09-01T00:00:00Z"
+	}
+
+	allowed_priorities = ["P1", "P2"]
+	excluded_service_ids = ["61305a8eb7a2fa0e44cfd0f5"]
+}
+	`, calendarName)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceMSTeamsIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to configure the [Microsoft Teams integration](https://support.squadcast.com/integrations/outgoing-integrations-chatops-tools/microsoft-teams) of a team, i.e. the channels the incidents of its services are posted to. " +
+			"The Squadcast app must have been installed in the Microsoft Teams tenant from the Squadcast web app first, the API rejects the configuration of a team without one. " +
+			"Destroying this resource removes the channel mappings, the tenant stays connected.",
+
+		CreateContext: resourceMSTeamsIntegrationCreate,
+		ReadContext:   resourceMSTeamsIntegrationRead,
+		UpdateContext: resourceMSTeamsIntegrationUpdate,
+		DeleteContext: resourceMSTeamsIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMSTeamsIntegrationImport,
+		},
+		CustomizeDiff: validateReferences(msteamsIntegrationReferences),
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"tenant_id": {
+				Description: "Id of the connected Microsoft Teams tenant.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"channel_mapping": {
+				Description: "Channels the incidents are posted to. The mapping without a service is the default of the team, used for the services that are not mapped.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Description:  "Service id, unset for the default channel of the team.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"msteams_team_id": {
+							Description:  "Id of the Microsoft Teams team of the channel.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"channel_id": {
+							Description:  "Id of the Microsoft Teams channel, e.g. `19:0123456789abcdef@thread.tacv2`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func msteamsIntegrationReferences(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error) {
+	teamID := d.Get("team_id").(string)
+	if !d.NewValueKnown("team_id") {
+		return nil, nil
+	}
+
+	refs := []reference{{Attribute: "team_id", Type: "team", ID: teamID}}
+	refs = append(refs, serviceMappingReferences("channel_mapping", d.Get("channel_mapping").([]any), teamID)...)
+
+	return refs, nil
+}
+
+func resourceMSTeamsIntegrationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodeMSTeamsChannelMappings decodes the channel mappings, rejecting services mapped more than once and more than
+// one default channel.
+func decodeMSTeamsChannelMappings(d *schema.ResourceData) ([]api.MSTeamsChannelMapping, error) {
+	var mappings []api.MSTeamsChannelMapping
+	if err := tf.DecodeAt("channel_mapping", d.Get("channel_mapping").([]any), &mappings); err != nil {
+		return nil, err
+	}
+
+	services := map[string]bool{}
+	for i, mapping := range mappings {
+		if services[mapping.ServiceID] {
+			if mapping.ServiceID == "" {
+				return nil, fmt.Errorf("channel_mapping.%d: the default channel of the team is set more than once", i)
+			}
+			return nil, fmt.Errorf("channel_mapping.%d: service %s is mapped more than once", i, mapping.ServiceID)
+		}
+		services[mapping.ServiceID] = true
+	}
+
+	return mappings, nil
+}
+
+func resourceMSTeamsIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	mappings, err := decodeMSTeamsChannelMappings(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Updating msteams integration", tf.M{
+		"team_id": teamID,
+	})
+	_, err = client.UpdateMSTeamsIntegration(ctx, teamID, &api.UpdateMSTeamsIntegrationReq{
+		ChannelMappings: mappings,
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return diag.Errorf("no Microsoft Teams tenant is connected to the team %s, install the Squadcast app from the Squadcast web app first", teamID)
+		}
+		return diagFromErr(err)
+	}
+
+	d.SetId(teamID)
+
+	return readAfterCreate(ctx, d, meta, resourceMSTeamsIntegrationRead)
+}
+
+func resourceMSTeamsIntegrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Reading msteams integration", tf.M{
+		"team_id": teamID,
+	})
+	integration, err := client.GetMSTeamsIntegration(ctx, teamID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(integration, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceMSTeamsIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceMSTeamsIntegrationCreate(ctx, d, meta)
+}
+
+func resourceMSTeamsIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteMSTeamsIntegration(ctx, d.Get("team_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
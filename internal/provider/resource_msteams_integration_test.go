@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestDecodeMSTeamsChannelMappings(t *testing.T) {
+	mapping := func(serviceID string) tf.M {
+		return tf.M{"service_id": serviceID, "msteams_team_id": "3f2504e0-4f89-11d3-9a0c-0305e82c3301", "channel_id": "19:0123456789abcdef@thread.tacv2"}
+	}
+
+	cases := map[string]struct {
+		mappings []any
+		err      string
+	}{
+		"default and services": {mappings: []any{mapping(""), mapping("61305a8eb7a2fa0e44cfd0f5"), mapping("61305a8eb7a2fa0e44cfd0f6")}},
+		"duplicate service":    {mappings: []any{mapping("61305a8eb7a2fa0e44cfd0f5"), mapping("61305a8eb7a2fa0e44cfd0f5")}, err: "channel_mapping.1: service 61305a8eb7a2fa0e44cfd0f5 is mapped more than once"},
+		"duplicate default":    {mappings: []any{mapping(""), mapping("")}, err: "channel_mapping.1: the default channel of the team is set more than once"},
+	}
+
+	for name, c := range cases {
+		d := resourceMSTeamsIntegration().TestResourceData()
+		d.Set("channel_mapping", c.mappings)
+
+		mappings, err := decodeMSTeamsChannelMappings(d)
+		if c.err != "" {
+			if err == nil || !regexp.MustCompile(regexp.QuoteMeta(c.err)).MatchString(err.Error()) {
+				t.Errorf("%s: expected an error matching %q, got %v", name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if len(mappings) != len(c.mappings) || mappings[1].ServiceID != "61305a8eb7a2fa0e44cfd0f5" {
+			t.Errorf("%s: unexpected mappings %v", name, mappings)
+		}
+	}
+}
+
+func TestAccResourceMSTeamsIntegration(t *testing.T) {
+	resourceName := "squadcast_msteams_integration.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMSTeamsIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMSTeamsIntegrationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.0.service_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.0.channel_id", "19:0123456789abcdef@thread.tacv2"),
+				),
+			},
+			{
+				Config: testAccResourceMSTeamsIntegrationConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.1.service_id", "61305a8eb7a2fa0e44cfd0f5"),
+					resource.TestCheckResourceAttr(resourceName, "channel_mapping.1.channel_id", "19:fedcba9876543210@thread.tacv2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckMSTeamsIntegrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_msteams_integration" {
+			continue
+		}
+
+		integration, err := client.GetMSTeamsIntegration(context.Background(), rs.Primary.Attributes["team_id"])
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(integration.ChannelMappings) != 0 {
+			return fmt.Errorf("expected msteams channel mappings to be removed, %d found", len(integration.ChannelMappings))
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceMSTeamsIntegrationConfig() string {
+	return `
+resource "squadcast_msteams_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+
+	channel_mapping {
+		msteams_team_id = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+		channel_id = "19:0123456789abcdef@thread.tacv2"
+	}
+}
+	`
+}
+
+func testAccResourceMSTeamsIntegrationConfig_update() string {
+	return `
+resource "squadcast_msteams_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+
+	channel_mapping {
+		msteams_team_id = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+		channel_id = "19:0123456789abcdef@thread.tacv2"
+	}
+
+	channel_mapping {
+		service_id = "61305a8eb7a2fa0e44cfd0f5"
+		msteams_team_id = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+		channel_id = "19:fedcba9876543210@thread.tacv2"
+	}
+}
+	`
+}
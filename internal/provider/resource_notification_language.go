@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// organizationNotificationLanguageID is the id of the notification language of the organization, when no team is set.
+const organizationNotificationLanguageID = "organization"
+
+var notificationLanguages = []string{"en", "de", "es", "fr", "it", "nl", "pt", "ja", "zh", "hi"}
+
+func resourceNotificationLanguage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to manage the default language of the notifications (email, SMS, phone and push) of the organization or of a team, and the language of individual users. " +
+			"Users without an override get the language of their team, or of the organization if their team has none.",
+
+		CreateContext: resourceNotificationLanguageCreate,
+		ReadContext:   resourceNotificationLanguageRead,
+		UpdateContext: resourceNotificationLanguageUpdate,
+		DeleteContext: resourceNotificationLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNotificationLanguageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id. If not set, the default language of the organization is managed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"language": {
+				Description:  "Default notification language, as an ISO 639-1 code. (en, de, es, fr, it, nl, pt, ja, zh or hi)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(notificationLanguages, false),
+			},
+			"user_overrides": {
+				Description: "Notification language of individual users, overriding the default language.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description:  "User id.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+						"language": {
+							Description:  "Notification language of the user, as an ISO 639-1 code.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(notificationLanguages, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNotificationLanguageImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() != organizationNotificationLanguageID {
+		d.Set("team_id", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeNotificationLanguage(d *schema.ResourceData) (*api.UpdateNotificationLanguageReq, error) {
+	var overrides []api.UserNotificationLanguage
+	err := Decode(d.Get("user_overrides").(*schema.Set).List(), &overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		if users[override.UserID] {
+			return nil, fmt.Errorf("user_overrides: user %s is set more than once", override.UserID)
+		}
+		users[override.UserID] = true
+	}
+	if overrides == nil {
+		overrides = []api.UserNotificationLanguage{}
+	}
+
+	return &api.UpdateNotificationLanguageReq{
+		Language:      d.Get("language").(string),
+		UserOverrides: overrides,
+	}, nil
+}
+
+func resourceNotificationLanguageCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeNotificationLanguage(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Updating notification language", tf.M{
+		"team_id":  teamID,
+		"language": req.Language,
+	})
+	_, err = client.UpdateNotificationLanguage(ctx, teamID, req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if teamID == "" {
+		d.SetId(organizationNotificationLanguageID)
+	} else {
+		d.SetId(teamID)
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceNotificationLanguageRead)
+}
+
+func resourceNotificationLanguageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Reading notification language", tf.M{
+		"team_id": teamID,
+	})
+	language, err := client.GetNotificationLanguage(ctx, teamID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(language, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNotificationLanguageUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceNotificationLanguageCreate(ctx, d, meta)
+}
+
+func resourceNotificationLanguageDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteNotificationLanguage(ctx, d.Get("team_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceNotificationLanguage(t *testing.T) {
+	resourceName := "squadcast_notification_language.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationLanguageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNotificationLanguageConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "language", "de"),
+					resource.TestCheckResourceAttr(resourceName, "user_overrides.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceNotificationLanguageConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "language", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "user_overrides.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_overrides.*", map[string]string{
+						"user_id":  "5f8891527f735f0a6646f3b6",
+						"language": "en",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationLanguageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_notification_language" {
+			continue
+		}
+
+		_, err := client.GetNotificationLanguage(context.Background(), rs.Primary.Attributes["team_id"])
+		if err == nil {
+			return fmt.Errorf("expected notification language to be reset, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceNotificationLanguageConfig() string {
+	return `
+resource "squadcast_notification_language" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	language = "de"
+}
+	`
+}
+
+func testAccResourceNotificationLanguageConfig_update() string {
+	return `
+resource "squadcast_notification_language" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	language = "fr"
+
+	user_overrides {
+		user_id = "5f8891527f735f0a6646f3b6"
+		language = "en"
+	}
+}
+	`
+}
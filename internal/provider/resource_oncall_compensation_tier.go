@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// timeOfDayRegexp matches a time of the day (HH:MM).
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func resourceOncallCompensationTier() *schema.Resource {
+	return &schema.Resource{
+		Description: "On-call compensation tiers map the on-call shifts of a schedule, or of some of its rotations, to a pay rate (e.g. weekends or nights) in the on-call payroll export of the analytics, so that on-call pay rules are codified.",
+
+		CreateContext: resourceOncallCompensationTierCreate,
+		ReadContext:   resourceOncallCompensationTierRead,
+		UpdateContext: resourceOncallCompensationTierUpdate,
+		DeleteContext: resourceOncallCompensationTierDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOncallCompensationTierImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "On-call compensation tier id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the compensation tier.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"description": {
+				Description: "Description of the compensation tier.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description:  "Shifts covered by the tier. (weekday or weekend or night)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"weekday", "weekend", "night"}, false),
+			},
+			"schedule_id": {
+				Description:      "id of the schedule whose shifts are compensated.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"rotation_ids": {
+				Description: "ids of the rotations of the schedule whose shifts are compensated. Defaults to all the rotations of the schedule.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric rotation id"),
+				},
+			},
+			"start_time": {
+				Description:  "Start of the night, in the timezone of the schedule (HH:MM). Required when type is night.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(timeOfDayRegexp, "must be a time of the day (HH:MM)"),
+			},
+			"end_time": {
+				Description:  "End of the night, in the timezone of the schedule (HH:MM), it can be on the next day. Required when type is night.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(timeOfDayRegexp, "must be a time of the day (HH:MM)"),
+			},
+			"rate_multiplier": {
+				Description:  "Multiplier of the base on-call pay rate for the shifts covered by the tier.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.FloatBetween(0, 10),
+			},
+		},
+	}
+}
+
+func resourceOncallCompensationTierImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	tier, err := client.GetOncallCompensationTierByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(tier.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeOncallCompensationTier(d *schema.ResourceData) (*api.CreateUpdateOncallCompensationTierReq, error) {
+	tierType := d.Get("type").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	switch {
+	case tierType == "night" && (startTime == "" || endTime == ""):
+		return nil, fmt.Errorf("start_time and end_time are required when type is night")
+	case tierType != "night" && (startTime != "" || endTime != ""):
+		return nil, fmt.Errorf("start_time and end_time can only be set when type is night, got type %s", tierType)
+	}
+
+	scheduleID, err := strconv.Atoi(d.Get("schedule_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	rotationIDs := []int{}
+	for _, id := range tf.ExpandStringSet(d.Get("rotation_ids").(*schema.Set)) {
+		rotationID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+		rotationIDs = append(rotationIDs, rotationID)
+	}
+
+	return &api.CreateUpdateOncallCompensationTierReq{
+		Name:           d.Get("name").(string),
+		TeamID:         d.Get("team_id").(string),
+		Description:    d.Get("description").(string),
+		Type:           tierType,
+		ScheduleID:     scheduleID,
+		RotationIDs:    rotationIDs,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		RateMultiplier: d.Get("rate_multiplier").(float64),
+	}, nil
+}
+
+func resourceOncallCompensationTierCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeOncallCompensationTier(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating on-call compensation tier", tf.M{
+		"name": req.Name,
+	})
+	tier, err := client.CreateOncallCompensationTier(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "on-call compensation tier", req.Name, func() (string, error) {
+			existing, err := client.GetOncallCompensationTierByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceOncallCompensationTierUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(tier.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceOncallCompensationTierRead)
+}
+
+func resourceOncallCompensationTierRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading on-call compensation tier", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	tier, err := client.GetOncallCompensationTierById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(tier, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOncallCompensationTierUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeOncallCompensationTier(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateOncallCompensationTier(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceOncallCompensationTierRead(ctx, d, meta)
+}
+
+func resourceOncallCompensationTierDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteOncallCompensationTier(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceOncallCompensationTier(t *testing.T) {
+	tierName := testAccName("compensation-tier")
+
+	resourceName := "squadcast_oncall_compensation_tier.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOncallCompensationTierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceOncallCompensationTierConfig(tierName, "night", "", 1.5),
+				ExpectError: regexp.MustCompile("start_time and end_time are required when type is night"),
+			},
+			{
+				Config: testAccResourceOncallCompensationTierConfig(tierName, "night", `start_time = "20:00"
+	end_time = "08:00"`, 1.5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", tierName),
+					resource.TestCheckResourceAttr(resourceName, "type", "night"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "20:00"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "08:00"),
+					resource.TestCheckResourceAttr(resourceName, "rate_multiplier", "1.5"),
+				),
+			},
+			{
+				Config: testAccResourceOncallCompensationTierConfig(tierName, "weekend", "", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "weekend"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", ""),
+					resource.TestCheckResourceAttr(resourceName, "rate_multiplier", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + tierName,
+			},
+		},
+	})
+}
+
+func testAccCheckOncallCompensationTierDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_oncall_compensation_tier" {
+			continue
+		}
+
+		_, err := client.GetOncallCompensationTierById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected on-call compensation tier to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceOncallCompensationTierConfig(tierName, tierType, window string, rate float64) string {
+	return fmt.Sprintf(`
+resource "squadcast_oncall_compensation_tier" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	type = "%s"
+	schedule_id = "100"
+	rotation_ids = ["200"]
+	%s
+	rate_multiplier = %v
+}
+	`, tierName, tierType, window, rate)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceOncallHandoffNotesTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to set the template of the handoff notes of a schedule (v2), i.e. the notes prefilled for the outgoing on-call at the end of their shift, so that every shift transition covers the same topics. " +
+			"There can be only one template per schedule, it is removed when the resource is destroyed.",
+
+		CreateContext: resourceOncallHandoffNotesTemplateCreate,
+		ReadContext:   resourceOncallHandoffNotesTemplateRead,
+		UpdateContext: resourceOncallHandoffNotesTemplateUpdate,
+		DeleteContext: resourceOncallHandoffNotesTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOncallHandoffNotesTemplateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"content": {
+				Description:  "Template of the notes, in markdown, e.g. a checklist of the open incidents, ongoing maintenances and follow-ups.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validation.StringLenBetween(1, 10000)),
+			},
+			"require_notes": {
+				Description: "Whether the outgoing on-call must submit the notes before the end of their shift, otherwise the notes are optional.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"reminder_minutes_before": {
+				Description:  "Number of minutes before the end of a shift the outgoing on-call is reminded to write the notes at. 0 disables the reminder.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(0, 24*60),
+			},
+		},
+	}
+}
+
+func resourceOncallHandoffNotesTemplateImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("schedule_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceOncallHandoffNotesTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Get("schedule_id").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating handoff notes template", tf.M{
+		"schedule_id": scheduleID,
+	})
+	_, err = client.UpdateHandoffNotesTemplate(ctx, scheduleID, api.HandoffNotesTemplateInput{
+		Content:               d.Get("content").(string),
+		RequireNotes:          d.Get("require_notes").(bool),
+		ReminderMinutesBefore: d.Get("reminder_minutes_before").(int),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(d.Get("schedule_id").(string))
+
+	return readAfterCreate(ctx, d, meta, resourceOncallHandoffNotesTemplateRead)
+}
+
+func resourceOncallHandoffNotesTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Reading handoff notes template", tf.M{
+		"schedule_id": scheduleID,
+	})
+	template, err := client.GetHandoffNotesTemplate(ctx, scheduleID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(&template.HandoffNotesTemplate, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOncallHandoffNotesTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceOncallHandoffNotesTemplateCreate(ctx, d, meta)
+}
+
+func resourceOncallHandoffNotesTemplateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.DeleteHandoffNotesTemplate(ctx, scheduleID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceOncallHandoffNotesTemplate(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "squadcast_oncall_handoff_notes_template.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOncallHandoffNotesTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOncallHandoffNotesTemplateConfig(scheduleName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "schedule_id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "content", "## Open incidents\n"),
+					resource.TestCheckResourceAttr(resourceName, "require_notes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reminder_minutes_before", "30"),
+				),
+			},
+			{
+				Config: testAccResourceOncallHandoffNotesTemplateConfig(scheduleName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "require_notes", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOncallHandoffNotesTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_oncall_handoff_notes_template" {
+			continue
+		}
+
+		scheduleID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		template, err := client.GetHandoffNotesTemplate(context.Background(), scheduleID)
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if template.Content != "" {
+			return fmt.Errorf("expected handoff notes template to be destroyed, %s was found", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceOncallHandoffNotesTemplateConfig(scheduleName string, requireNotes bool) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_oncall_handoff_notes_template" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			content = "## Open incidents\n"
+			require_notes = %t
+		}
+	`, scheduleName, requireNotes)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourcePostmortemTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Postmortem templates](https://support.squadcast.com/docs/postmortems) are the markdown documents the postmortems of the incidents of a team start from. " +
+			"The placeholders of the template, in double curly braces, e.g. `incident.title`, are filled in with the details of the incident when a postmortem is created.",
+
+		CreateContext: resourcePostmortemTemplateCreate,
+		ReadContext:   resourcePostmortemTemplateRead,
+		UpdateContext: resourcePostmortemTemplateUpdate,
+		DeleteContext: resourcePostmortemTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostmortemTemplateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Postmortem template id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the postmortem template.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description: "Description of the postmortem template.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"body": {
+				Description:  "Markdown body of the postmortem template, with placeholders in double curly braces for the details of the incident.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePostmortemTemplateBody,
+			},
+			"is_default": {
+				Description: "Whether the postmortems of the team start from this template by default. A team has a single default template, " +
+					"setting it on a template unsets it on the previous default template, which is reported as a change of that template on its next plan.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// validatePostmortemTemplateBody makes sure every `{{` placeholder of the body is closed and non-empty.
+func validatePostmortemTemplateBody(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%s must not be empty", k))
+		return warnings, errors
+	}
+
+	if _, err := taggingTemplatePlaceholders(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return warnings, errors
+}
+
+func resourcePostmortemTemplateImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := client.GetPostmortemTemplateByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(template.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodePostmortemTemplate(d *schema.ResourceData) *api.CreateUpdatePostmortemTemplateReq {
+	return &api.CreateUpdatePostmortemTemplateReq{
+		TeamID:      d.Get("team_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Template:    d.Get("body").(string),
+		IsDefault:   d.Get("is_default").(bool),
+	}
+}
+
+func resourcePostmortemTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req := decodePostmortemTemplate(d)
+
+	tflog.Info(ctx, "Creating postmortem template", tf.M{
+		"name": req.Name,
+	})
+	template, err := client.CreatePostmortemTemplate(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "postmortem template", req.Name, func() (string, error) {
+			existing, err := client.GetPostmortemTemplateByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourcePostmortemTemplateUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(template.ID)
+
+	return readAfterCreate(ctx, d, meta, resourcePostmortemTemplateRead)
+}
+
+func resourcePostmortemTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading postmortem template", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	template, err := client.GetPostmortemTemplateById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(template, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePostmortemTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdatePostmortemTemplate(ctx, d.Id(), decodePostmortemTemplate(d))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourcePostmortemTemplateRead(ctx, d, meta)
+}
+
+func resourcePostmortemTemplateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeletePostmortemTemplate(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
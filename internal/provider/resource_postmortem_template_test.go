@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestValidatePostmortemTemplateBody(t *testing.T) {
+	cases := map[string]string{
+		"# {{incident.title}}\n\n{{ incident.description }}": "",
+		"# Postmortem":             "",
+		"":                         "must not be empty",
+		"# {{incident.title":       "unterminated template placeholder",
+		"# {{ }}":                  "empty template placeholder",
+		"# {{incident.{{title}}}}": "nested template placeholders",
+	}
+
+	for body, want := range cases {
+		_, errs := validatePostmortemTemplateBody(body, "body")
+		if want == "" {
+			if len(errs) != 0 {
+				t.Errorf("%q: unexpected errors %v", body, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || !regexp.MustCompile(want).MatchString(errs[0].Error()) {
+			t.Errorf("%q: expected an error matching %q, got %v", body, want, errs)
+		}
+	}
+}
+
+func TestAccResourcePostmortemTemplate(t *testing.T) {
+	templateName := testAccName("postmortem-template")
+
+	resourceName := "squadcast_postmortem_template.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPostmortemTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePostmortemTemplateConfig(templateName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", templateName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Incident review"),
+					resource.TestCheckResourceAttr(resourceName, "body", "# {{incident.title}}\n\n## Timeline\n"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+				),
+			},
+			{
+				Config: testAccResourcePostmortemTemplateConfig(templateName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", templateName),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + templateName,
+			},
+		},
+	})
+}
+
+func testAccCheckPostmortemTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_postmortem_template" {
+			continue
+		}
+
+		_, err := client.GetPostmortemTemplateById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected postmortem template to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourcePostmortemTemplateConfig(templateName string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "squadcast_postmortem_template" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name = "%s"
+	description = "Incident review"
+	body = <<-EOT
+		# {{incident.title}}
+
+		## Timeline
+	EOT
+	is_default = %t
+}
+	`, templateName, isDefault)
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceResponsePlay() *schema.Resource {
+	return &schema.Resource{
+		Description: "Response plays are predefined bundles of responders, runbooks and communication channels, applied to an incident in one click, e.g. the Sev1 play of a team. " +
+			"Define them as code to standardize them across teams.",
+
+		CreateContext: resourceResponsePlayCreate,
+		ReadContext:   resourceResponsePlayRead,
+		UpdateContext: resourceResponsePlayUpdate,
+		DeleteContext: resourceResponsePlayDelete,
+		CustomizeDiff: validateEntityRefs("responders"),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResponsePlayImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Response play id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the response play.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description: "Description of the response play.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"priority": {
+				Description:  "Priority set on the incident when the play is run (P1, P2, P3, P4, P5). The priority of the incident is left as is when empty.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"P1", "P2", "P3", "P4", "P5"}, false),
+			},
+			"responders": {
+				Description: "Responders added to the incident when the play is run.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: entityRefSchema("Responder", []string{"user", "squad", "escalation_policy"}, tf.ValidateObjectID),
+				},
+			},
+			"runbook_ids": {
+				Description: "Runbooks attached to the incident when the play is run, in order.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"communication_channels": {
+				Description: "Communication channels attached to the incident when the play is run, e.g. the video conference of the war room.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Channel type (chat_room, video_conference, other).",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"chat_room", "video_conference", "other"}, false),
+						},
+						"name": {
+							Description:  "Name of the channel.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"url": {
+							Description:  "URL of the channel.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+			"tags": {
+				Description: "Tags added to the incident when the play is run.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceResponsePlayImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, name, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	responsePlay, err := client.GetResponsePlayByName(ctx, teamID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(responsePlay.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeResponsePlay(ctx context.Context, client *api.Client, d *schema.ResourceData) (*api.CreateUpdateResponsePlayReq, error) {
+	mresponders := d.Get("responders").([]any)
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"responders": mresponders}, "responders"); err != nil {
+		return nil, err
+	}
+
+	req := &api.CreateUpdateResponsePlayReq{
+		TeamID:      d.Get("team_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Priority:    d.Get("priority").(string),
+		RunbookIDs:  tf.ExpandStringList(d.Get("runbook_ids").([]any)),
+		Tags:        tf.ExpandStringMap(d.Get("tags")),
+	}
+	if err := tf.DecodeAt("responders", mresponders, &req.Responders); err != nil {
+		return nil, err
+	}
+	if err := tf.DecodeAt("communication_channels", d.Get("communication_channels").([]any), &req.Channels); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func resourceResponsePlayCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeResponsePlay(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating response play", tf.M{
+		"name": req.Name,
+	})
+	responsePlay, err := client.CreateResponsePlay(ctx, req)
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "response play", req.Name, func() (string, error) {
+			existing, err := client.GetResponsePlayByName(ctx, req.TeamID, req.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceResponsePlayUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(responsePlay.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceResponsePlayRead)
+}
+
+func resourceResponsePlayRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading response play", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	responsePlay, err := client.GetResponsePlayById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	m, err := responsePlay.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "responders")
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceResponsePlayUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeResponsePlay(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateResponsePlay(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceResponsePlayRead(ctx, d, meta)
+}
+
+func resourceResponsePlayDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteResponsePlay(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
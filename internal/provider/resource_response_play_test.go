@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDecodeResponsePlay(t *testing.T) {
+	d := resourceResponsePlay().TestResourceData()
+	d.Set("team_id", "613611c1eb22db455cfa789f")
+	d.Set("name", "Sev1")
+	d.Set("priority", "P1")
+	d.Set("responders", []any{
+		map[string]any{"type": "squad", "id": "61305a8eb7a2fa0e44cfd0f5"},
+		map[string]any{"type": "escalation_policy", "id": "61305a8eb7a2fa0e44cfd0f6"},
+	})
+	d.Set("communication_channels", []any{
+		map[string]any{"type": "video_conference", "name": "War room", "url": "https://meet.example.com/sev1"},
+	})
+
+	req, err := decodeResponsePlay(context.Background(), &api.Client{}, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Responders) != 2 || req.Responders[1] != (api.ResponsePlayResponder{Type: "escalation_policy", ID: "61305a8eb7a2fa0e44cfd0f6"}) {
+		t.Errorf("unexpected responders %#v", req.Responders)
+	}
+	if len(req.Channels) != 1 || req.Channels[0].URL != "https://meet.example.com/sev1" {
+		t.Errorf("unexpected communication channels %#v", req.Channels)
+	}
+	if req.Priority != "P1" || len(req.RunbookIDs) != 0 {
+		t.Errorf("unexpected response play %#v", req)
+	}
+}
+
+func TestAccResourceResponsePlay(t *testing.T) {
+	responsePlayName := testAccName("response-play")
+
+	resourceName := "squadcast_response_play.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceResponsePlayConfig(responsePlayName, "P2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", responsePlayName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "P2"),
+					resource.TestCheckResourceAttr(resourceName, "responders.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "responders.0.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "responders.0.id", "5f8891527f735f0a6646f3b7"),
+					resource.TestCheckResourceAttrPair(resourceName, "runbook_ids.0", "squadcast_runbook.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "communication_channels.0.type", "video_conference"),
+					resource.TestCheckResourceAttr(resourceName, "communication_channels.0.url", "https://meet.example.com/sev1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.severity", "sev1"),
+				),
+			},
+			{
+				Config: testAccResourceResponsePlayConfig(responsePlayName, "P1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", responsePlayName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "P1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:" + responsePlayName,
+			},
+		},
+	})
+}
+
+func testAccCheckResponsePlayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_response_play" {
+			continue
+		}
+
+		_, err := client.GetResponsePlayById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected response play to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceResponsePlayConfig(responsePlayName, priority string) string {
+	return fmt.Sprintf(`
+resource "squadcast_runbook" "test" {
+	name = "%[1]s"
+	team_id = "613611c1eb22db455cfa789f"
+
+	steps {
+		content = "Page the incident commander"
+	}
+}
+
+resource "squadcast_response_play" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name = "%[1]s"
+	description = "Sev1 response"
+	priority = "%[2]s"
+
+	responders {
+		type = "user"
+		id = "5f8891527f735f0a6646f3b7"
+	}
+
+	runbook_ids = [squadcast_runbook.test.id]
+
+	communication_channels {
+		type = "video_conference"
+		name = "War room"
+		url = "https://meet.example.com/sev1"
+	}
+
+	tags = {
+		severity = "sev1"
+	}
+}
+	`, responsePlayName, priority)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// serviceRuleV2Schema builds the schema of a single rule resource from the `rules` element of the resource managing the whole list.
+func serviceRuleV2Schema(rules *schema.Resource) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": {
+			Description: "Rule id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"service_id": {
+			Description:  "Service id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+	}
+
+	for k, v := range rules.Schema["rules"].Elem.(*schema.Resource).Schema {
+		s[k] = v
+	}
+
+	return s
+}
+
+// serviceRuleV2Fields collects the attributes of a single rule resource in the shape of an element of `rules`.
+func serviceRuleV2Fields(rules *schema.Resource, get func(key string) any) tf.M {
+	ruleSchema := rules.Schema["rules"].Elem.(*schema.Resource).Schema
+
+	mrule := make(tf.M, len(ruleSchema))
+	for k := range ruleSchema {
+		mrule[k] = get(k)
+	}
+
+	return mrule
+}
+
+// importServiceRuleV2 imports a single rule resource by teamID:serviceID:ruleID.
+func importServiceRuleV2(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, serviceID, ruleID, err := parse3PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.SetId(ruleID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRoutingRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "A single [routing rule](https://support.squadcast.com/docs/alert-routing) of a service, managed by its id. " +
+			"Unlike `squadcast_routing_rules`, which owns the whole list of rules, this resource only appends, updates and deletes its own rule, " +
+			"so that several modules or teams can manage their rules on a shared service. Do not use both resources for the same service.",
+
+		CreateContext: resourceRoutingRuleV2Create,
+		ReadContext:   resourceRoutingRuleV2Read,
+		UpdateContext: resourceRoutingRuleV2Update,
+		DeleteContext: resourceRoutingRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServiceRuleV2,
+		},
+
+		Schema: serviceRuleV2Schema(resourceRoutingRules()),
+	}
+}
+
+func decodeRoutingRuleV2(d *schema.ResourceData) (*api.RoutingRule, error) {
+	var rule api.RoutingRule
+	err := Decode(serviceRuleV2Fields(resourceRoutingRules(), d.Get), &rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func resourceRoutingRuleV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := decodeRoutingRuleV2(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating routing_rule_v2", tf.M{
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	routingRule, err := client.CreateRoutingRuleV2(ctx, d.Get("service_id").(string), rule)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(routingRule.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceRoutingRuleV2Read)
+}
+
+func resourceRoutingRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading routing_rule_v2", tf.M{
+		"id":         d.Id(),
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	routingRule, err := client.GetRoutingRuleV2(ctx, d.Get("service_id").(string), d.Get("team_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(routingRule, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRoutingRuleV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := decodeRoutingRuleV2(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateRoutingRuleV2(ctx, d.Get("service_id").(string), d.Id(), rule)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceRoutingRuleV2Read(ctx, d, meta)
+}
+
+func resourceRoutingRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteRoutingRuleV2(ctx, d.Get("service_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceRoutingRuleV2(t *testing.T) {
+	resourceName := "squadcast_routing_rule_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingRuleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRoutingRuleV2Config("payload[\\\"event_id\\\"] == 40"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "is_basic", "false"),
+					resource.TestCheckResourceAttr(resourceName, "expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "route_to_id", "61305713d0b4ab89e2fec9ba"),
+					resource.TestCheckResourceAttr(resourceName, "route_to_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr("squadcast_routing_rule_v2.other", "route_to_type", "squad"),
+				),
+			},
+			{
+				Config: testAccResourceRoutingRuleV2Config("payload[\\\"event_id\\\"] == 41"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "expression", "payload[\"event_id\"] == 41"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd:" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckRoutingRuleV2Destroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_routing_rule_v2" {
+			continue
+		}
+
+		_, err := client.GetRoutingRuleV2(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected routing rule to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceRoutingRuleV2Config(expression string) string {
+	return fmt.Sprintf(`
+resource "squadcast_routing_rule_v2" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	is_basic = false
+	expression = "%s"
+
+	route_to_id = "61305713d0b4ab89e2fec9ba"
+	route_to_type = "user"
+}
+
+resource "squadcast_routing_rule_v2" "other" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	is_basic = true
+
+	basic_expressions {
+		lhs = "payload[\"foo\"]"
+		rhs = "bar"
+	}
+
+	route_to_id = "6136fbd2c2fc70c3101ca7e1"
+	route_to_type = "squad"
+}
+	`, expression)
+}
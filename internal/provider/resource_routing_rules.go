@@ -15,7 +15,7 @@ const routingRulesID = "routing_rules"
 
 func resourceRoutingRules() *schema.Resource {
 	return &schema.Resource{
-		Description: "[Routing rules](https://support.squadcast.com/docs/alert-routing) allows you to ensure that alerts are routed to the right responder with the help of `event tags` attached to them.",
+		Description: "[Routing rules](https://support.squadcast.com/docs/alert-routing) allows you to ensure that alerts are routed to the right responder with the help of `event tags` attached to them. This resource manages all the routing rules of the service: rules created outside of it are removed on its next update. Use `squadcast_routing_rule_v2` to manage the routing rules of a shared service from several configurations.",
 
 		CreateContext: resourceRoutingRulesCreate,
 		ReadContext:   resourceRoutingRulesRead,
@@ -50,6 +50,7 @@ func resourceRoutingRules() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"enabled": enabledSchema("Whether the rule routes the matching alerts, a disabled rule is kept but skipped."),
 						"is_basic": {
 							Description: "is_basic will be true when users use the drop down selectors which will have lhs, op & rhs value, whereas it will be false when they use the advanced mode and it would have the expression for it's value",
 							Type:        schema.TypeBool,
@@ -118,7 +119,7 @@ func resourceRoutingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 	var rules []api.RoutingRule
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, "Creating routing_rules", tf.M{
@@ -128,12 +129,12 @@ func resourceRoutingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 
 	_, err = client.UpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateRoutingRulesReq{Rules: rules})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(routingRulesID)
 
-	return resourceRoutingRulesRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceRoutingRulesRead)
 }
 
 func resourceRoutingRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -156,11 +157,11 @@ func resourceRoutingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 	routingRules, err := client.GetRoutingRules(ctx, serviceID.(string), teamID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(routingRules, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -172,12 +173,19 @@ func resourceRoutingRulesUpdate(ctx context.Context, d *schema.ResourceData, met
 	var rules []api.RoutingRule
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = client.UpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateRoutingRulesReq{Rules: rules})
+	oldRules, _ := d.GetChange("rules")
+	var base []api.RoutingRule
+	err = Decode(oldRules, &base)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceRoutingRulesRead(ctx, d, meta)
@@ -186,9 +194,15 @@ func resourceRoutingRulesUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceRoutingRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	_, err := client.UpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateRoutingRulesReq{Rules: []api.RoutingRule{}})
+	var base []api.RoutingRule
+	err := Decode(d.Get("rules"), &base)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, []api.RoutingRule{})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
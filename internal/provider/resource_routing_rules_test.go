@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,9 +14,9 @@ import (
 func TestAccResourceRoutingRules(t *testing.T) {
 	resourceName := "squadcast_routing_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckRoutingRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceRoutingRulesConfig(),
@@ -26,6 +27,7 @@ func TestAccResourceRoutingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_id", "5f8891527f735f0a6646f3b6"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_type", "user"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
@@ -51,6 +53,14 @@ func TestAccResourceRoutingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
 			},
+			{
+				Config: testAccResourceRoutingRulesConfig_disableRule(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.enabled", "true"),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -126,3 +136,8 @@ resource "squadcast_routing_rules" "test" {
 }
 	`)
 }
+
+func testAccResourceRoutingRulesConfig_disableRule() string {
+	return strings.Replace(testAccResourceRoutingRulesConfig_updateRules(), `route_to_type = "user"`, `route_to_type = "user"
+		enabled = false`, 1)
+}
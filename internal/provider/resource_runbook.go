@@ -108,7 +108,7 @@ func resourceRunbookCreate(ctx context.Context, d *schema.ResourceData, meta any
 	var steps []*api.RunbookStep
 	err := Decode(d.Get("steps"), &steps)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, "Creating runbook", tf.M{
@@ -134,12 +134,22 @@ func resourceRunbookCreate(ctx context.Context, d *schema.ResourceData, meta any
 
 	runbook, err := client.CreateRunbook(ctx, createRunbookReq)
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "runbook", d.Get("name").(string), func() (string, error) {
+			existing, err := client.GetRunbookByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceRunbookUpdate(ctx, d, meta)...)
 	}
 
 	d.SetId(runbook.ID)
 
-	return resourceRunbookRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceRunbookRead)
 }
 
 func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -162,11 +172,11 @@ func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, meta any)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(runbook, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -178,7 +188,7 @@ func resourceRunbookUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	var steps []*api.RunbookStep
 	err := Decode(d.Get("steps"), &steps)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateRunbookReq := &api.CreateUpdateRunbookReq{
@@ -202,7 +212,7 @@ func resourceRunbookUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	_, err = client.UpdateRunbook(ctx, d.Id(), updateRunbookReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceRunbookRead(ctx, d, meta)
@@ -217,7 +227,7 @@ func resourceRunbookDelete(ctx context.Context, d *schema.ResourceData, meta any
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,20 +5,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 func TestAccResourceRunbook(t *testing.T) {
-	runbookName := acctest.RandomWithPrefix("runbook")
+	runbookName := testAccName("runbook")
 
 	resourceName := "squadcast_runbook.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckRunbookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRunbookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceRunbookConfig(runbookName),
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,7 +23,7 @@ func resourceSchedule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScheduleImport,
 		},
-		DeprecationMessage: "This resource is deprecated, please use `squadcast_schedule_v2` instead.",
+		DeprecationMessage: "This resource is deprecated, please use `squadcast_schedule_v2` instead. The `squadcast_schedule_v2_migration` data source maps legacy schedules to their v2 schedules.",
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "Schedule id.",
@@ -53,6 +54,11 @@ func resourceSchedule() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"slug": {
+				Description: "Slug of the schedule, it does not change when the schedule is renamed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -65,10 +71,16 @@ func resourceScheduleImport(ctx context.Context, d *schema.ResourceData, meta an
 		return nil, err
 	}
 
-	schedule, err := client.GetScheduleByName(ctx, teamID, name)
+	schedule, diags, err := lookupSchedule(ctx, client, teamID, name)
 	if err != nil {
 		return nil, err
 	}
+	// The importers can not return diagnostics, the warnings are logged instead.
+	for _, warning := range diags {
+		tflog.Warn(ctx, warning.Summary, tf.M{
+			"detail": warning.Detail,
+		})
+	}
 
 	d.Set("team_id", teamID)
 	d.SetId(schedule.ID)
@@ -76,6 +88,34 @@ func resourceScheduleImport(ctx context.Context, d *schema.ResourceData, meta an
 	return []*schema.ResourceData{d}, nil
 }
 
+// lookupSchedule returns the schedule with the given slug, or with the given name if no schedule has this slug, along
+// with a warning when it was looked up by name.
+func lookupSchedule(ctx context.Context, client *api.Client, teamID string, slugOrName string) (*api.Schedule, diag.Diagnostics, error) {
+	schedule, err := client.GetScheduleBySlug(ctx, teamID, slugOrName)
+	if err == nil {
+		return schedule, nil, nil
+	}
+	if api.ErrorStatusCode(err) != 0 {
+		return nil, nil, err
+	}
+
+	schedule, err = client.GetScheduleByName(ctx, teamID, slugOrName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return schedule, diag.Diagnostics{scheduleLookedUpByNameWarning(schedule)}, nil
+}
+
+// scheduleLookedUpByNameWarning warns that a schedule was looked up by name rather than by slug.
+func scheduleLookedUpByNameWarning(schedule *api.Schedule) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Schedule `%s` looked up by name", schedule.Name),
+		Detail:   fmt.Sprintf("The lookup breaks once the schedule is renamed, use its slug `%s` instead.", schedule.Slug),
+	}
+}
+
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -89,12 +129,12 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta an
 		Color:       d.Get("color").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(schedule.ID)
 
-	return resourceScheduleRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceScheduleRead)
 }
 
 func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -117,11 +157,11 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta any)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(schedule, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -137,7 +177,7 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		Color:       d.Get("color").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScheduleRead(ctx, d, meta)
@@ -152,7 +192,7 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
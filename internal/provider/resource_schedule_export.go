@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceScheduleExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to enable the calendar (ICS) export of a schedule (v2) and get its feed URLs, e.g. to distribute calendar subscription links. The export is disabled when the resource is destroyed.",
+
+		CreateContext: resourceScheduleExportCreate,
+		ReadContext:   resourceScheduleExportRead,
+		UpdateContext: resourceScheduleExportUpdate,
+		DeleteContext: resourceScheduleExportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScheduleExportImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schedule_id": {
+				Description:      "id of the schedule to export.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateScheduleV2ID,
+			},
+			"enabled": {
+				Description: "Whether the calendar export is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"ics_url": {
+				Description: "URL of the ICS feed of the schedule, including the overrides. Empty when the export is disabled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"webcal_url": {
+				Description: "The `ics_url` with the webcal scheme, to subscribe to the schedule from calendar apps.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceScheduleExportImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("schedule_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceScheduleExportCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Get("schedule_id").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating schedule export", tf.M{
+		"schedule_id": scheduleID,
+		"enabled":     d.Get("enabled").(bool),
+	})
+	_, err = client.UpdateScheduleExport(ctx, scheduleID, api.ScheduleExportInput{
+		Enabled: d.Get("enabled").(bool),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(d.Get("schedule_id").(string))
+
+	return readAfterCreate(ctx, d, meta, resourceScheduleExportRead)
+}
+
+func resourceScheduleExportRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Reading schedule export", tf.M{
+		"schedule_id": scheduleID,
+	})
+	export, err := client.GetScheduleExport(ctx, scheduleID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(&export.ScheduleExport, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScheduleExportUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceScheduleExportCreate(ctx, d, meta)
+}
+
+func resourceScheduleExportDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	scheduleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateScheduleExport(ctx, scheduleID, api.ScheduleExportInput{
+		Enabled: false,
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
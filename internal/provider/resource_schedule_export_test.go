@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceScheduleExport(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "squadcast_schedule_export.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScheduleExportConfig(scheduleName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "schedule_id", "squadcast_schedule_v2.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ics_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "webcal_url"),
+				),
+			},
+			{
+				Config: testAccResourceScheduleExportConfig(scheduleName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ics_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "webcal_url", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleExportDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_schedule_export" {
+			continue
+		}
+
+		scheduleID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		export, err := client.GetScheduleExport(context.Background(), scheduleID)
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if export.Enabled {
+			return fmt.Errorf("expected schedule export to be disabled, %s is enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceScheduleExportConfig(scheduleName string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "UTC"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+		}
+
+		resource "squadcast_schedule_export" "test" {
+			schedule_id = squadcast_schedule_v2.test.id
+			enabled = %t
+		}
+	`, scheduleName, enabled)
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -17,7 +19,8 @@ import (
 
 func resourceScheduleRotationV2() *schema.Resource {
 	return &schema.Resource{
-		Description:   "[Schedule rotations](https://support.squadcast.com/schedules/schedules-new/adding-a-schedule#2.-choose-a-rotation-pattern) are used to manage on-call scheduling & determine who will be notified when an incident is triggered.",
+		Description: "[Schedule rotations](https://support.squadcast.com/schedules/schedules-new/adding-a-schedule#2.-choose-a-rotation-pattern) are used to manage on-call scheduling & determine who will be notified when an incident is triggered. " +
+			"If the id of the rotation becomes invalid, e.g. after its schedule is rebuilt, the rotation is looked up by name in its schedule and the new id is adopted with a warning.",
 		ReadContext:   resourceScheduleRotationV2Read,
 		CreateContext: resourceScheduleRotationV2Create,
 		UpdateContext: resourceScheduleRotationV2Update,
@@ -25,46 +28,228 @@ func resourceScheduleRotationV2() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScheduleRotationV2Import,
 		},
+		CustomizeDiff: customdiff.All(
+			validateEntityRefs("participant_groups.*.participants"),
+			scheduleRotationV2CustomizeDiff,
+			validateTeamParticipants(func(d *schema.ResourceDiff) []string {
+				return rotationTeamParticipants("", map[string]any{"participant_groups": d.Get("participant_groups")})
+			}),
+			validateReferences(scheduleRotationV2References),
+		),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceScheduleRotationV2V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceScheduleRotationV2StateUpgradeV0,
+			},
+		},
+		Schema: resourceScheduleRotationV2Schema(),
+	}
+}
+
+// scheduleRotationV2References returns the participants of the rotation, the schedule is looked up for the team of the squads.
+func scheduleRotationV2References(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error) {
+	scheduleID := d.Get("schedule_id").(string)
+	if scheduleID == "" || !d.NewValueKnown("schedule_id") {
+		return nil, nil
+	}
+
+	schedule, err := client.GetScheduleV2ById(ctx, scheduleID)
+	if err != nil {
+		return nil, reference{Attribute: "schedule_id", Type: "schedule", ID: scheduleID}.error(err)
+	}
+
+	return rotationReferences("", map[string]any{"participant_groups": d.Get("participant_groups")}, schedule.TeamID), nil
+}
+
+func resourceScheduleRotationV2Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "Rotation id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule_id": {
+			Description:      "id of the schedule that the rotation belongs to.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateScheduleV2ID,
+		},
+		"name": {
+			Description:  "Rotation name.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 150),
+		},
+		"participant_groups": {
+			Description: "Ordered list of participant groups for the rotation. For each rotation the participant_groups are cycled through in order.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"participants": {
+						Description: "Group participants. Teams are rejected during plan when the plan of the organization does not allow them as participants of schedules.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: rotationParticipantSchema(),
+						},
+					},
+				},
+			},
+		},
+		"start_date": {
+			Description:      "Defines the start date of the rotation, in RFC3339 format. The date is read back in the timezone of the schedule, dates with another offset denoting the same instant are not reported as changes.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppressEquivalentRotationDate,
+		},
+		"period": {
+			Description:  "Rotation period (none, daily, weekly, monthly, custom). Defines how often the rotation repeats.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"none", "daily", "weekly", "monthly", "custom"}, false),
+		},
+		"shift_timeslots": {
+			Description: "Timeslots where the rotation is active.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_hour": {
+						Description:  "Defines the start hour of the each shift in the schedule timezone.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 23),
+					},
+					"start_minute": {
+						Description:  "Defines the start minute of the each shift in the schedule timezone.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 59),
+					},
+					"duration": {
+						Description:  "Defines the duration of each shift. (in minutes)",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 1440),
+					},
+					"day_of_week": {
+						Description:           "Defines the day of the week for the shift. If not specified, the timeslot is active on all days of the week.",
+						Type:                  schema.TypeString,
+						Optional:              true,
+						ValidateFunc:          validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, true),
+						DiffSuppressFunc:      tf.SuppressCaseDifferences,
+						DiffSuppressOnRefresh: true,
+					},
+				},
+			},
+		},
+		"custom_period_frequency": {
+			Description:  "Frequency of the custom rotation repeat pattern. Only applicable if period is set to custom.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"custom_period_unit": {
+			Description:  "Unit of the custom rotation repeat pattern (day, week). Only applicable if period is set to custom.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"day", "week"}, false),
+		},
+		"change_participants_frequency": {
+			Description:  "Frequency with which participants change in the rotation.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"change_participants_unit": {
+			Description:  "Unit of the frequency with which participants change in the rotation (rotation, day, week, month).",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"rotation", "day", "week", "month"}, false),
+		},
+		"end_date": {
+			Description:      "Defines the end date of the schedule rotation, in RFC3339 format. Like `start_date`, it is read back in the timezone of the schedule. Only one of `end_date`, `ends_after_iterations` and `ends_never` can be set.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppressEquivalentRotationDate,
+			ConflictsWith:    []string{"ends_after_iterations", "ends_never"},
+		},
+		"ends_after_iterations": {
+			Description:   "Defines the number of iterations of the schedule rotation. Only one of `end_date`, `ends_after_iterations` and `ends_never` can be set.",
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"end_date", "ends_never"},
+		},
+		"ends_never": {
+			Description: "Defines that the schedule rotation never ends, to state it explicitly instead of leaving `end_date` and `ends_after_iterations` unset. " +
+				"When the rotation is found with an end, e.g. set outside of Terraform, the change is planned to make it never end again. Only one of `end_date`, `ends_after_iterations` and `ends_never` can be set. Defaults to `false`.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"end_date", "ends_after_iterations"},
+		},
+		"color": {
+			Description:  "Color of the rotation in the schedule calendar, hex value (e.g. `#0f61dd`). Defaults to the color assigned by Squadcast.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringMatch(tagColorRegexp, "must be a hex color code, e.g. #0f61dd"),
+		},
+	}
+}
+
+// rotationParticipantSchema is the schema of the participants of the participant groups of a rotation.
+func rotationParticipantSchema() map[string]*schema.Schema {
+	s := entityRefSchema("Participant", []string{"user", "squad", "team"}, tf.ValidateObjectID)
+	s["display_name"] = &schema.Schema{
+		Description: "Name of the participant as shown in Squadcast, e.g. the full name of the user, resolved from its id.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return s
+}
+
+// resourceScheduleRotationV2V0 is the schema before schedule_id was changed from a number to a string. It is a frozen
+// copy of the schema of that version, later changes to the schema must not be made here.
+func resourceScheduleRotationV2V0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "Rotation id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"schedule_id": {
-				Description: "id of the schedule that the rotation belongs to.",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"name": {
-				Description:  "Rotation name.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 150),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"participant_groups": {
-				Description: "Ordered list of participant groups for the rotation. For each rotation the participant_groups are cycled through in order.",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"participants": {
-							Description: "Group participants.",
-							Type:        schema.TypeList,
-							Optional:    true,
+							Type:     schema.TypeList,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
-										Description:  "Participant type (user, team, squad).",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
+										Type:     schema.TypeString,
+										Required: true,
 									},
 									"id": {
-										Description:  "Participant id.",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: tf.ValidateObjectID,
+										Type:     schema.TypeString,
+										Required: true,
 									},
 								},
 							},
@@ -73,87 +258,82 @@ func resourceScheduleRotationV2() *schema.Resource {
 				},
 			},
 			"start_date": {
-				Description: "Defines the start date of the rotation.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"period": {
-				Description:  "Rotation period (none, daily, weekly, monthly, custom). Defines how often the rotation repeats.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"none", "daily", "weekly", "monthly", "custom"}, false),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"shift_timeslots": {
-				Description: "Timeslots where the rotation is active.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"start_hour": {
-							Description:  "Defines the start hour of the each shift in the schedule timezone.",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 23),
-						},
-						"start_minute": {
-							Description:  "Defines the start minute of the each shift in the schedule timezone.",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 59),
-						},
-						"duration": {
-							Description:  "Defines the duration of each shift. (in minutes)",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 1440),
-						},
-						"day_of_week": {
-							Description:  "Defines the day of the week for the shift. If not specified, the timeslot is active on all days of the week.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, false),
-						},
-					},
+					Schema: rotationShiftTimeslotSchemaV0(),
 				},
 			},
 			"custom_period_frequency": {
-				Description:  "Frequency of the custom rotation repeat pattern. Only applicable if period is set to custom.",
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(1, 100),
+				Type:     schema.TypeInt,
+				Optional: true,
 			},
 			"custom_period_unit": {
-				Description:  "Unit of the custom rotation repeat pattern (day, week). Only applicable if period is set to custom.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"day", "week"}, false),
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"change_participants_frequency": {
-				Description:  "Frequency with which participants change in the rotation.",
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntBetween(1, 100),
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"change_participants_unit": {
-				Description:  "Unit of the frequency with which participants change in the rotation (rotation, day, week, month).",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"rotation", "day", "week", "month"}, false),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"end_date": {
-				Description: "Defines the end date of the schedule rotation.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"ends_after_iterations": {
-				Description: "Defines the number of iterations of the schedule rotation.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Type:     schema.TypeInt,
+				Optional: true,
 			},
 		},
 	}
 }
+
+// rotationShiftTimeslotSchemaV0 is the frozen schema of the shift timeslots of the version 0 of the rotations.
+func rotationShiftTimeslotSchemaV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_hour": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"start_minute": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"duration": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"day_of_week": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func resourceScheduleRotationV2StateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	switch scheduleID := rawState["schedule_id"].(type) {
+	case float64:
+		rawState["schedule_id"] = strconv.FormatInt(int64(scheduleID), 10)
+	case int:
+		rawState["schedule_id"] = strconv.Itoa(scheduleID)
+	}
+
+	return rawState, nil
+}
+
 func parse3PartImportID(id string) (string, string, string, error) {
 	parts := strings.SplitN(id, ":", 3)
 
@@ -171,15 +351,55 @@ func resourceScheduleRotationV2Import(ctx context.Context, d *schema.ResourceDat
 		return nil, err
 	}
 
+	// The ids do not change when the schedule or the rotation is renamed, they are preferred to the names.
+	if _, err := strconv.Atoi(scheduleName); err == nil {
+		rotation, err := findScheduleRotationByID(ctx, client, teamID, scheduleName, rotationName)
+		if err != nil {
+			return nil, err
+		}
+		if rotation != nil {
+			d.SetId(strconv.Itoa(rotation.ID))
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
 	rotation, err := client.GetRotationByName(ctx, teamID, scheduleName, rotationName)
 	if err != nil {
 		return nil, errors.New("rotation not found")
 	}
+	tflog.Warn(ctx, "Rotation imported by name, the import breaks once the schedule or the rotation is renamed: import it by teamID:scheduleID:rotationID instead", tf.M{
+		"schedule_name": scheduleName,
+		"rotation_name": rotationName,
+		"rotation_id":   rotation.NewRotation.ID,
+	})
 	d.SetId(strconv.Itoa(rotation.NewRotation.ID))
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// findScheduleRotationByID returns the rotation with the given id of the schedule with the given id, or nil if the
+// team has no such schedule or rotation.
+func findScheduleRotationByID(ctx context.Context, client *api.Client, teamID, scheduleID, rotationID string) (*api.NewRotation, error) {
+	schedule, err := client.GetScheduleV2ById(ctx, scheduleID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if schedule.TeamID != teamID {
+		return nil, nil
+	}
+
+	for i := range schedule.Rotations {
+		if strconv.Itoa(schedule.Rotations[i].ID) == rotationID {
+			return &schedule.Rotations[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
 func resourceScheduleRotationV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -189,206 +409,428 @@ func resourceScheduleRotationV2Read(ctx context.Context, d *schema.ResourceData,
 		"name": d.Get("name").(string),
 	})
 
+	var diags diag.Diagnostics
 	rotation, err := client.GetScheduleRotationById(ctx, id)
 	if err != nil {
-		if api.IsResourceNotFoundError(err) {
+		if !api.IsResourceNotFoundError(err) {
+			return diagFromErr(err)
+		}
+
+		// The rotation ids change when the schedule is rebuilt upstream, look the rotation up by name to keep it in state.
+		adopted, ferr := findScheduleRotationByName(ctx, client, d.Get("schedule_id").(string), d.Get("name").(string))
+		if ferr != nil {
+			// The schedule was deleted along with the rotation.
+			if api.IsResourceNotFoundError(ferr) {
+				d.SetId("")
+				return nil
+			}
+			return diagFromErr(ferr)
+		}
+		if adopted == nil || strconv.Itoa(adopted.ID) == id {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+
+		d.SetId(strconv.Itoa(adopted.ID))
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Rotation id changed",
+			Detail:   fmt.Sprintf("Rotation %s could not be read (%s), the rotation %q of the schedule %s was adopted with the id %d instead.", id, err, adopted.Name, d.Get("schedule_id").(string), adopted.ID),
+		})
+		rotation = &api.ScheduleRotationQueryStruct{NewRotation: *adopted}
 	}
 
-	if err = tf.EncodeAndSet(rotation, d); err != nil {
-		return diag.FromErr(err)
+	m, err := rotation.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if err = normalizeScheduleRotationV2Dates(ctx, client, d, m); err != nil {
+		return diagFromErr(err)
+	}
+	flattenRotationEndsNever(m, d.Get("ends_never").(bool))
+	preserveEntityRefNames(d, m, "participant_groups.*.participants")
+	setEntityRefDisplayNames(ctx, client, rotationParticipantsTeamID(ctx, client, d.Get("schedule_id").(string), m), m, "participant_groups.*.participants")
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceScheduleRotationV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+// rotationDates are the attributes of a rotation holding a date, which the API returns in UTC.
+var rotationDates = []string{"start_date", "end_date"}
 
-	tflog.Info(ctx, "Creating rotation", tf.M{
-		"name": d.Get("name").(string),
-	})
+// suppressEquivalentRotationDate suppresses the diff between two rotation dates denoting the same instant, e.g. a date
+// configured with the offset of the schedule timezone and the same date in UTC.
+func suppressEquivalentRotationDate(k, old, new string, d *schema.ResourceData) bool {
+	return sameInstant(old, new)
+}
 
-	createScheduleRotationReq := api.NewRotation{
-		Name:                        d.Get("name").(string),
-		StartDate:                   d.Get("start_date").(string),
-		Period:                      d.Get("period").(string),
-		ChangeParticipantsFrequency: d.Get("change_participants_frequency").(int),
-		ChangeParticipantsUnit:      d.Get("change_participants_unit").(string),
+func sameInstant(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
 	}
+	tb, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+
+	return ta.Equal(tb)
+}
 
-	endsAfterIterations, isIterationsEndSet := d.GetOk("ends_after_iterations")
-	endDate, isEndDateSet := d.GetOk("end_date")
-	if isIterationsEndSet {
-		createScheduleRotationReq.EndsAfterIterations = endsAfterIterations.(int)
+// normalizeRotationDates sets the dates of an encoded rotation to the prior values when they denote the same instant,
+// to keep the configured format, and otherwise formats them in the given location.
+func normalizeRotationDates(m tf.M, prior map[string]any, loc *time.Location) {
+	for _, k := range rotationDates {
+		v, _ := m[k].(string)
+		p, _ := prior[k].(string)
+		if sameInstant(v, p) {
+			m[k] = p
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			m[k] = t.In(loc).Format(time.RFC3339)
+		}
 	}
-	if isEndDateSet {
-		createScheduleRotationReq.EndDate = endDate.(string)
+}
+
+// rotationDatesChanged reports whether a date of an encoded rotation doesn't denote the same instant as its prior value.
+func rotationDatesChanged(m tf.M, prior map[string]any) bool {
+	for _, k := range rotationDates {
+		v, _ := m[k].(string)
+		p, _ := prior[k].(string)
+		if v != "" && !sameInstant(v, p) {
+			return true
+		}
 	}
-	if isIterationsEndSet && isEndDateSet {
-		return diag.Errorf("only one of end_date and ends_after_iterations can be set")
+
+	return false
+}
+
+// normalizeScheduleRotationV2Dates normalizes the dates of the rotation read from the API, the schedule is only looked
+// up for its timezone when a date changed, e.g. on import.
+func normalizeScheduleRotationV2Dates(ctx context.Context, client *api.Client, d *schema.ResourceData, m tf.M) error {
+	prior := map[string]any{}
+	for _, k := range rotationDates {
+		prior[k] = d.Get(k)
+	}
+	if !rotationDatesChanged(m, prior) {
+		normalizeRotationDates(m, prior, time.UTC)
+		return nil
 	}
 
-	participants := d.Get("participant_groups").([]interface{})
-	if len(participants) > 0 {
-		var participantGroupsList []api.ParticipantGroup
-		for _, participant := range participants {
-			participantMap, ok := participant.(map[string]interface{})
-			if !ok {
-				return diag.Errorf("participant_groups is invalid")
-			}
-			var participantGroup api.ParticipantGroup
-			var participantsList []api.Participant
-			participants := participantMap["participants"].([]interface{})
+	schedule, err := client.GetScheduleV2ById(ctx, d.Get("schedule_id").(string))
+	if err != nil {
+		return err
+	}
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q of schedule %s: %w", schedule.TimeZone, d.Get("schedule_id").(string), err)
+	}
+	normalizeRotationDates(m, prior, loc)
 
-			err := Decode(participants, &participantsList)
-			if err != nil {
-				return diag.Errorf(err.Error())
-			}
-			participantGroup.Participants = participantsList
-			participantGroupsList = append(participantGroupsList, participantGroup)
+	return nil
+}
+
+// rotationParticipantsTeamID returns the team of the schedule of a rotation, to look up its squad participants. The
+// schedule is only looked up when the rotation has squad participants, an empty team is returned otherwise.
+func rotationParticipantsTeamID(ctx context.Context, client *api.Client, scheduleID string, mrotation tf.M) string {
+	for _, ref := range findEntityRefs(mrotation, "participant_groups.*.participants") {
+		if ref.m["type"] != "squad" {
+			continue
 		}
-		createScheduleRotationReq.ParticipantGroups = participantGroupsList
+
+		schedule, err := client.GetScheduleV2ById(ctx, scheduleID)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to read the schedule for the team of the squad participants", tf.M{
+				"schedule_id": scheduleID,
+				"error":       err.Error(),
+			})
+			return ""
+		}
+		return schedule.TeamID
 	}
 
-	shiftTimeSlots := d.Get("shift_timeslots").([]interface{})
-	if len(shiftTimeSlots) > 0 {
-		if createScheduleRotationReq.Period != "custom" && len(shiftTimeSlots) > 1 {
-			return diag.Errorf("multiple shift_timeslots can only be set when period is custom")
+	return ""
+}
+
+// findScheduleRotationByName scans the rotations of a schedule for the given name, it returns nil if the schedule has no such rotation.
+func findScheduleRotationByName(ctx context.Context, client *api.Client, scheduleID, name string) (*api.NewRotation, error) {
+	if scheduleID == "" || name == "" {
+		return nil, nil
+	}
+
+	tflog.Info(ctx, "Looking up rotation by name", tf.M{
+		"schedule_id": scheduleID,
+		"name":        name,
+	})
+	schedule, err := client.GetScheduleV2ById(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range schedule.Rotations {
+		if schedule.Rotations[i].Name == name {
+			return &schedule.Rotations[i], nil
 		}
-		var shiftTimeSlotsList []api.Timeslot
-		err := Decode(shiftTimeSlots, &shiftTimeSlotsList)
-		if err != nil {
-			return diag.Errorf("shift_timeslots is invalid")
+	}
+
+	return nil, nil
+}
+
+// validateRotationEnd checks that a rotation ends in at most one way, the API would otherwise pick one of them.
+func validateRotationEnd(mrotation map[string]any) error {
+	var ends []string
+	if endDate, _ := mrotation["end_date"].(string); endDate != "" {
+		ends = append(ends, "end_date")
+	}
+	if iterations, _ := mrotation["ends_after_iterations"].(int); iterations != 0 {
+		ends = append(ends, "ends_after_iterations")
+	}
+	if endsNever, _ := mrotation["ends_never"].(bool); endsNever {
+		ends = append(ends, "ends_never")
+	}
+	if len(ends) > 1 {
+		return fmt.Errorf("only one of end_date, ends_after_iterations and ends_never can be set, got %s", strings.Join(ends, " and "))
+	}
+
+	return nil
+}
+
+// rotationTeamParticipants returns the paths of the team participants of a rotation at the given path, e.g.
+// participant_groups.0.participants.1.
+func rotationTeamParticipants(path string, rotation map[string]any) []string {
+	var paths []string
+	groups, _ := rotation["participant_groups"].([]any)
+	for i, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+		participants, _ := group["participants"].([]any)
+		for j, p := range participants {
+			if participant, ok := p.(map[string]any); ok && participant["type"] == "team" {
+				paths = append(paths, fmt.Sprintf("%s.%d.participants.%d", joinAttributePath(path, "participant_groups"), i, j))
+			}
 		}
-		createScheduleRotationReq.ShiftTimeSlots = shiftTimeSlotsList
 	}
 
-	customPeriodFreq, freqIsSet := d.GetOk("custom_period_frequency")
-	customPeriodUnit, unitIsSet := d.GetOk("custom_period_unit")
+	return paths
+}
 
-	if createScheduleRotationReq.Period == "custom" {
-		if !freqIsSet || customPeriodFreq.(int) == 0 {
-			return diag.Errorf("custom_period_frequency must be set when period is custom")
+// validateTeamParticipants returns a CustomizeDiff function that rejects the team participants of rotations, at the
+// paths returned by participants, when the plan of the organization does not allow teams in schedules.
+func validateTeamParticipants(participants func(d *schema.ResourceDiff) []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		client, ok := meta.(*api.Client)
+		if !ok {
+			return nil
 		}
-		if !unitIsSet || customPeriodUnit.(string) == "" {
-			return diag.Errorf("custom_period_unit must be set when period is custom")
+		paths := participants(d)
+		if len(paths) == 0 {
+			return nil
 		}
 
-		createScheduleRotationReq.CustomPeriodFrequency = customPeriodFreq.(int)
-		createScheduleRotationReq.CustomPeriodUnit = customPeriodUnit.(string)
-	} else {
-		if freqIsSet {
-			return diag.Errorf("custom_period_frequency can only be set when period is custom")
+		plan, err := client.GetCurrentOrganizationPlan(ctx)
+		if err != nil {
+			return err
 		}
-		if unitIsSet {
-			return diag.Errorf("custom_period_unit can only be set when period is custom")
+		if allowed := plan.Features.ScheduleTeamParticipants; allowed == nil || *allowed {
+			return nil
 		}
+
+		return fmt.Errorf("%s: the `%s` plan of your organization does not allow teams as participants of schedule rotations, use its users or squads instead", strings.Join(paths, ", "), plan.Name)
 	}
+}
 
-	rotation, err := client.CreateScheduleRotation(ctx, d.Get("schedule_id").(int), createScheduleRotationReq)
-	if err != nil {
-		return diag.FromErr(err)
+// withTeamParticipantField points the errors of the GraphQL API about team participants which don't report their
+// field at the first team participant of the rotation, whose paths are returned by rotationTeamParticipants.
+func withTeamParticipantField(err error, paths []string) error {
+	var gqlErrs *api.GraphQLErrors
+	if len(paths) == 0 || !errors.As(err, &gqlErrs) {
+		return err
 	}
 
-	d.SetId(strconv.Itoa(rotation.NewRotation.ID))
+	scoped := &api.GraphQLErrors{Operation: gqlErrs.Operation}
+	for _, gqlErr := range gqlErrs.Errors {
+		gqlErr := *gqlErr
+		if message := strings.ToLower(gqlErr.Message); gqlErr.Field == "" && strings.Contains(message, "team") && strings.Contains(message, "participant") {
+			gqlErr.Field = paths[0] + ".type"
+		}
+		scoped.Errors = append(scoped.Errors, &gqlErr)
+	}
 
-	return resourceScheduleRotationV2Read(ctx, d, meta)
+	return scoped
 }
 
-func resourceScheduleRotationV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+// flattenRotationEndsNever sets ends_never of an encoded rotation, which is not returned by the API: it is kept when
+// the rotation has no end, and is false otherwise so that an end set outside of Terraform is planned to be removed.
+func flattenRotationEndsNever(m tf.M, endsNever bool) {
+	endDate, _ := m["end_date"].(string)
+	iterations, _ := m["ends_after_iterations"].(int)
+	m["ends_never"] = endsNever && endDate == "" && iterations == 0
+}
 
-	tflog.Info(ctx, "Creating rotation", tf.M{
-		"name": d.Get("name").(string),
-	})
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	updateScheduleRotationReq := api.NewRotation{
-		Name:                        d.Get("name").(string),
-		StartDate:                   d.Get("start_date").(string),
-		Period:                      d.Get("period").(string),
-		ChangeParticipantsFrequency: d.Get("change_participants_frequency").(int),
-		ChangeParticipantsUnit:      d.Get("change_participants_unit").(string),
+// expandScheduleRotation converts the attributes of a rotation, as in the schema of `squadcast_schedule_rotation_v2`, into an API rotation.
+func expandScheduleRotation(mrotation tf.M) (*api.NewRotation, error) {
+	rotation := &api.NewRotation{
+		Name:                        mrotation["name"].(string),
+		StartDate:                   mrotation["start_date"].(string),
+		Period:                      mrotation["period"].(string),
+		ChangeParticipantsFrequency: mrotation["change_participants_frequency"].(int),
+		ChangeParticipantsUnit:      mrotation["change_participants_unit"].(string),
+		EndsAfterIterations:         mrotation["ends_after_iterations"].(int),
+		EndDate:                     mrotation["end_date"].(string),
+		Color:                       mrotation["color"].(string),
 	}
 
-	endsAfterIterations, isIterationsEndSet := d.GetOk("ends_after_iterations")
-	endDate, isEndDateSet := d.GetOk("end_date")
-	if isIterationsEndSet {
-		updateScheduleRotationReq.EndsAfterIterations = endsAfterIterations.(int)
-	}
-	if isEndDateSet {
-		updateScheduleRotationReq.EndDate = endDate.(string)
-	}
-	if isIterationsEndSet && isEndDateSet {
-		return diag.Errorf("only one of end_date and ends_after_iterations can be set")
+	if err := validateRotationEnd(mrotation); err != nil {
+		return nil, err
 	}
 
-	participants := d.Get("participant_groups").([]interface{})
+	participants := mrotation["participant_groups"].([]interface{})
 	if len(participants) > 0 {
 		var participantGroupsList []api.ParticipantGroup
-		for _, participant := range participants {
+		for i, participant := range participants {
 			participantMap, ok := participant.(map[string]interface{})
 			if !ok {
-				return diag.Errorf("participant_groups is invalid")
+				return nil, fmt.Errorf("participant_groups is invalid")
 			}
 			var participantGroup api.ParticipantGroup
 			var participantsList []api.Participant
 			participants := participantMap["participants"].([]interface{})
 
-			err := Decode(participants, &participantsList)
+			err := tf.DecodeAt(fmt.Sprintf("participant_groups.%d.participants", i), participants, &participantsList)
 			if err != nil {
-				return diag.Errorf(err.Error())
+				return nil, err
 			}
 			participantGroup.Participants = participantsList
 			participantGroupsList = append(participantGroupsList, participantGroup)
 		}
-		updateScheduleRotationReq.ParticipantGroups = participantGroupsList
+		rotation.ParticipantGroups = participantGroupsList
 	}
 
-	shiftTimeSlots := d.Get("shift_timeslots").([]interface{})
+	shiftTimeSlots := mrotation["shift_timeslots"].([]interface{})
 	if len(shiftTimeSlots) > 0 {
-		if updateScheduleRotationReq.Period != "custom" && len(shiftTimeSlots) > 1 {
-			return diag.Errorf("multiple shift_timeslots can only be set when period is custom")
+		if rotation.Period != "custom" && len(shiftTimeSlots) > 1 {
+			return nil, fmt.Errorf("multiple shift_timeslots can only be set when period is custom")
 		}
 		var shiftTimeSlotsList []api.Timeslot
-		err := Decode(shiftTimeSlots, &shiftTimeSlotsList)
+		err := tf.DecodeAt("shift_timeslots", shiftTimeSlots, &shiftTimeSlotsList)
 		if err != nil {
-			return diag.Errorf("shift_timeslots is invalid")
+			return nil, err
 		}
-		updateScheduleRotationReq.ShiftTimeSlots = shiftTimeSlotsList
+		rotation.ShiftTimeSlots = shiftTimeSlotsList
 	}
 
-	customPeriodFreq, freqIsSet := d.GetOk("custom_period_frequency")
-	customPeriodUnit, unitIsSet := d.GetOk("custom_period_unit")
+	customPeriodFreq := mrotation["custom_period_frequency"].(int)
+	customPeriodUnit := mrotation["custom_period_unit"].(string)
 
-	if updateScheduleRotationReq.Period == "custom" {
-		if !freqIsSet || customPeriodFreq.(int) == 0 {
-			return diag.Errorf("custom_period_frequency must be set when period is custom")
+	if rotation.Period == "custom" {
+		if customPeriodFreq == 0 {
+			return nil, fmt.Errorf("custom_period_frequency must be set when period is custom")
 		}
-		if !unitIsSet || customPeriodUnit.(string) == "" {
-			return diag.Errorf("custom_period_unit must be set when period is custom")
+		if customPeriodUnit == "" {
+			return nil, fmt.Errorf("custom_period_unit must be set when period is custom")
 		}
 
-		updateScheduleRotationReq.CustomPeriodFrequency = customPeriodFreq.(int)
-		updateScheduleRotationReq.CustomPeriodUnit = customPeriodUnit.(string)
+		rotation.CustomPeriodFrequency = customPeriodFreq
+		rotation.CustomPeriodUnit = customPeriodUnit
 	} else {
-		if freqIsSet {
-			return diag.Errorf("custom_period_frequency can only be set when period is custom")
+		if customPeriodFreq != 0 {
+			return nil, fmt.Errorf("custom_period_frequency can only be set when period is custom")
 		}
-		if unitIsSet {
-			return diag.Errorf("custom_period_unit can only be set when period is custom")
+		if customPeriodUnit != "" {
+			return nil, fmt.Errorf("custom_period_unit can only be set when period is custom")
 		}
 	}
 
-	_, err = client.UpdateScheduleRotation(ctx, id, updateScheduleRotationReq)
+	return rotation, nil
+}
+
+func resourceScheduleRotationV2Fields(d *schema.ResourceData) tf.M {
+	mrotation := tf.M{}
+	for k := range resourceScheduleRotationV2Schema() {
+		mrotation[k] = d.Get(k)
+	}
+
+	return mrotation
+}
+
+// resolveScheduleRotationV2Refs returns the attributes of the rotation with the ids of the participants configured by
+// name, the schedule is only looked up for the team of the squads when a participant is configured by name.
+func resolveScheduleRotationV2Refs(ctx context.Context, client *api.Client, d *schema.ResourceData) (tf.M, error) {
+	mrotation := resourceScheduleRotationV2Fields(d)
+	if !hasEntityRefsByName(d, mrotation, "participant_groups.*.participants") {
+		return mrotation, nil
+	}
+
+	schedule, err := client.GetScheduleV2ById(ctx, d.Get("schedule_id").(string))
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveEntityRefs(ctx, client, d, schedule.TeamID, mrotation, "participant_groups.*.participants"); err != nil {
+		return nil, err
+	}
+
+	return mrotation, nil
+}
+
+func resourceScheduleRotationV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating rotation", tf.M{
+		"name": d.Get("name").(string),
+	})
+
+	mrotation, err := resolveScheduleRotationV2Refs(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	createScheduleRotationReq, err := expandScheduleRotation(mrotation)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	scheduleID, err := strconv.Atoi(d.Get("schedule_id").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rotation, err := client.CreateScheduleRotation(ctx, scheduleID, *createScheduleRotationReq)
+	if err != nil {
+		return diagFromErr(withTeamParticipantField(err, rotationTeamParticipants("", mrotation)))
+	}
+
+	d.SetId(strconv.Itoa(rotation.NewRotation.ID))
+
+	return readAfterCreate(ctx, d, meta, resourceScheduleRotationV2Read)
+}
+
+func resourceScheduleRotationV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating rotation", tf.M{
+		"name": d.Get("name").(string),
+	})
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	mrotation, err := resolveScheduleRotationV2Refs(ctx, client, d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	updateScheduleRotationReq, err := expandScheduleRotation(mrotation)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateScheduleRotation(ctx, id, *updateScheduleRotationReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(withTeamParticipantField(err, rotationTeamParticipants("", mrotation)))
 	}
 
 	return resourceScheduleRotationV2Read(ctx, d, meta)
@@ -406,7 +848,7 @@ func resourceScheduleRotationV2Delete(ctx context.Context, d *schema.ResourceDat
 			return nil
 		}
 		tflog.Info(ctx, "random err found while deleting rotation")
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, "No err while deleting rotation")
@@ -3,22 +3,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hasura/go-graphql-client"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 func TestAccResourceScheduleRotation(t *testing.T) {
-	rotationName := acctest.RandomWithPrefix("schedule_rotation_v2")
+	rotationName := testAccName("schedule_rotation_v2")
 
 	resourceName := "squadcast_schedule_rotation_v2.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleRotationDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleRotationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleRotationConfig(rotationName),
@@ -36,6 +43,7 @@ func TestAccResourceScheduleRotation(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "participant_groups.0.participants.0.type", "team"),
 					resource.TestCheckResourceAttr(resourceName, "participant_groups.0.participants.0.id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "ends_after_iterations", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "color"),
 				),
 			},
 			{
@@ -61,6 +69,7 @@ func TestAccResourceScheduleRotation(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "participant_groups.0.participants.0.type", "team"),
 					resource.TestCheckResourceAttr(resourceName, "participant_groups.0.participants.0.id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "end_date", "2023-08-31T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "color", "#0f61dd"),
 				),
 			},
 			{
@@ -73,6 +82,351 @@ func TestAccResourceScheduleRotation(t *testing.T) {
 	})
 }
 
+func TestAccResourceScheduleRotationRecreatedUpstream(t *testing.T) {
+	rotationName := testAccName("schedule_rotation_v2")
+
+	var oldID string
+	resourceName := "squadcast_schedule_rotation_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScheduleRotationConfig(rotationName),
+				Check: resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+					oldID = value
+					return nil
+				}),
+			},
+			{
+				// Recreate the rotation behind Terraform's back, as a rebuild of the schedule does.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*api.Client)
+
+					rotation, err := client.GetScheduleRotationById(context.Background(), oldID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if _, err = client.DeleteScheduleRotationByID(context.Background(), oldID); err != nil {
+						t.Fatal(err)
+					}
+					rotation.NewRotation.ID = 0
+					if _, err = client.CreateScheduleRotation(context.Background(), 100, rotation.NewRotation); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceScheduleRotationConfig(rotationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value == oldID {
+							return fmt.Errorf("expected the recreated rotation to be adopted, still %s", oldID)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rotationName),
+				),
+			},
+		},
+	})
+}
+
+// testAccRotationCase is a permutation of the period, the participant change unit and the end condition of a rotation.
+type testAccRotationCase struct {
+	period                 string
+	customPeriodUnit       string
+	changeParticipantsUnit string
+	end                    string
+}
+
+func (c testAccRotationCase) String() string {
+	period := c.period
+	if c.customPeriodUnit != "" {
+		period += "-" + c.customPeriodUnit
+	}
+
+	return fmt.Sprintf("%s/%s/%s", period, c.changeParticipantsUnit, c.end)
+}
+
+// testAccRotationCases returns every period type, including both units of custom periods, with every participant
+// change unit and every end condition.
+func testAccRotationCases() []testAccRotationCase {
+	var cases []testAccRotationCase
+	for _, period := range [][2]string{{"none", ""}, {"daily", ""}, {"weekly", ""}, {"monthly", ""}, {"custom", "day"}, {"custom", "week"}} {
+		for _, unit := range []string{"rotation", "day", "week", "month"} {
+			for _, end := range []string{"never", "end_date", "ends_after_iterations"} {
+				cases = append(cases, testAccRotationCase{period: period[0], customPeriodUnit: period[1], changeParticipantsUnit: unit, end: end})
+			}
+		}
+	}
+
+	return cases
+}
+
+// attributes returns the configuration of the rotation of the case.
+func (c testAccRotationCase) attributes(rotationName string) map[string]any {
+	attributes := map[string]any{
+		"schedule_id": "100",
+		"name":        rotationName,
+		"start_date":  "2023-07-01T00:00:00Z",
+		"period":      c.period,
+		"shift_timeslots": []any{
+			map[string]any{"start_hour": 9, "start_minute": 0, "duration": 480},
+		},
+		"change_participants_frequency": 1,
+		"change_participants_unit":      c.changeParticipantsUnit,
+		"participant_groups":            testAccScheduleRotationTeamParticipant,
+	}
+	if c.period == "custom" {
+		attributes["custom_period_frequency"] = 2
+		attributes["custom_period_unit"] = c.customPeriodUnit
+		attributes["shift_timeslots"] = []any{
+			map[string]any{"start_hour": 9, "start_minute": 0, "duration": 480, "day_of_week": "monday"},
+			map[string]any{"start_hour": 9, "start_minute": 0, "duration": 480, "day_of_week": "thursday"},
+		}
+	}
+	switch c.end {
+	case "never":
+		attributes["ends_never"] = true
+	case "end_date":
+		attributes["end_date"] = "2023-12-31T00:00:00Z"
+	case "ends_after_iterations":
+		attributes["ends_after_iterations"] = 3
+	}
+
+	return attributes
+}
+
+func (c testAccRotationCase) checks(resourceName, rotationName string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet(resourceName, "id"),
+		resource.TestCheckResourceAttr(resourceName, "name", rotationName),
+		resource.TestCheckResourceAttr(resourceName, "period", c.period),
+		resource.TestCheckResourceAttr(resourceName, "change_participants_unit", c.changeParticipantsUnit),
+		resource.TestCheckResourceAttr(resourceName, "custom_period_unit", c.customPeriodUnit),
+	}
+	if c.period == "custom" {
+		checks = append(checks,
+			resource.TestCheckResourceAttr(resourceName, "custom_period_frequency", "2"),
+			resource.TestCheckResourceAttr(resourceName, "shift_timeslots.#", "2"),
+		)
+	}
+	switch c.end {
+	case "never":
+		checks = append(checks,
+			resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+			resource.TestCheckResourceAttr(resourceName, "ends_after_iterations", "0"),
+			resource.TestCheckResourceAttr(resourceName, "ends_never", "true"),
+		)
+	case "end_date":
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, "end_date", "2023-12-31T00:00:00Z"))
+	case "ends_after_iterations":
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, "ends_after_iterations", "3"))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+// testAccScheduleRotationImportID returns the import id of the rotation by the ids of its schedule and of itself.
+func testAccScheduleRotationImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", resourceName)
+		}
+
+		return "613611c1eb22db455cfa789f:" + rs.Primary.Attributes["schedule_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+// TestScheduleRotationCases makes sure the configurations of the acceptance test matrix are valid, so that its
+// failures are those of the API.
+func TestScheduleRotationCases(t *testing.T) {
+	r := resourceScheduleRotationV2()
+	for _, c := range testAccRotationCases() {
+		attributes := c.attributes("test")
+		if diags := r.Validate(terraform.NewResourceConfigRaw(attributes)); diags.HasError() {
+			t.Errorf("%s: invalid configuration: %v", c, diags)
+			continue
+		}
+
+		d := schema.TestResourceDataRaw(t, r.Schema, attributes)
+		if _, err := expandScheduleRotation(resourceScheduleRotationV2Fields(d)); err != nil {
+			t.Errorf("%s: %s", c, err)
+		}
+		if config := testAccResourceConfig("squadcast_schedule_rotation_v2", "test", attributes); !strings.Contains(config, fmt.Sprintf("change_participants_unit = %q", c.changeParticipantsUnit)) {
+			t.Errorf("%s: unexpected configuration %s", c, config)
+		}
+	}
+}
+
+func TestScheduleRotationEndConflicts(t *testing.T) {
+	r := resourceScheduleRotationV2()
+	ends := map[string]any{
+		"end_date":              "2023-12-31T00:00:00Z",
+		"ends_after_iterations": 3,
+		"ends_never":            true,
+	}
+	for a, va := range ends {
+		for b, vb := range ends {
+			if a >= b {
+				continue
+			}
+			attributes := testAccRotationCase{period: "daily", changeParticipantsUnit: "rotation"}.attributes("test")
+			attributes[a] = va
+			attributes[b] = vb
+			if diags := r.Validate(terraform.NewResourceConfigRaw(attributes)); !diags.HasError() {
+				t.Errorf("%s and %s: expected a conflict", a, b)
+			}
+			if err := validateRotationEnd(attributes); err == nil {
+				t.Errorf("%s and %s: expected the inline rotation to be invalid", a, b)
+			}
+		}
+	}
+}
+
+func TestFlattenRotationEndsNever(t *testing.T) {
+	for _, c := range []struct {
+		m         tf.M
+		endsNever bool
+		expected  bool
+	}{
+		{tf.M{"end_date": "", "ends_after_iterations": 0}, true, true},
+		{tf.M{"end_date": "", "ends_after_iterations": 0}, false, false},
+		{tf.M{"end_date": "2023-12-31T00:00:00Z", "ends_after_iterations": 0}, true, false},
+		{tf.M{"end_date": "", "ends_after_iterations": 3}, true, false},
+	} {
+		flattenRotationEndsNever(c.m, c.endsNever)
+		if c.m["ends_never"] != c.expected {
+			t.Errorf("%v with ends_never %t: expected %t, got %v", c.m, c.endsNever, c.expected, c.m["ends_never"])
+		}
+	}
+}
+
+func TestAccResourceScheduleRotation_permutations(t *testing.T) {
+	resourceName := "squadcast_schedule_rotation_v2.test"
+	for _, c := range testAccRotationCases() {
+		c := c
+		t.Run(c.String(), func(t *testing.T) {
+			rotationName := testAccName("schedule_rotation_v2")
+			config := testAccResourceConfig("squadcast_schedule_rotation_v2", "test", c.attributes(rotationName))
+
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: protoV6ProviderFactories,
+				CheckDestroy:             testAccCheckScheduleRotationDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check:  c.checks(resourceName, rotationName),
+					},
+					{
+						ResourceName:      resourceName,
+						ImportState:       true,
+						ImportStateVerify: true,
+						ImportStateIdFunc: testAccScheduleRotationImportID(resourceName),
+						// ends_never is not returned by the API, it is false when the rotation is imported.
+						ImportStateVerifyIgnore: []string{"ends_never"},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceScheduleRotation_disappears(t *testing.T) {
+	rotationName := testAccName("schedule_rotation_v2")
+
+	resourceName := "squadcast_schedule_rotation_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScheduleRotationConfig(rotationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					testAccCheckScheduleRotationDisappears(resourceName),
+				),
+				// The rotation is created again by the next apply.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckScheduleRotationDisappears deletes the rotation behind Terraform's back.
+func testAccCheckScheduleRotationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		_, err := client.DeleteScheduleRotationByID(context.Background(), rs.Primary.ID)
+
+		return err
+	}
+}
+
+func TestResourceScheduleRotationV2StateUpgradeV0(t *testing.T) {
+	rawState := map[string]any{
+		"id":          "123",
+		"schedule_id": float64(100),
+	}
+
+	actual, err := resourceScheduleRotationV2StateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if actual["schedule_id"] != "100" {
+		t.Fatalf("expected schedule_id to be \"100\", got %#v", actual["schedule_id"])
+	}
+
+	// The schema of the version 0 is frozen, the attributes added since must not be part of it.
+	ty := resourceScheduleRotationV2V0().CoreConfigSchema().ImpliedType()
+	if !ty.AttributeType("schedule_id").Equals(cty.Number) || ty.HasAttribute("color") {
+		t.Errorf("unexpected schema of the version 0: %#v", ty)
+	}
+}
+
+func TestNormalizeRotationDates(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := tf.M{
+		"start_date": "2023-06-30T18:30:00Z",
+		"end_date":   "2023-08-31T00:00:00Z",
+	}
+	prior := map[string]any{
+		"start_date": "2023-07-01T00:00:00+05:30",
+		"end_date":   "",
+	}
+	if !rotationDatesChanged(m, prior) {
+		t.Fatal("expected the end date to be reported as changed")
+	}
+
+	normalizeRotationDates(m, prior, loc)
+	if m["start_date"] != "2023-07-01T00:00:00+05:30" {
+		t.Errorf("expected the configured start date to be kept, got %q", m["start_date"])
+	}
+	if m["end_date"] != "2023-08-31T05:30:00+05:30" {
+		t.Errorf("expected the end date in the schedule timezone, got %q", m["end_date"])
+	}
+
+	if !suppressEquivalentRotationDate("start_date", "2023-06-30T18:30:00Z", "2023-07-01T00:00:00+05:30", nil) {
+		t.Error("expected the diff between equivalent instants to be suppressed")
+	}
+	if suppressEquivalentRotationDate("start_date", "2023-07-01T00:00:00Z", "2023-07-01T00:00:00+05:30", nil) {
+		t.Error("expected the diff between different instants not to be suppressed")
+	}
+}
+
 func testAccCheckScheduleRotationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -94,61 +448,186 @@ func testAccCheckScheduleRotationDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccScheduleRotationTeamParticipant is a participant group with the team of schedule 100.
+var testAccScheduleRotationTeamParticipant = []any{
+	map[string]any{
+		"participants": []any{
+			map[string]any{"id": "613611c1eb22db455cfa789f", "type": "team"},
+		},
+	},
+}
+
 func testAccResourceScheduleRotationConfig(rotationName string) string {
-	return fmt.Sprintf(`
-		resource "squadcast_schedule_rotation_v2" "test" {
-			schedule_id = "100"
-			name = "%s"
-			start_date = "2023-07-01T00:00:00Z"
-			period = "weekly"
-			shift_timeslots {
-				start_hour = 10
-				start_minute = 30
-				duration = 720
-			}
-			change_participants_frequency = 1
-			change_participants_unit = "rotation"
-			participant_groups {
-				participants {
-					id = "613611c1eb22db455cfa789f"
-					type = "team"
-				}
-			}
-			ends_after_iterations = 2
-		}
-	`, rotationName)
+	return testAccResourceConfig("squadcast_schedule_rotation_v2", "test", map[string]any{
+		"schedule_id": "100",
+		"name":        rotationName,
+		"start_date":  "2023-07-01T00:00:00Z",
+		"period":      "weekly",
+		"shift_timeslots": []any{
+			map[string]any{"start_hour": 10, "start_minute": 30, "duration": 720},
+		},
+		"change_participants_frequency": 1,
+		"change_participants_unit":      "rotation",
+		"participant_groups":            testAccScheduleRotationTeamParticipant,
+		"ends_after_iterations":         2,
+	})
 }
 
 func testAccResourceScheduleRotationConfig_update(rotationName string) string {
-	return fmt.Sprintf(`
-		resource "squadcast_schedule_rotation_v2" "test" {
-			schedule_id = "100"
-			name = "%s"
-			start_date = "2023-06-13T00:00:00Z"
-			period = "custom"
-			shift_timeslots {
-				start_hour = 10
-				start_minute = 0
-				duration = 1440
-				day_of_week = "saturday"
-			}
-			shift_timeslots {
-				start_hour = 12
-				start_minute = 30
-				duration = 720
-				day_of_week = "sunday"
+	return testAccResourceConfig("squadcast_schedule_rotation_v2", "test", map[string]any{
+		"schedule_id": "100",
+		"name":        rotationName,
+		"start_date":  "2023-06-13T00:00:00Z",
+		"period":      "custom",
+		"shift_timeslots": []any{
+			map[string]any{"start_hour": 10, "start_minute": 0, "duration": 1440, "day_of_week": "saturday"},
+			map[string]any{"start_hour": 12, "start_minute": 30, "duration": 720, "day_of_week": "sunday"},
+		},
+		"change_participants_frequency": 1,
+		"change_participants_unit":      "rotation",
+		"custom_period_frequency":       1,
+		"custom_period_unit":            "week",
+		"participant_groups":            testAccScheduleRotationTeamParticipant,
+		"end_date":                      "2023-08-31T00:00:00Z",
+		"color":                         "#0f61dd",
+	})
+}
+
+func TestRotationTeamParticipants(t *testing.T) {
+	rotation := map[string]any{
+		"participant_groups": []any{
+			map[string]any{"participants": []any{
+				map[string]any{"id": "5f8891527f735f0a6646f3b6", "type": "user"},
+			}},
+			map[string]any{"participants": []any{
+				map[string]any{"id": "63bfabae865e9c93cd31756e", "type": "squad"},
+				map[string]any{"id": "613611c1eb22db455cfa789f", "type": "team"},
+			}},
+		},
+	}
+
+	paths := rotationTeamParticipants("rotations.1", rotation)
+	if len(paths) != 1 || paths[0] != "rotations.1.participant_groups.1.participants.1" {
+		t.Errorf("unexpected paths %v", paths)
+	}
+	if paths := scheduleV2TeamParticipants([]any{map[string]any{}, rotation}); len(paths) != 1 || paths[0] != "rotations.1.participant_groups.1.participants.1" {
+		t.Errorf("unexpected paths of the inline rotations %v", paths)
+	}
+}
+
+func TestValidateTeamParticipants(t *testing.T) {
+	for features, valid := range map[string]bool{
+		`{}`:                                   true,
+		`{"schedule_team_participants":true}`:  true,
+		`{"schedule_team_participants":false}`: false,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v3/organization/plan" {
+				t.Errorf("unexpected request %s", r.URL)
 			}
-			change_participants_frequency = 1
-			change_participants_unit = "rotation"
-			custom_period_frequency = 1
-			custom_period_unit = "week"
-			participant_groups {
-				participants {
-					id = "613611c1eb22db455cfa789f"
-					type = "team"
-				}
+			w.Write([]byte(`{"data":{"plan_name":"free","features":` + features + `}}`))
+		}))
+
+		client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+		customizeDiff := validateTeamParticipants(func(d *schema.ResourceDiff) []string {
+			return []string{"participant_groups.0.participants.1"}
+		})
+		err := customizeDiff(context.Background(), nil, client)
+		switch {
+		case valid && err != nil:
+			t.Errorf("%s: unexpected error %s", features, err)
+		case !valid && (err == nil || !strings.HasPrefix(err.Error(), "participant_groups.0.participants.1: ")):
+			t.Errorf("%s: expected an error about the team participant, got %v", features, err)
+		}
+
+		server.Close()
+	}
+}
+
+func TestWithTeamParticipantField(t *testing.T) {
+	err := &api.GraphQLErrors{Operation: "mutate", Errors: []*api.GraphQLError{
+		{Message: "Team participants are not allowed in this schedule", Code: "BAD_USER_INPUT"},
+		{Message: "duration must be at least 30 minutes", Field: "shiftTimeSlots[0].duration"},
+		{Message: "internal error"},
+	}}
+
+	diags := diagFromErr(withGraphQLFieldPrefix(withTeamParticipantField(err, []string{"participant_groups.1.participants.0"}), "rotations[2]"))
+	if len(diags) != 3 {
+		t.Fatalf("expected a diagnostic per error, got %#v", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("rotations").IndexInt(2).GetAttr("participant_groups").IndexInt(1).GetAttr("participants").IndexInt(0).GetAttr("type")) {
+		t.Errorf("expected the type of the team participant, got %#v", diags[0].AttributePath)
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("rotations").IndexInt(2).GetAttr("shift_timeslots").IndexInt(0).GetAttr("duration")) {
+		t.Errorf("expected the field reported by the API to be kept, got %#v", diags[1].AttributePath)
+	}
+	if diags[2].AttributePath != nil {
+		t.Errorf("expected the other errors not to be scoped, got %#v", diags[2].AttributePath)
+	}
+	if withTeamParticipantField(err, nil) != error(err) {
+		t.Errorf("expected the errors to be unchanged without team participants")
+	}
+}
+
+func TestResourceScheduleRotationV2Read_errors(t *testing.T) {
+	for name, c := range map[string]struct {
+		responses []string
+		requests  int
+	}{
+		// A transient error is returned without looking the rotation up by name.
+		"transient error": {responses: []string{""}, requests: 1},
+		// The rotation is not found, and looking it up by name fails.
+		"lookup error": {responses: []string{`{"data":null,"errors":[{"message":"rotation not found","extensions":{"code":"NOT_FOUND"}}]}`, ""}, requests: 2},
+	} {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			response := c.responses[requests]
+			requests++
+			if response == "" {
+				w.WriteHeader(http.StatusBadGateway)
+				return
 			}
-			end_date =  "2023-08-31T00:00:00Z"
+			w.Write([]byte(response))
+		}))
+
+		client := &api.Client{GraphQLClient: graphql.NewClient(server.URL, nil), ServiceAccountToken: "token"}
+		d := schema.TestResourceDataRaw(t, resourceScheduleRotationV2().Schema, map[string]any{"schedule_id": "100", "name": "primary"})
+		d.SetId("123")
+
+		diags := resourceScheduleRotationV2Read(context.Background(), d, client)
+		server.Close()
+		if !diags.HasError() {
+			t.Errorf("%s: expected an error", name)
 		}
-	`, rotationName)
+		if d.Id() != "123" {
+			t.Errorf("%s: expected the rotation to be kept in the state, got %q", name, d.Id())
+		}
+		if requests != c.requests {
+			t.Errorf("%s: expected %d requests, got %d", name, c.requests, requests)
+		}
+	}
+}
+
+func TestResourceScheduleRotationV2Read_scheduleDeleted(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// Neither the rotation nor its schedule are found.
+		w.Write([]byte(`{"data":null,"errors":[{"message":"not found","extensions":{"code":"NOT_FOUND"}}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{GraphQLClient: graphql.NewClient(server.URL, nil), ServiceAccountToken: "token"}
+	d := schema.TestResourceDataRaw(t, resourceScheduleRotationV2().Schema, map[string]any{"schedule_id": "100", "name": "primary"})
+	d.SetId("123")
+
+	if diags := resourceScheduleRotationV2Read(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the rotation to be removed from the state, got %q", d.Id())
+	}
+	if requests != 2 {
+		t.Errorf("expected the rotation to be looked up by name in its schedule, got %d requests", requests)
+	}
 }
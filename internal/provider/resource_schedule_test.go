@@ -3,22 +3,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 func TestAccResourceSchedule(t *testing.T) {
-	scheduleName := acctest.RandomWithPrefix("schedule")
+	scheduleName := testAccName("schedule")
 
 	resourceName := "squadcast_schedule.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleConfig(scheduleName),
@@ -28,6 +30,7 @@ func TestAccResourceSchedule(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", scheduleName),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					resource.TestCheckResourceAttr(resourceName, "color", "#9900ef"),
+					resource.TestCheckResourceAttrSet(resourceName, "slug"),
 				),
 			},
 			{
@@ -46,10 +49,56 @@ func TestAccResourceSchedule(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateId:     "613611c1eb22db455cfa789f:" + scheduleName,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					slug, err := tf.StateAttr(s, "squadcast_schedule", "slug")
+					if err != nil {
+						return "", err
+					}
+
+					return "613611c1eb22db455cfa789f:" + slug, nil
+				},
+			},
 		},
 	})
 }
 
+func TestLookupSchedule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":"1","name":"Primary","slug":"secondary"},{"id":"2","name":"Secondary","slug":"primary"}]}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	for ref, want := range map[string]struct {
+		id       string
+		warnings int
+	}{
+		// The slugs are preferred to the names, they survive renames.
+		"primary":   {"2", 0},
+		"secondary": {"1", 0},
+		"Primary":   {"1", 1},
+	} {
+		schedule, diags, err := lookupSchedule(context.Background(), client, "613611c1eb22db455cfa789f", ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schedule.ID != want.id {
+			t.Errorf("expected schedule %s for %q, got %s", want.id, ref, schedule.ID)
+		}
+		if len(diags) != want.warnings || diags.HasError() {
+			t.Errorf("expected %d warnings for %q, got %v", want.warnings, ref, diags)
+		}
+	}
+
+	if _, _, err := lookupSchedule(context.Background(), client, "613611c1eb22db455cfa789f", "tertiary"); err == nil {
+		t.Error("expected an error for an unknown schedule")
+	}
+}
+
 func testAccCheckScheduleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -15,7 +21,8 @@ import (
 
 func resourceScheduleV2() *schema.Resource {
 	return &schema.Resource{
-		Description: "[Squadcast schedules v2](https://support.squadcast.com/docs/schedules-new) are used to manage on-call scheduling & determine who will be notified when an incident is triggered.",
+		Description: "[Squadcast schedules v2](https://support.squadcast.com/docs/schedules-new) are used to manage on-call scheduling & determine who will be notified when an incident is triggered." +
+			" Rotations can either be managed inline with the `rotations` block or with the `squadcast_schedule_rotation_v2` resource, but not both for the same schedule.",
 
 		ReadContext:   resourceScheduleV2Read,
 		CreateContext: resourceScheduleV2Create,
@@ -24,79 +31,327 @@ func resourceScheduleV2() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScheduleV2Import,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			validateEntityRefs("entity_owner", "rotations.*.participant_groups.*.participants"),
+			validateReferences(scheduleV2References),
+			scheduleV2RotationsCustomizeDiff,
+			validateTeamParticipants(func(d *schema.ResourceDiff) []string {
+				return scheduleV2TeamParticipants(d.Get("rotations").([]any))
+			}),
+			scheduleV2CoverageCustomizeDiff,
+		),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceScheduleV2V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceScheduleV2StateUpgradeV0,
+			},
+		},
+		Schema: resourceScheduleV2Schema(),
+	}
+}
 
+func resourceScheduleV2Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "Schedule id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"name": {
+			Description:  "Name of the schedule.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 150),
+		},
+		"description": {
+			Description:  "Detailed description about the schedule.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 1000),
+		},
+		"timezone": {
+			Description:           "Timezone for the schedule. Names of the same timezone, e.g. Asia/Kolkata and Asia/Calcutta, are not reported as changes.",
+			Type:                  schema.TypeString,
+			Required:              true,
+			DiffSuppressFunc:      tf.SuppressEquivalentTimezones,
+			DiffSuppressOnRefresh: true,
+		},
+		"entity_owner": {
+			Description: "Schedule owner.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: entityRefSchema("Schedule owner", []string{"user", "squad", "team"}, tf.ValidateObjectID),
+			},
+		},
+		"tags": {
+			Description: "Schedule tags, in any order. A tag is identified by its key and value, its color can be changed in place.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         hashTag,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description: "Schedule tag key.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"value": {
+						Description: "Schedule tag value.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"color": {
+						Description:      "Schedule tag color, hex value (e.g. `#0f61dd`). Defaults to the color assigned by Squadcast, the case of the hex digits is ignored.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Computed:         true,
+						ValidateFunc:     validation.StringMatch(tagColorRegexp, "must be a hex color code, e.g. #0f61dd"),
+						DiffSuppressFunc: tf.SuppressCaseDifferences,
+					},
+				},
+			},
+		},
+		"rotations": {
+			Description: "Rotations of the schedule, managed inline. Rotations are matched by name, so renaming a rotation replaces it. When no rotations are set, the rotations of the schedule are left to `squadcast_schedule_rotation_v2`.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: resourceScheduleV2RotationSchema(),
+			},
+		},
+		"coverage_check": {
+			Description: "Checks of the coverage of the inline `rotations` during plan. A week of the shift timeslots of the rotations is simulated, timeslots without a day of week being repeated every day, " +
+				"to find the gaps no rotation is on-call in and the overlaps of two or more rotations. The start and end dates of the rotations are not taken into account. " +
+				"Findings set to `error` fail the plan, findings set to `warn` are logged during plan and reported as warnings on apply.",
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"gaps": {
+						Description:  "How the gaps in the coverage are reported. (off, warn, error) Defaults to `warn`.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "warn",
+						ValidateFunc: validation.StringInSlice([]string{"off", "warn", "error"}, false),
+					},
+					"overlaps": {
+						Description:  "How the overlaps of the rotations are reported. (off, warn, error) Defaults to `warn`.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "warn",
+						ValidateFunc: validation.StringInSlice([]string{"off", "warn", "error"}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceScheduleV2V0 is the schema before the tags were changed from a list to a set. It is a frozen copy of the schema
+// of that version, later changes to the schema must not be made here.
+func resourceScheduleV2V0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "Schedule id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"team_id": {
-				Description:  "Team id.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: tf.ValidateObjectID,
-				ForceNew:     true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"name": {
-				Description:  "Name of the schedule.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 150),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"description": {
-				Description:  "Detailed description about the schedule.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1000),
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"timezone": {
-				Description: "Timezone for the schedule.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"entity_owner": {
-				Description: "Schedule owner.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MaxItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"type": {
-							Description:  "Schedule owner type (user, team, squad).",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
-						},
 						"id": {
-							Description:  "Schedule owner id.",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: tf.ValidateObjectID,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
 						},
 					},
 				},
 			},
 			"tags": {
-				Description: "Schedule tags.",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
-							Description: "Schedule tag key.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"value": {
-							Description: "Schedule tag value.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"color": {
-							Description: "Schedule tag color.",
-							Type:        schema.TypeString,
-							Computed:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"participant_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"participants": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"change_participants_unit": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"change_participants_frequency": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"shift_timeslots": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"start_hour": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"start_minute": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"duration": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"custom_period_frequency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"custom_period_unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ends_after_iterations": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"period": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"coverage_check": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gaps": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"overlaps": {
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 					},
 				},
@@ -105,6 +360,221 @@ func resourceScheduleV2() *schema.Resource {
 	}
 }
 
+func resourceScheduleV2StateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	return upgradeListsToSets(rawState, "tags"), nil
+}
+
+// scheduleV2CoverageDiagnostics simulates a week of the inline rotations and reports their gaps and overlaps as
+// configured by coverage_check.
+func scheduleV2CoverageDiagnostics(mcheck []any, mrotations []any) diag.Diagnostics {
+	if len(mcheck) == 0 || mcheck[0] == nil || len(mrotations) == 0 {
+		return nil
+	}
+	check := mcheck[0].(tf.M)
+
+	rotations, err := expandScheduleV2Rotations(mrotations)
+	if err != nil {
+		// The rotations are validated again when they are applied.
+		return nil
+	}
+	coverage := api.SimulateScheduleWeek(rotations)
+
+	var diags diag.Diagnostics
+	report := func(mode string, summary string, intervals []api.CoverageInterval) {
+		if mode == "off" || len(intervals) == 0 {
+			return
+		}
+		severity := diag.Warning
+		if mode == "error" {
+			severity = diag.Error
+		}
+		periods := make([]string, len(intervals))
+		for i, interval := range intervals {
+			periods[i] = interval.String()
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       summary,
+			Detail:        fmt.Sprintf("In a simulated week of the rotations, in the timezone of the schedule: %s.", strings.Join(periods, "; ")),
+			AttributePath: cty.GetAttrPath("rotations"),
+		})
+	}
+	report(check["gaps"].(string), "No rotation of the schedule is on-call in some periods", coverage.Gaps)
+	report(check["overlaps"].(string), "Rotations of the schedule overlap", coverage.Overlaps)
+
+	return diags
+}
+
+func scheduleV2CoverageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, diagnostic := range scheduleV2CoverageDiagnostics(d.Get("coverage_check").([]any), d.Get("rotations").([]any)) {
+		if diagnostic.Severity == diag.Error {
+			return fmt.Errorf("%s. %s", diagnostic.Summary, diagnostic.Detail)
+		}
+		tflog.Warn(ctx, diagnostic.Summary, tf.M{
+			"detail": diagnostic.Detail,
+		})
+	}
+
+	return nil
+}
+
+// scheduleV2References returns the team, the owner and the participants of the inline rotations of the schedule.
+func scheduleV2References(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error) {
+	teamID := d.Get("team_id").(string)
+	if !d.NewValueKnown("team_id") {
+		teamID = ""
+	}
+
+	refs := []reference{{Attribute: "team_id", Type: "team", ID: teamID}}
+	refs = append(refs, ownerReferences("entity_owner", d.Get("entity_owner").([]any), teamID)...)
+	for i, r := range d.Get("rotations").([]any) {
+		if rotation, ok := r.(map[string]any); ok {
+			refs = append(refs, rotationReferences(fmt.Sprintf("rotations.%d", i), rotation, teamID)...)
+		}
+	}
+
+	return refs, nil
+}
+
+// scheduleV2RotationsCustomizeDiff checks during plan that the inline rotations end in at most one way.
+func scheduleV2RotationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for i, r := range d.Get("rotations").([]any) {
+		if rotation, ok := r.(map[string]any); ok {
+			if err := validateRotationEnd(rotation); err != nil {
+				return fmt.Errorf("rotations.%d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// scheduleV2TeamParticipants returns the paths of the team participants of the inline rotations.
+func scheduleV2TeamParticipants(mrotations []any) []string {
+	var paths []string
+	for i, r := range mrotations {
+		if rotation, ok := r.(map[string]any); ok {
+			paths = append(paths, rotationTeamParticipants(fmt.Sprintf("rotations.%d", i), rotation)...)
+		}
+	}
+
+	return paths
+}
+
+// resourceScheduleV2RotationSchema is the schema of `squadcast_schedule_rotation_v2` without the schedule id, for the inline rotations.
+func resourceScheduleV2RotationSchema() map[string]*schema.Schema {
+	s := resourceScheduleRotationV2Schema()
+	delete(s, "schedule_id")
+	// The conflicts are between the attributes of the resource, those of the inline rotations are checked in
+	// scheduleV2RotationsCustomizeDiff.
+	for _, k := range []string{"end_date", "ends_after_iterations", "ends_never"} {
+		s[k].ConflictsWith = nil
+	}
+
+	return s
+}
+
+func expandScheduleV2Rotations(mrotations []any) ([]api.NewRotation, error) {
+	rotations := make([]api.NewRotation, 0, len(mrotations))
+	names := make(map[string]bool, len(mrotations))
+	for i, mrotation := range mrotations {
+		rotation, err := expandScheduleRotation(mrotation.(tf.M))
+		if err != nil {
+			return nil, fmt.Errorf("rotations.%d: %w", i, err)
+		}
+		if names[rotation.Name] {
+			return nil, fmt.Errorf("rotations.%d: duplicate rotation name `%s`", i, rotation.Name)
+		}
+		names[rotation.Name] = true
+
+		rotations = append(rotations, *rotation)
+	}
+
+	return rotations, nil
+}
+
+// flattenScheduleV2Rotations encodes the rotations of a schedule in the order of the configured rotations,
+// followed by the rotations which are not configured. Their dates are normalized to the timezone of the schedule.
+func flattenScheduleV2Rotations(configured []any, rotations []api.NewRotation, loc *time.Location) ([]any, error) {
+	order := make(map[string]int, len(configured))
+	for i, mrotation := range configured {
+		order[mrotation.(tf.M)["name"].(string)] = i
+	}
+
+	sorted := make([]api.NewRotation, len(rotations))
+	copy(sorted, rotations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		oi, iok := order[sorted[i].Name]
+		oj, jok := order[sorted[j].Name]
+		if iok && jok {
+			return oi < oj
+		}
+		return iok && !jok
+	})
+
+	mrotations := make([]any, 0, len(sorted))
+	for _, rotation := range sorted {
+		mrotation, err := rotation.Encode()
+		if err != nil {
+			return nil, err
+		}
+		var prior tf.M
+		if i, ok := order[rotation.Name]; ok {
+			prior = configured[i].(tf.M)
+		}
+		normalizeRotationDates(mrotation, prior, loc)
+		endsNever, _ := prior["ends_never"].(bool)
+		flattenRotationEndsNever(mrotation, endsNever)
+		mrotations = append(mrotations, mrotation)
+	}
+
+	return mrotations, nil
+}
+
+// updateScheduleV2Rotations applies the changes of the inline rotations, matched by name, with the rotation mutations.
+func updateScheduleV2Rotations(ctx context.Context, client *api.Client, scheduleID int, old, new []any) error {
+	rotations, err := expandScheduleV2Rotations(new)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]string, len(old))
+	for _, mrotation := range old {
+		mrotation := mrotation.(tf.M)
+		ids[mrotation["name"].(string)] = mrotation["id"].(string)
+	}
+
+	for i, rotation := range rotations {
+		id, ok := ids[rotation.Name]
+		delete(ids, rotation.Name)
+		if !ok || id == "" {
+			if _, err := client.CreateScheduleRotation(ctx, scheduleID, rotation); err != nil {
+				return withGraphQLFieldPrefix(withTeamParticipantField(err, rotationTeamParticipants("", new[i].(tf.M))), fmt.Sprintf("rotations[%d]", i))
+			}
+			continue
+		}
+
+		rotationID, err := strconv.Atoi(id)
+		if err != nil {
+			return err
+		}
+		if _, err := client.UpdateScheduleRotation(ctx, rotationID, rotation); err != nil {
+			return withGraphQLFieldPrefix(withTeamParticipantField(err, rotationTeamParticipants("", new[i].(tf.M))), fmt.Sprintf("rotations[%d]", i))
+		}
+	}
+
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, err := client.DeleteScheduleRotationByID(ctx, id); err != nil && !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceScheduleV2Import(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*api.Client)
 	teamID, scheduleName, err := parse2PartImportID(d.Id())
@@ -141,11 +611,37 @@ func resourceScheduleV2Read(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	m, err := schedule.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "entity_owner")
+
+	var rotations []any
+	configured := d.Get("rotations").([]any)
+	if len(configured) > 0 {
+		loc, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return diag.Errorf("invalid timezone %q of schedule %s: %s", schedule.TimeZone, d.Id(), err)
+		}
+		rotations, err = flattenScheduleV2Rotations(configured, schedule.Rotations, loc)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		preserveEntityRefNames(d, tf.M{"rotations": rotations}, "rotations.*.participant_groups.*.participants")
+		setEntityRefDisplayNames(ctx, client, schedule.TeamID, tf.M{"rotations": rotations}, "rotations.*.participant_groups.*.participants")
 	}
 
-	if err = tf.EncodeAndSet(schedule, d); err != nil {
-		return diag.FromErr(err)
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
+	}
+	if len(configured) > 0 {
+		if err = d.Set("rotations", rotations); err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	return nil
@@ -165,7 +661,7 @@ func resourceScheduleV2Create(ctx context.Context, d *schema.ResourceData, meta
 		TeamID:      d.Get("team_id").(string),
 	}
 
-	tags := d.Get("tags").([]interface{})
+	tags := d.Get("tags").(*schema.Set).List()
 	if len(tags) > 0 {
 		var tagsList []*api.Tag
 		err := Decode(tags, &tagsList)
@@ -176,6 +672,11 @@ func resourceScheduleV2Create(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	entityOwner := d.Get("entity_owner").([]interface{})
+	mrotations := d.Get("rotations").([]any)
+	err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"entity_owner": entityOwner, "rotations": mrotations}, "entity_owner", "rotations.*.participant_groups.*.participants")
+	if err != nil {
+		return diagFromErr(err)
+	}
 	if len(entityOwner) > 0 {
 		entityOwnerMap, ok := entityOwner[0].(map[string]interface{})
 		if !ok {
@@ -187,14 +688,30 @@ func resourceScheduleV2Create(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	rotations, err := expandScheduleV2Rotations(mrotations)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	createScheduleReq.Rotations = rotations
+
 	schedule, err := client.CreateScheduleV2(ctx, createScheduleReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(withTeamParticipantField(err, scheduleV2TeamParticipants(mrotations)))
 	}
 
 	d.SetId(strconv.Itoa(schedule.NewSchedule.ID))
 
-	return resourceScheduleV2Read(ctx, d, meta)
+	// The rotations of a schedule are created asynchronously, the schedule is not found until they are all created.
+	_, err = api.WaitForFound(ctx, d.Timeout(schema.TimeoutCreate), func(ctx context.Context) (*api.ScheduleQueryStruct, error) {
+		return client.GetScheduleV2ById(ctx, d.Id())
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	diags := scheduleV2CoverageDiagnostics(d.Get("coverage_check").([]any), mrotations)
+
+	return append(diags, readAfterCreate(ctx, d, meta, resourceScheduleV2Read)...)
 }
 
 func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -205,7 +722,7 @@ func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta
 	})
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	updateScheduleReq := api.UpdateSchedule{
 		Name:        d.Get("name").(string),
@@ -213,7 +730,7 @@ func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta
 		TimeZone:    d.Get("timezone").(string),
 	}
 
-	tags := d.Get("tags").([]interface{})
+	tags := d.Get("tags").(*schema.Set).List()
 	if len(tags) > 0 {
 		var tagsList []*api.Tag
 		err := Decode(tags, &tagsList)
@@ -224,6 +741,11 @@ func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	entityOwner := d.Get("entity_owner").([]interface{})
+	old, new := d.GetChange("rotations")
+	err = resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"entity_owner": entityOwner, "rotations": new}, "entity_owner", "rotations.*.participant_groups.*.participants")
+	if err != nil {
+		return diagFromErr(err)
+	}
 	if len(entityOwner) > 0 {
 		entityOwnerMap, ok := entityOwner[0].(map[string]interface{})
 		if !ok {
@@ -237,9 +759,18 @@ func resourceScheduleV2Update(ctx context.Context, d *schema.ResourceData, meta
 
 	_, err = client.UpdateScheduleV2(ctx, id, updateScheduleReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	return resourceScheduleV2Read(ctx, d, meta)
+
+	if d.HasChange("rotations") {
+		if err = updateScheduleV2Rotations(ctx, client, id, old.([]any), new.([]any)); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	diags := scheduleV2CoverageDiagnostics(d.Get("coverage_check").([]any), new.([]any))
+
+	return append(diags, resourceScheduleV2Read(ctx, d, meta)...)
 }
 
 func resourceScheduleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -254,7 +785,7 @@ func resourceScheduleV2Delete(ctx context.Context, d *schema.ResourceData, meta
 			return nil
 		}
 		tflog.Info(ctx, "random err found while deleting schedule")
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, "No err while deleting schedule")
@@ -3,22 +3,107 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
+func TestScheduleV2CoverageDiagnostics(t *testing.T) {
+	rotation := func(name string, timeslots ...tf.M) tf.M {
+		mtimeslots := make([]any, len(timeslots))
+		for i, timeslot := range timeslots {
+			mtimeslots[i] = timeslot
+		}
+		return tf.M{
+			"name":                          name,
+			"start_date":                    "2032-06-01T00:00:00Z",
+			"period":                        "custom",
+			"custom_period_frequency":       1,
+			"custom_period_unit":            "week",
+			"shift_timeslots":               mtimeslots,
+			"change_participants_frequency": 1,
+			"change_participants_unit":      "rotation",
+			"ends_after_iterations":         0,
+			"end_date":                      "",
+			"color":                         "",
+			"participant_groups":            []any{tf.M{"participants": []any{tf.M{"type": "user", "id": "5f8891527f735f0a6646f3b6"}}}},
+		}
+	}
+	timeslot := func(day string, hour int, duration int) tf.M {
+		return tf.M{"day_of_week": day, "start_hour": hour, "start_minute": 0, "duration": duration}
+	}
+
+	rotations := []any{
+		// Every day from 08:00 to 20:00.
+		rotation("day", timeslot("", 8, 720)),
+		// Every night from 20:00 to 08:00, but on the weekend.
+		rotation("night",
+			timeslot("monday", 20, 720), timeslot("tuesday", 20, 720), timeslot("wednesday", 20, 720),
+			timeslot("thursday", 20, 720), timeslot("friday", 19, 780),
+		),
+	}
+
+	cases := map[string]struct {
+		check []any
+		want  []string
+	}{
+		"unchecked": {},
+		"off":       {check: []any{tf.M{"gaps": "off", "overlaps": "off"}}},
+		"gaps": {
+			check: []any{tf.M{"gaps": "error", "overlaps": "off"}},
+			want:  []string{"error: No rotation .* saturday 20:00 - sunday 08:00; sunday 20:00 - monday 08:00\\."},
+		},
+		"all": {
+			check: []any{tf.M{"gaps": "warn", "overlaps": "warn"}},
+			want: []string{
+				"warning: No rotation .* saturday 20:00 - sunday 08:00; sunday 20:00 - monday 08:00\\.",
+				"warning: Rotations .* friday 19:00 - friday 20:00 \\(day, night\\)\\.",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		diags := scheduleV2CoverageDiagnostics(c.check, rotations)
+		if len(diags) != len(c.want) {
+			t.Errorf("%s: expected %d diagnostics, got %v", name, len(c.want), diags)
+			continue
+		}
+		for i, d := range diags {
+			severity := "warning"
+			if d.Severity == diag.Error {
+				severity = "error"
+			}
+			if got := fmt.Sprintf("%s: %s %s", severity, d.Summary, d.Detail); !regexp.MustCompile(c.want[i]).MatchString(got) {
+				t.Errorf("%s: diagnostic %d = %q, want a match of %q", name, i, got, c.want[i])
+			}
+		}
+	}
+
+	// A single rotation covering the whole week.
+	if diags := scheduleV2CoverageDiagnostics([]any{tf.M{"gaps": "error", "overlaps": "error"}}, []any{rotation("always", timeslot("", 0, 1440))}); len(diags) != 0 {
+		t.Errorf("expected no gaps nor overlaps, got %v", diags)
+	}
+	// Rotations without participants are not on-call.
+	empty := rotation("empty", timeslot("", 0, 1440))
+	empty["participant_groups"] = []any{}
+	if diags := scheduleV2CoverageDiagnostics([]any{tf.M{"gaps": "error", "overlaps": "off"}}, []any{empty}); len(diags) != 1 || !regexp.MustCompile("the whole week").MatchString(diags[0].Detail) {
+		t.Errorf("expected a gap of the whole week, got %v", diags)
+	}
+}
+
 func TestAccResourceScheduleV2(t *testing.T) {
-	scheduleName := acctest.RandomWithPrefix("schedule_v2")
+	scheduleName := testAccName("schedule_v2")
 
 	resourceName := "squadcast_schedule_v2.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckScheduleV2Destroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleV2Destroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceScheduleV2Config(scheduleName),
@@ -30,8 +115,10 @@ func TestAccResourceScheduleV2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "timezone", "Asia/Kolkata"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.type", "team"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.id", "613611c1eb22db455cfa789f"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0.key", "key1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0.value", "value1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags.*", map[string]string{
+						"key":   "key1",
+						"value": "value1",
+					}),
 				),
 			},
 			{
@@ -44,8 +131,11 @@ func TestAccResourceScheduleV2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "timezone", "Asia/Kolkata"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.type", "team"),
 					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.id", "613611c1eb22db455cfa789f"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0.key", "key1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0.value", "value1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tags.*", map[string]string{
+						"key":   "key1",
+						"value": "value1",
+						"color": "#0f61dd",
+					}),
 				),
 			},
 			{
@@ -58,6 +148,40 @@ func TestAccResourceScheduleV2(t *testing.T) {
 	})
 }
 
+func TestAccResourceScheduleV2InlineRotations(t *testing.T) {
+	scheduleName := testAccName("schedule_v2")
+
+	resourceName := "squadcast_schedule_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceScheduleV2Config_rotations(scheduleName, 720, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotations.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.name", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.shift_timeslots.0.duration", "720"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.participant_groups.0.participants.0.id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.1.name", "secondary"),
+				),
+			},
+			{
+				Config: testAccResourceScheduleV2Config_rotations(scheduleName, 1440, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.name", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.shift_timeslots.0.duration", "1440"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleV2Destroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -112,7 +236,64 @@ func testAccResourceScheduleV2Config_update(scheduleName string) string {
 			tags {
 				key = "key1"
 				value = "value1"
+				color = "#0f61dd"
 			}
 		}
 	`, scheduleName)
 }
+
+func testAccResourceScheduleV2Config_rotations(scheduleName string, duration int, secondary bool) string {
+	secondaryRotation := ""
+	if secondary {
+		secondaryRotation = `
+			rotations {
+				name = "secondary"
+				start_date = "2032-06-01T00:00:00Z"
+				period = "weekly"
+				shift_timeslots {
+					start_hour = 0
+					start_minute = 0
+					duration = 1440
+				}
+				change_participants_frequency = 1
+				change_participants_unit = "rotation"
+				participant_groups {
+					participants {
+						type = "team"
+						id = "613611c1eb22db455cfa789f"
+					}
+				}
+			}`
+	}
+
+	return fmt.Sprintf(`
+		resource "squadcast_schedule_v2" "test" {
+			name = "%s"
+			team_id = "613611c1eb22db455cfa789f"
+			timezone = "Asia/Kolkata"
+			entity_owner {
+				type = "team"
+				id = "613611c1eb22db455cfa789f"
+			}
+			rotations {
+				name = "primary"
+				start_date = "2032-06-01T00:00:00Z"
+				period = "daily"
+				shift_timeslots {
+					start_hour = 10
+					start_minute = 30
+					duration = %d
+				}
+				change_participants_frequency = 1
+				change_participants_unit = "rotation"
+				participant_groups {
+					participants {
+						type = "team"
+						id = "613611c1eb22db455cfa789f"
+					}
+				}
+			}
+			%s
+		}
+	`, scheduleName, duration, secondaryRotation)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,138 +23,591 @@ func resourceService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceImport,
 		},
+		CustomizeDiff: serviceDelayedNotificationsCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceServiceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceServiceStateUpgradeV0,
+			},
+		},
+		Schema: resourceServiceSchema(),
+	}
+}
+
+func resourceServiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "Service id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description:  "Name of the Service.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"description": {
+			Description:  "Detailed description about this service.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"escalation_policy_id": {
+			Description:  "Escalation policy id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+		},
+		"email_prefix": {
+			Description: "Email prefix.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"api_key": {
+			Description: "Unique API key of this service.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"email": {
+			Description: "Email.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"dependencies": {
+			Description: "Dependencies (serviceIds). The upstream services this service depends on, used for dependency based deduplication and impact analysis. Removing all of them clears the dependencies of the service.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+		},
+		"maintainer": {
+			Description: "Service owner, the user, team or squad accountable for the service.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description:  "The id of the maintainer.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: tf.ValidateObjectID,
+					},
+					"type": {
+						Description:  "The type of the maintainer. (user, team or squad)",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
+					},
+				},
+			},
+		},
+		"labels": {
+			Description: "Metadata labels of the service, by key, e.g. its cost center or compliance tier.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Description: "Service tags. Tags are unordered, the API may return them in any order.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         hashTag,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description: "key",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"value": {
+						Description: "value",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"alert_sources": {
+			Description: "List of active alert source names. Find all alert sources supported on Squadcast [here](https://www.squadcast.com/integrations).",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"active_alert_source_endpoints": {
+			Description: "Active alert source endpoints.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"alert_source_endpoints": {
+			Description: "All available alert source endpoints.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"slack_channel_id": {
+			Description: "Slack extension for the service. If set, specifies the ID of the Slack channel associated with the service. If this ID is set, it cannot be removed, but it can be changed to a different slack_channel_id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Optional:    true,
+		},
+		"muted": {
+			Description: "Whether the notifications of the incidents of the service are muted, e.g. during the quiet period of a risky deployment. The incidents are still created. Toggling it updates the service in place. Defaults to `false`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"delayed_notifications": {
+			Description: "Queue the notifications of the incidents triggered outside of the business hours of the service until the next business hours start, e.g. for services whose incidents can wait for the next working day. " +
+				"The notifications are sent right away when not set.",
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"timezone": {
+						Description:           "Timezone of the business hours, e.g. Asia/Kolkata.",
+						Type:                  schema.TypeString,
+						Required:              true,
+						ValidateFunc:          tf.ValidateTimezone,
+						DiffSuppressFunc:      tf.SuppressEquivalentTimezones,
+						DiffSuppressOnRefresh: true,
+					},
+					"business_hours": {
+						Description: "Business hours of the service, the notifications are sent during any of them.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"days": {
+									Description: "Days of the week of the business hours (monday, tuesday, wednesday, thursday, friday, saturday, sunday).",
+									Type:        schema.TypeSet,
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, false),
+									},
+									Set: schema.HashString,
+								},
+								"start_time": {
+									Description:  "Start of the business hours (HH:MM).",
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(timeOfDayRegexp, "must be a time of the day (HH:MM)"),
+								},
+								"end_time": {
+									Description:  "End of the business hours (HH:MM), it must be after the start.",
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(timeOfDayRegexp, "must be a time of the day (HH:MM)"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"intelligent_alert_grouping": {
+			Description: "Intelligent alert grouping groups the alerts similar to the alerts of an open incident of the service, triggered during the time window, into that incident. " +
+				"The alerts are not grouped when not set.",
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time_window": {
+						Description:  "Length of the grouping window, in minutes.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		"auto_pause_transient_alerts": {
+			Description: "Auto pause transient alerts (APTA) holds the notifications of the new incidents of the service for the timeout, the incidents auto resolved by their alert source during it are transient and never notified. " +
+				"The notifications are sent right away when not set.",
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"timeout": {
+						Description:  "Evaluation window of the new incidents, in minutes (2, 3, 5, 10 or 15).",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntInSlice([]int{2, 3, 5, 10, 15}),
+					},
+				},
+			},
+		},
+		"metrics": serviceMetricsSchema(),
+	}
+}
 
+// resourceServiceV0 is the schema before the tags were changed from a list to a set. It is a frozen copy of the schema
+// of that version, later changes to the schema must not be made here.
+func resourceServiceV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "Service id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
-				Description:  "Name of the Service.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 1000),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"description": {
-				Description:  "Detailed description about this service.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(1, 1000),
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"team_id": {
-				Description:  "Team id.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: tf.ValidateObjectID,
-				ForceNew:     true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"escalation_policy_id": {
-				Description:  "Escalation policy id.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: tf.ValidateObjectID,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"email_prefix": {
-				Description: "Email prefix.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"api_key": {
-				Description: "Unique API key of this service.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"email": {
-				Description: "Email.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"dependencies": {
-				Description: "Dependencies (serviceIds)",
-				Type:        schema.TypeSet,
-				Optional:    true,
+				Type:     schema.TypeSet,
+				Optional: true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: tf.ValidateObjectID,
+					Type: schema.TypeString,
 				},
 			},
 			"maintainer": {
-				Description: "Service owner.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Computed:    true,
-				MaxItems:    1,
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Description:  "The id of the maintainer.",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: tf.ValidateObjectID,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"type": {
-							Description:  "The type of the maintainer. (user, team or squad)",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"user", "squad", "team"}, false),
+							Type:     schema.TypeString,
+							Required: true,
 						},
 					},
 				},
 			},
 			"tags": {
-				Description: "Service tags.",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
-							Description: "key",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"value": {
-							Description: "value",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 					},
 				},
 			},
 			"alert_sources": {
-				Description: "List of active alert source names. Find all alert sources supported on Squadcast [here](https://www.squadcast.com/integrations).",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"active_alert_source_endpoints": {
-				Description: "Active alert source endpoints.",
-				Type:        schema.TypeMap,
-				Computed:    true,
+				Type:     schema.TypeMap,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"alert_source_endpoints": {
-				Description: "All available alert source endpoints.",
-				Type:        schema.TypeMap,
-				Computed:    true,
+				Type:     schema.TypeMap,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"slack_channel_id": {
-				Description: "Slack extension for the service. If set, specifies the ID of the Slack channel associated with the service. If this ID is set, it cannot be removed, but it can be changed to a different slack_channel_id.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"muted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incident_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mtta": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mttr": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceStateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	return upgradeListsToSets(rawState, "tags"), nil
+}
+
+// expandServiceDelayedNotifications returns the delayed notification config of the service, disabled when the
+// `delayed_notifications` block is not set.
+func expandServiceDelayedNotifications(d *schema.ResourceData) *api.ServiceDelayedNotificationConfig {
+	config := &api.ServiceDelayedNotificationConfig{}
+
+	mconfigs := d.Get("delayed_notifications").([]any)
+	if len(mconfigs) == 0 || mconfigs[0] == nil {
+		return config
+	}
+	mconfig := mconfigs[0].(map[string]any)
+
+	config.Enabled = true
+	config.Timezone = mconfig["timezone"].(string)
+	for _, h := range mconfig["business_hours"].([]any) {
+		mhours := h.(map[string]any)
+		config.BusinessHours = append(config.BusinessHours, &api.ServiceBusinessHours{
+			Days:      tf.ExpandStringSet(mhours["days"].(*schema.Set)),
+			StartTime: mhours["start_time"].(string),
+			EndTime:   mhours["end_time"].(string),
+		})
+	}
+
+	return config
+}
+
+// setServiceDelayedNotifications reads the delayed notification config of a service into `delayed_notifications`,
+// which is empty when the notifications are sent right away.
+func setServiceDelayedNotifications(ctx context.Context, client *api.Client, d *schema.ResourceData, serviceID string) diag.Diagnostics {
+	config, err := client.GetServiceDelayedNotificationConfig(ctx, serviceID)
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		return diagFromErr(err)
+	}
+
+	delayedNotifications := []any{}
+	if config != nil && config.Enabled {
+		m, err := config.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		delayedNotifications = append(delayedNotifications, m)
+	}
+
+	if err = d.Set("delayed_notifications", delayedNotifications); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// serviceDelayedNotificationsCustomizeDiff rejects business hours ending before they start, business hours can not
+// span midnight.
+func serviceDelayedNotificationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	mconfigs := d.Get("delayed_notifications").([]any)
+	if len(mconfigs) == 0 || mconfigs[0] == nil {
+		return nil
+	}
+
+	for i, h := range mconfigs[0].(map[string]any)["business_hours"].([]any) {
+		mhours, ok := h.(map[string]any)
+		if !ok {
+			continue
+		}
+		start, end := mhours["start_time"].(string), mhours["end_time"].(string)
+		// Times of the day (HH:MM) compare as strings, unknown times are empty.
+		if start != "" && end != "" && end <= start {
+			return fmt.Errorf("delayed_notifications.0.business_hours.%d: end_time (%s) must be after start_time (%s), split business hours spanning midnight in two", i, end, start)
+		}
+	}
+
+	return nil
+}
+
+// expandServiceIntelligentAlertGrouping returns the intelligent alert grouping config of the service, disabled when
+// the `intelligent_alert_grouping` block is not set.
+func expandServiceIntelligentAlertGrouping(d *schema.ResourceData) *api.ServiceIntelligentAlertGroupingConfig {
+	config := &api.ServiceIntelligentAlertGroupingConfig{}
+
+	mconfigs := d.Get("intelligent_alert_grouping").([]any)
+	if len(mconfigs) == 0 || mconfigs[0] == nil {
+		return config
+	}
+
+	config.Enabled = true
+	config.TimeWindow = mconfigs[0].(map[string]any)["time_window"].(int)
+
+	return config
+}
+
+// setServiceIntelligentAlertGrouping reads the intelligent alert grouping config of a service into
+// `intelligent_alert_grouping`, which is empty when the alerts are not grouped.
+func setServiceIntelligentAlertGrouping(ctx context.Context, client *api.Client, d *schema.ResourceData, serviceID string) diag.Diagnostics {
+	config, err := client.GetServiceIntelligentAlertGroupingConfig(ctx, serviceID)
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		return diagFromErr(err)
+	}
+
+	intelligentAlertGrouping := []any{}
+	if config != nil && config.Enabled {
+		m, err := config.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		intelligentAlertGrouping = append(intelligentAlertGrouping, m)
+	}
+
+	if err = d.Set("intelligent_alert_grouping", intelligentAlertGrouping); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// expandServiceAPTA returns the auto pause transient alerts config of the service, disabled when the
+// `auto_pause_transient_alerts` block is not set.
+func expandServiceAPTA(d *schema.ResourceData) *api.ServiceAPTAConfig {
+	config := &api.ServiceAPTAConfig{}
+
+	mconfigs := d.Get("auto_pause_transient_alerts").([]any)
+	if len(mconfigs) == 0 || mconfigs[0] == nil {
+		return config
+	}
+
+	config.Enabled = true
+	config.Timeout = mconfigs[0].(map[string]any)["timeout"].(int)
+
+	return config
+}
+
+// setServiceAPTA reads the auto pause transient alerts config of a service into `auto_pause_transient_alerts`, which
+// is empty when the notifications are sent right away.
+func setServiceAPTA(ctx context.Context, client *api.Client, d *schema.ResourceData, serviceID string) diag.Diagnostics {
+	config, err := client.GetServiceAPTAConfig(ctx, serviceID)
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		return diagFromErr(err)
+	}
+
+	apta := []any{}
+	if config != nil && config.Enabled {
+		m, err := config.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		apta = append(apta, m)
+	}
+
+	if err = d.Set("auto_pause_transient_alerts", apta); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func serviceMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Incident metrics of the service over the last 30 days. Not refreshed when `skip_analytics_refresh` is set on the provider.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"incident_count": {
+					Description: "Number of incidents.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"mtta": {
+					Description: "Mean time to acknowledge, in seconds.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"mttr": {
+					Description: "Mean time to resolve, in seconds.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
 			},
 		},
 	}
 }
 
+// setServiceMetrics reads the analytics of a service into `metrics`, unless the provider skips the analytics refresh.
+func setServiceMetrics(ctx context.Context, client *api.Client, d *schema.ResourceData, teamID, serviceID string) diag.Diagnostics {
+	if client.SkipAnalyticsRefresh {
+		return nil
+	}
+
+	analytics, err := client.GetServiceAnalytics(ctx, teamID, serviceID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	metrics, err := analytics.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if err = d.Set("metrics", tf.List(metrics)); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	teamID, id, err := parse2PartImportID(d.Id())
 	if err != nil {
@@ -178,15 +632,16 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 		Description:        d.Get("description").(string),
 		EscalationPolicyID: d.Get("escalation_policy_id").(string),
 		EmailPrefix:        d.Get("email_prefix").(string),
+		Labels:             tf.ExpandStringMap(d.Get("labels")),
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := d.Get("tags").(*schema.Set).List()
 
 	if len(mtags) > 0 {
 		var tags []api.ServiceTag
 		err := Decode(mtags, &tags)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		serviceCreateReq.Tags = tags
@@ -208,7 +663,17 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 
 	service, err := client.CreateService(ctx, &serviceCreateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "service", serviceCreateReq.Name, func() (string, error) {
+			existing, err := client.GetServiceByName(ctx, serviceCreateReq.TeamID, serviceCreateReq.Name)
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceServiceUpdate(ctx, d, meta)...)
 	}
 
 	d.SetId(service.ID)
@@ -236,7 +701,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 		_, err = client.AddAlertSources(ctx, service.ID, &alertSourcesReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -246,7 +711,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 			Data: mdependencies,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -256,11 +721,56 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 			ChannelID: slackChannelID.(string),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceServiceRead(ctx, d, meta)
+	if d.Get("muted").(bool) {
+		tflog.Info(ctx, "Muting service", tf.M{
+			"id": service.ID,
+		})
+		_, err = client.UpdateServiceMute(ctx, service.ID, &api.UpdateServiceMuteReq{
+			Muted: true,
+		})
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if config := expandServiceDelayedNotifications(d); config.Enabled {
+		tflog.Info(ctx, "Delaying service notifications", tf.M{
+			"id":       service.ID,
+			"timezone": config.Timezone,
+		})
+		_, err = client.UpdateServiceDelayedNotificationConfig(ctx, service.ID, config)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if config := expandServiceIntelligentAlertGrouping(d); config.Enabled {
+		tflog.Info(ctx, "Enabling service intelligent alert grouping", tf.M{
+			"id":          service.ID,
+			"time_window": config.TimeWindow,
+		})
+		_, err = client.UpdateServiceIntelligentAlertGroupingConfig(ctx, service.ID, config)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if config := expandServiceAPTA(d); config.Enabled {
+		tflog.Info(ctx, "Enabling service auto pause transient alerts", tf.M{
+			"id":      service.ID,
+			"timeout": config.Timeout,
+		})
+		_, err = client.UpdateServiceAPTAConfig(ctx, service.ID, config)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceServiceRead)
 }
 
 func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -283,17 +793,17 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	activeAlertSources, err := client.ListActiveAlertSources(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	alertSources, err := client.ListAlertSources(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var activeAlertSourcesMap = make(map[string]string, len(activeAlertSources.AlertSources))
@@ -310,7 +820,23 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	service.AlertSources = alertSources.Available().EndpointMap(client.IngestionBaseURL, service)
 
 	if err = tf.EncodeAndSet(service, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if diags := setServiceDelayedNotifications(ctx, client, d, service.ID); diags.HasError() {
+		return diags
+	}
+
+	if diags := setServiceIntelligentAlertGrouping(ctx, client, d, service.ID); diags.HasError() {
+		return diags
+	}
+
+	if diags := setServiceAPTA(ctx, client, d, service.ID); diags.HasError() {
+		return diags
+	}
+
+	if diags := setServiceMetrics(ctx, client, d, service.Owner.ID, service.ID); diags.HasError() {
+		return diags
 	}
 
 	return nil
@@ -324,15 +850,16 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		Description:        d.Get("description").(string),
 		EscalationPolicyID: d.Get("escalation_policy_id").(string),
 		EmailPrefix:        d.Get("email_prefix").(string),
+		Labels:             tf.ExpandStringMap(d.Get("labels")),
 	}
 
-	mtags := d.Get("tags").([]any)
+	mtags := d.Get("tags").(*schema.Set).List()
 
 	if len(mtags) > 0 {
 		var tags []api.ServiceTag
 		err := Decode(mtags, &tags)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		updateReq.Tags = tags
@@ -354,7 +881,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	_, err := client.UpdateService(ctx, d.Id(), &updateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	malertsources := tf.ListToSlice[string](d.Get("alert_sources"))
@@ -364,7 +891,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 		_, err = client.AddAlertSources(ctx, d.Id(), &alertSourcesReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if len(malertsources) > 0 {
@@ -389,17 +916,23 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 		_, err = client.AddAlertSources(ctx, d.Id(), &alertSourcesReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
-	mdependencies := tf.ExpandStringSet(d.Get("dependencies").(*schema.Set))
-	if len(mdependencies) > 0 {
+	if d.HasChange("dependencies") {
+		mdependencies := tf.ExpandStringSet(d.Get("dependencies").(*schema.Set))
+		for _, dependency := range mdependencies {
+			if dependency == d.Id() {
+				return diag.Errorf("service %s cannot depend on itself", d.Id())
+			}
+		}
+
 		_, err = client.UpdateServiceDependencies(ctx, d.Id(), &api.UpdateServiceDependenciesReq{
 			Data: mdependencies,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -408,7 +941,56 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			ChannelID: d.Get("slack_channel_id").(string),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("muted") {
+		tflog.Info(ctx, "Updating service mute", tf.M{
+			"id":    d.Id(),
+			"muted": d.Get("muted").(bool),
+		})
+		_, err = client.UpdateServiceMute(ctx, d.Id(), &api.UpdateServiceMuteReq{
+			Muted: d.Get("muted").(bool),
+		})
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("delayed_notifications") {
+		config := expandServiceDelayedNotifications(d)
+		tflog.Info(ctx, "Updating service delayed notifications", tf.M{
+			"id":      d.Id(),
+			"enabled": config.Enabled,
+		})
+		_, err = client.UpdateServiceDelayedNotificationConfig(ctx, d.Id(), config)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("intelligent_alert_grouping") {
+		config := expandServiceIntelligentAlertGrouping(d)
+		tflog.Info(ctx, "Updating service intelligent alert grouping", tf.M{
+			"id":      d.Id(),
+			"enabled": config.Enabled,
+		})
+		_, err = client.UpdateServiceIntelligentAlertGroupingConfig(ctx, d.Id(), config)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("auto_pause_transient_alerts") {
+		config := expandServiceAPTA(d)
+		tflog.Info(ctx, "Updating service auto pause transient alerts", tf.M{
+			"id":      d.Id(),
+			"enabled": config.Enabled,
+		})
+		_, err = client.UpdateServiceAPTAConfig(ctx, d.Id(), config)
+		if err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -424,7 +1006,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
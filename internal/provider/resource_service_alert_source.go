@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// serviceAlertSourcesMu serializes updates of the active alert sources of services, since the
+// API only allows replacing the whole list.
+var serviceAlertSourcesMu sync.Mutex
+
+func resourceServiceAlertSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Enables an [alert source](https://www.squadcast.com/integrations) on a service and exposes its endpoint. Do not use this resource together with the `alert_sources` attribute of `squadcast_service` for the same service.",
+
+		CreateContext: resourceServiceAlertSourceCreate,
+		ReadContext:   resourceServiceAlertSourceRead,
+		UpdateContext: resourceServiceAlertSourceUpdate,
+		DeleteContext: resourceServiceAlertSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAlertSourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Alert source id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"alert_source": {
+				Description: "Alert source name. Find all alert sources supported on Squadcast [here](https://www.squadcast.com/integrations).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email_prefix": {
+				Description: "Email prefix of the service, only applicable to the email alert source.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"email_config": {
+				Description: "How the emails are parsed into incidents, only applicable to the email alert source. When not set, the configuration of the service is left as is. The address to send the emails to is exported as `endpoint`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parsing_mode": {
+							Description:  "How the body of the emails is parsed. (plain, json) In the plain mode, the body is read as `Key: value` lines. Defaults to `plain`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "plain",
+							ValidateFunc: validation.StringInSlice([]string{"plain", "json"}, false),
+						},
+						"key_field_mapping": {
+							Description: "Incident fields set from keys of the body. By default, the message of the incident is the subject of the email and its description is the body.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"incident_field": {
+										Description:  "Incident field. (message, description, priority, status, event_id)",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"message", "description", "priority", "status", "event_id"}, false),
+									},
+									"key": {
+										Description:  "Key of the body the field is set from, the label of a `Key: value` line in the plain mode or a dot separated path in the json mode, e.g. `alert.severity`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alert_source_shortname": {
+				Description: "Shortname of the alert source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"endpoint": {
+				Description: "Webhook URL, API key or email to which the alert source should send alerts.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceServiceAlertSourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	teamID, serviceID, alertSourceName, err := parse3PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, alertSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.Set("alert_source", alertSourceName)
+	d.SetId(alertSource.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateServiceAlertSources enables or disables alertSourceID on the service, keeping the other active alert sources.
+func updateServiceAlertSources(ctx context.Context, client *api.Client, serviceID string, alertSourceID string, enable bool) error {
+	serviceAlertSourcesMu.Lock()
+	defer serviceAlertSourcesMu.Unlock()
+
+	activeAlertSources, err := client.ListActiveAlertSources(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	alertSourceIDs := make([]string, 0, len(activeAlertSources.AlertSources)+1)
+	for _, alertSource := range activeAlertSources.AlertSources {
+		if alertSource.ID != alertSourceID {
+			alertSourceIDs = append(alertSourceIDs, alertSource.ID)
+		}
+	}
+	if enable {
+		alertSourceIDs = append(alertSourceIDs, alertSourceID)
+	}
+
+	_, err = client.AddAlertSources(ctx, serviceID, &api.AddAlertSourcesReq{
+		AlertSources: alertSourceIDs,
+	})
+	return err
+}
+
+func updateServiceEmailPrefix(ctx context.Context, client *api.Client, teamID string, serviceID string, emailPrefix string) error {
+	service, err := client.GetServiceById(ctx, teamID, serviceID)
+	if err != nil {
+		return err
+	}
+
+	if strings.Split(service.Email, "@")[0] == emailPrefix {
+		return nil
+	}
+
+	_, err = client.UpdateService(ctx, serviceID, &api.UpdateServiceReq{
+		Name:               service.Name,
+		Description:        service.Description,
+		EscalationPolicyID: service.EscalationPolicyID,
+		EmailPrefix:        emailPrefix,
+		Maintainer:         service.Maintainer,
+		Tags:               service.Tags,
+		Labels:             service.Labels,
+	})
+	return err
+}
+
+// decodeEmailAlertSourceConfig decodes the email configuration, if set, rejecting incident fields mapped more than once
+// and keys that can't be found in the body with the parsing mode.
+func decodeEmailAlertSourceConfig(d *schema.ResourceData) (*api.EmailAlertSourceConfig, error) {
+	mconfig := d.Get("email_config").([]any)
+	if len(mconfig) == 0 || mconfig[0] == nil {
+		return nil, nil
+	}
+
+	m := mconfig[0].(tf.M)
+	config := &api.EmailAlertSourceConfig{
+		ParsingMode:     m["parsing_mode"].(string),
+		KeyFieldMapping: []api.EmailKeyFieldMapping{},
+	}
+	if err := tf.DecodeAt("email_config.0.key_field_mapping", m["key_field_mapping"].([]any), &config.KeyFieldMapping); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for i, mapping := range config.KeyFieldMapping {
+		if fields[mapping.IncidentField] {
+			return nil, fmt.Errorf("email_config.0.key_field_mapping.%d: %s is mapped more than once", i, mapping.IncidentField)
+		}
+		fields[mapping.IncidentField] = true
+
+		switch config.ParsingMode {
+		case "plain":
+			if strings.Contains(mapping.Key, ":") {
+				return nil, fmt.Errorf("email_config.0.key_field_mapping.%d: the key %q of a `Key: value` line can't contain a colon", i, mapping.Key)
+			}
+		case "json":
+			if strings.HasPrefix(mapping.Key, ".") || strings.HasSuffix(mapping.Key, ".") || strings.Contains(mapping.Key, "..") {
+				return nil, fmt.Errorf("email_config.0.key_field_mapping.%d: %q is not a dot separated path", i, mapping.Key)
+			}
+		}
+	}
+
+	return config, nil
+}
+
+func resourceServiceAlertSourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	alertSource, err := api.GetAlertSourceDetailsByName(client, ctx, d.Get("alert_source").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	emailPrefix, isEmailPrefixSet := d.GetOk("email_prefix")
+	if isEmailPrefixSet && alertSource.ShortName != "email" {
+		return diag.Errorf("email_prefix can only be set for the email alert source, %s is not an email alert source", d.Get("alert_source").(string))
+	}
+	emailConfig, err := decodeEmailAlertSourceConfig(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if emailConfig != nil && alertSource.ShortName != "email" {
+		return diag.Errorf("email_config can only be set for the email alert source, %s is not an email alert source", d.Get("alert_source").(string))
+	}
+
+	tflog.Info(ctx, "Enabling service alert source", tf.M{
+		"service_id":   d.Get("service_id").(string),
+		"alert_source": d.Get("alert_source").(string),
+	})
+	err = updateServiceAlertSources(ctx, client, d.Get("service_id").(string), alertSource.ID, true)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(alertSource.ID)
+
+	if isEmailPrefixSet {
+		err = updateServiceEmailPrefix(ctx, client, d.Get("team_id").(string), d.Get("service_id").(string), emailPrefix.(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if emailConfig != nil {
+		_, err = client.UpdateEmailAlertSourceConfig(ctx, d.Get("service_id").(string), emailConfig)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceServiceAlertSourceRead)
+}
+
+func resourceServiceAlertSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID := d.Get("service_id").(string)
+
+	tflog.Info(ctx, "Reading service alert source", tf.M{
+		"id":         d.Id(),
+		"service_id": serviceID,
+	})
+	service, err := client.GetServiceById(ctx, d.Get("team_id").(string), serviceID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	activeAlertSources, err := client.ListActiveAlertSources(ctx, serviceID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	isActive := false
+	for _, alertSource := range activeAlertSources.AlertSources {
+		if alertSource.ID == d.Id() {
+			isActive = true
+			break
+		}
+	}
+	if !isActive {
+		d.SetId("")
+		return nil
+	}
+
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var alertSource *api.AlertSource
+	for _, as := range alertSources {
+		if as.ID == d.Id() {
+			alertSource = as
+			break
+		}
+	}
+	if alertSource == nil {
+		return diagFromErr(fmt.Errorf("could not find an alert source with id `%s`", d.Id()))
+	}
+
+	d.Set("alert_source", alertSource.Type)
+	d.Set("alert_source_shortname", alertSource.ShortName)
+	d.Set("endpoint", alertSource.Endpoint(client.IngestionBaseURL, service))
+	if alertSource.ShortName == "email" {
+		d.Set("email_prefix", strings.Split(service.Email, "@")[0])
+
+		emailConfig, err := client.GetEmailAlertSourceConfig(ctx, serviceID)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		m, err := emailConfig.Encode()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if err = d.Set("email_config", tf.List(m)); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceAlertSourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	if d.HasChange("email_prefix") {
+		if d.Get("alert_source_shortname").(string) != "email" {
+			return diag.Errorf("email_prefix can only be set for the email alert source, %s is not an email alert source", d.Get("alert_source").(string))
+		}
+
+		err := updateServiceEmailPrefix(ctx, client, d.Get("team_id").(string), d.Get("service_id").(string), d.Get("email_prefix").(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("email_config") {
+		emailConfig, err := decodeEmailAlertSourceConfig(d)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if emailConfig != nil {
+			if d.Get("alert_source_shortname").(string) != "email" {
+				return diag.Errorf("email_config can only be set for the email alert source, %s is not an email alert source", d.Get("alert_source").(string))
+			}
+
+			_, err = client.UpdateEmailAlertSourceConfig(ctx, d.Get("service_id").(string), emailConfig)
+			if err != nil {
+				return diagFromErr(err)
+			}
+		}
+	}
+
+	return resourceServiceAlertSourceRead(ctx, d, meta)
+}
+
+func resourceServiceAlertSourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	err := updateServiceAlertSources(ctx, client, d.Get("service_id").(string), d.Id(), false)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceServiceAlertSource(t *testing.T) {
+	serviceName := testAccName("service")
+
+	resourceName := "squadcast_service_alert_source.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceAlertSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceAlertSourceConfig(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "alert_source", "Email"),
+					resource.TestCheckResourceAttr(resourceName, "alert_source_shortname", "email"),
+					resource.TestCheckResourceAttr(resourceName, "email_prefix", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", serviceName+"@squadcast.incidents.squadcast.com"),
+				),
+			},
+			{
+				Config: testAccResourceServiceAlertSourceConfig_update(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "alert_source", "Email"),
+					resource.TestCheckResourceAttr(resourceName, "email_prefix", serviceName+"-alerts"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", serviceName+"-alerts@squadcast.incidents.squadcast.com"),
+					resource.TestCheckResourceAttr(resourceName, "email_config.0.parsing_mode", "json"),
+					resource.TestCheckResourceAttr(resourceName, "email_config.0.key_field_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_config.0.key_field_mapping.0.incident_field", "priority"),
+					resource.TestCheckResourceAttr(resourceName, "email_config.0.key_field_mapping.0.key", "alert.severity"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeEmailAlertSourceConfig(t *testing.T) {
+	config := func(parsingMode string, mappings ...map[string]any) map[string]any {
+		mmappings := make([]any, len(mappings))
+		for i, mapping := range mappings {
+			mmappings[i] = mapping
+		}
+		return map[string]any{
+			"email_config": []any{map[string]any{"parsing_mode": parsingMode, "key_field_mapping": mmappings}},
+		}
+	}
+	mapping := func(field string, key string) map[string]any {
+		return map[string]any{"incident_field": field, "key": key}
+	}
+
+	cases := []struct {
+		name  string
+		raw   map[string]any
+		valid bool
+	}{
+		{"unset", map[string]any{}, true},
+		{"plain", config("plain", mapping("priority", "Severity"), mapping("event_id", "Alert ID")), true},
+		{"json", config("json", mapping("priority", "alert.severity")), true},
+		{"duplicate field", config("json", mapping("priority", "severity"), mapping("priority", "alert.severity")), false},
+		{"plain key with a colon", config("plain", mapping("priority", "Severity:")), false},
+		{"invalid json path", config("json", mapping("priority", "alert..severity")), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceAlertSource().Schema, c.raw)
+			emailConfig, err := decodeEmailAlertSourceConfig(d)
+			if c.valid && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !c.valid && err == nil {
+				t.Fatal("expected an error")
+			}
+			if c.name == "unset" && emailConfig != nil {
+				t.Errorf("expected no configuration, got %+v", emailConfig)
+			}
+		})
+	}
+}
+
+func testAccCheckServiceAlertSourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_service_alert_source" {
+			continue
+		}
+
+		activeAlertSources, err := client.ListActiveAlertSources(context.Background(), rs.Primary.Attributes["service_id"])
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		for _, alertSource := range activeAlertSources.AlertSources {
+			if alertSource.ID == rs.Primary.ID {
+				return fmt.Errorf("expected alert source to be disabled, but it is still active")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceServiceAlertSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_service_alert_source" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = squadcast_service.test.id
+	alert_source = "Email"
+}
+	`, serviceName, serviceName)
+}
+
+func testAccResourceServiceAlertSourceConfig_update(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s-alerts"
+}
+
+resource "squadcast_service_alert_source" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = squadcast_service.test.id
+	alert_source = "Email"
+	email_prefix = "%s-alerts"
+	email_config {
+		parsing_mode = "json"
+		key_field_mapping {
+			incident_field = "priority"
+			key = "alert.severity"
+		}
+	}
+}
+	`, serviceName, serviceName, serviceName)
+}
+
+func TestUpdateServiceEmailPrefix(t *testing.T) {
+	var update map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/services/61305a8eb7a2fa0e44cfd0f6":
+			w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f6","name":"api","email":"api@squadcast.incidents.squadcast.com","escalation_policy_id":"5f8c4ff09b0ccd917237c04b","labels":{"cost_center":"payments"}}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v3/services/61305a8eb7a2fa0e44cfd0f6":
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Error(err)
+			}
+			w.Write([]byte(`{"data":{"id":"61305a8eb7a2fa0e44cfd0f6"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	if err := updateServiceEmailPrefix(context.Background(), client, "613611c1eb22db455cfa789f", "61305a8eb7a2fa0e44cfd0f6", "payments-api"); err != nil {
+		t.Fatal(err)
+	}
+	if update["email_prefix"] != "payments-api" {
+		t.Errorf("expected the email prefix to be updated, got %v", update["email_prefix"])
+	}
+	if labels, _ := update["labels"].(map[string]any); labels["cost_center"] != "payments" {
+		t.Errorf("expected the labels to be kept, got %v", update["labels"])
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const serviceChecklistID = "service_checklist"
+
+func resourceServiceChecklist() *schema.Resource {
+	return &schema.Resource{
+		Description: "Service checklists track the production readiness of a service, e.g. whether a runbook is linked, an escalation policy is set or a dashboard is attached, so readiness gates can be managed as code.",
+
+		CreateContext: resourceServiceChecklistCreate,
+		ReadContext:   resourceServiceChecklistRead,
+		UpdateContext: resourceServiceChecklistUpdate,
+		DeleteContext: resourceServiceChecklistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceChecklistImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			return validateServiceChecklistCompleted(d.GetRawConfig())
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ServiceChecklist id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"items": {
+				Description: "Readiness checklist items of the service.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Item type. (runbook_linked, escalation_policy_set, dashboard_attached or custom)",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"runbook_linked", "escalation_policy_set", "dashboard_attached", "custom"}, false),
+						},
+						"name": {
+							Description:  "Item name, required for custom items.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"link": {
+							Description:  "Link to the runbook, dashboard or any other document backing this item.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"completed": {
+							Description: "Whether this item is completed. Items other than custom ones are evaluated by Squadcast, setting it on them is rejected during plan.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ready": {
+				Description: "Whether all the checklist items of the service are completed.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceServiceChecklistImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	_, serviceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("service_id", serviceID)
+	d.SetId(serviceChecklistID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateServiceChecklistCompleted rejects `completed` on the items other than custom ones, which are evaluated by
+// Squadcast. The configuration is checked rather than the plan, as `completed` is computed for all the items.
+func validateServiceChecklistCompleted(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	items := config.GetAttr("items")
+	if items.IsNull() || !items.IsKnown() {
+		return nil
+	}
+
+	for it := items.ElementIterator(); it.Next(); {
+		key, item := it.Element()
+		if item.IsNull() || !item.IsKnown() {
+			continue
+		}
+		itemType := item.GetAttr("type")
+		if itemType.IsNull() || !itemType.IsKnown() || itemType.AsString() == "custom" {
+			continue
+		}
+		if !item.GetAttr("completed").IsNull() {
+			i, _ := key.AsBigFloat().Int64()
+			return fmt.Errorf("items.%d: completed can only be set on custom items, %s items are evaluated by Squadcast", i, itemType.AsString())
+		}
+	}
+
+	return nil
+}
+
+func decodeServiceChecklistItems(d *schema.ResourceData) ([]api.ServiceChecklistItem, error) {
+	var items []api.ServiceChecklistItem
+	err := Decode(d.Get("items"), &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range items {
+		if item.Type == "custom" && item.Name == "" {
+			return nil, fmt.Errorf("items.%d: name is required for custom items", i)
+		}
+	}
+
+	return items, nil
+}
+
+func resourceServiceChecklistCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	items, err := decodeServiceChecklistItems(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating service checklist", tf.M{
+		"service_id": d.Get("service_id").(string),
+	})
+	_, err = client.UpdateServiceChecklist(ctx, d.Get("service_id").(string), &api.UpdateServiceChecklistReq{
+		Items: items,
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(serviceChecklistID)
+
+	return readAfterCreate(ctx, d, meta, resourceServiceChecklistRead)
+}
+
+func resourceServiceChecklistRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID, ok := d.GetOk("service_id")
+	if !ok {
+		return diag.Errorf("invalid service id provided")
+	}
+
+	tflog.Info(ctx, "Reading service checklist", tf.M{
+		"service_id": serviceID,
+	})
+	serviceChecklist, err := client.GetServiceChecklist(ctx, serviceID.(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(serviceChecklist, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceServiceChecklistUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceServiceChecklistCreate(ctx, d, meta)
+}
+
+func resourceServiceChecklistDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateServiceChecklist(ctx, d.Get("service_id").(string), &api.UpdateServiceChecklistReq{
+		Items: []api.ServiceChecklistItem{},
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
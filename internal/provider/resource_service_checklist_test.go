@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceServiceChecklist(t *testing.T) {
+	resourceName := "squadcast_service_checklist.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceChecklistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceServiceChecklistConfig_evaluatedItemCompleted(),
+				ExpectError: regexp.MustCompile(`items.0: completed can only be set on custom items`),
+			},
+			{
+				Config: testAccResourceServiceChecklistConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "items.0.type", "escalation_policy_set"),
+					resource.TestCheckResourceAttr(resourceName, "items.0.completed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ready", "true"),
+				),
+			},
+			{
+				Config: testAccResourceServiceChecklistConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "items.0.type", "escalation_policy_set"),
+					resource.TestCheckResourceAttr(resourceName, "items.0.completed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "items.1.type", "custom"),
+					resource.TestCheckResourceAttr(resourceName, "items.1.name", "Load test signed off"),
+					resource.TestCheckResourceAttr(resourceName, "items.1.completed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ready", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd",
+			},
+		},
+	})
+}
+
+func testAccCheckServiceChecklistDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_service_checklist" {
+			continue
+		}
+
+		serviceChecklist, err := client.GetServiceChecklist(context.Background(), rs.Primary.Attributes["service_id"])
+		if err != nil {
+			return err
+		}
+		count := len(serviceChecklist.Items)
+		if count > 0 {
+			return fmt.Errorf("expected all service checklist items to be destroyed, %d found", count)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceServiceChecklistConfig() string {
+	return fmt.Sprintf(`
+resource "squadcast_service_checklist" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	items {
+		type = "escalation_policy_set"
+	}
+}
+	`)
+}
+
+func testAccResourceServiceChecklistConfig_evaluatedItemCompleted() string {
+	return fmt.Sprintf(`
+resource "squadcast_service_checklist" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	items {
+		type = "escalation_policy_set"
+		completed = true
+	}
+}
+	`)
+}
+
+func testAccResourceServiceChecklistConfig_update() string {
+	return fmt.Sprintf(`
+resource "squadcast_service_checklist" "test" {
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	items {
+		type = "escalation_policy_set"
+	}
+
+	items {
+		type = "custom"
+		name = "Load test signed off"
+		completed = false
+	}
+}
+	`)
+}
+
+func TestValidateServiceChecklistCompleted(t *testing.T) {
+	item := func(itemType string, completed cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"type":      cty.StringVal(itemType),
+			"name":      cty.NullVal(cty.String),
+			"link":      cty.NullVal(cty.String),
+			"completed": completed,
+		})
+	}
+	config := func(items ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"service_id": cty.StringVal("61305a8eb7a2fa0e44cfd0f5"),
+			"items":      cty.ListVal(items),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		config  cty.Value
+		wantErr bool
+	}{
+		{"custom item completed", config(item("custom", cty.True)), false},
+		{"evaluated item without completed", config(item("runbook_linked", cty.NullVal(cty.Bool)), item("custom", cty.False)), false},
+		{"evaluated item completed", config(item("custom", cty.True), item("dashboard_attached", cty.False)), true},
+		{"unknown completed", config(item("runbook_linked", cty.UnknownVal(cty.Bool))), true},
+		{"unknown type", config(cty.ObjectVal(map[string]cty.Value{
+			"type":      cty.UnknownVal(cty.String),
+			"name":      cty.NullVal(cty.String),
+			"link":      cty.NullVal(cty.String),
+			"completed": cty.True,
+		})), false},
+		{"null config", cty.NullVal(config(item("custom", cty.True)).Type()), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServiceChecklistCompleted(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected an error: %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -94,7 +94,7 @@ func resourceServiceMaintenanceCreate(ctx context.Context, d *schema.ResourceDat
 	var windows []api.ServiceMaintenanceWindow
 	err := Decode(d.Get("windows"), &windows)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateWindows := make([]api.UpdateServiceMaintenanceWindowsWindow, 0, len(windows))
@@ -129,12 +129,12 @@ func resourceServiceMaintenanceCreate(ctx context.Context, d *schema.ResourceDat
 		},
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(serviceMaintenanceID)
 
-	return resourceServiceMaintenanceRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceServiceMaintenanceRead)
 }
 
 func resourceServiceMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -150,17 +150,17 @@ func resourceServiceMaintenanceRead(ctx context.Context, d *schema.ResourceData,
 	})
 	serviceMaintenanceWindows, err := client.GetServiceMaintenanceWindows(ctx, serviceID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	windows, err := tf.EncodeSlice(serviceMaintenanceWindows)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("windows", windows)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -181,7 +181,7 @@ func resourceServiceMaintenanceDelete(ctx context.Context, d *schema.ResourceDat
 		},
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -13,9 +13,9 @@ import (
 func TestAccResourceServiceMaintenance(t *testing.T) {
 	resourceName := "squadcast_service_maintenance.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckServiceMaintenanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceMaintenanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceServiceMaintenanceConfig(),
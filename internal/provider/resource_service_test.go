@@ -5,20 +5,126 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
+func TestResourceServiceDelayedNotifications(t *testing.T) {
+	r := resourceService()
+	config := func(start, end string) map[string]any {
+		return map[string]any{
+			"name":                 "test",
+			"team_id":              "613611c1eb22db455cfa789f",
+			"escalation_policy_id": "5f8c4ff09b0ccd917237c04b",
+			"email_prefix":         "test",
+			"delayed_notifications": []any{
+				map[string]any{
+					"timezone": "Asia/Kolkata",
+					"business_hours": []any{
+						map[string]any{"days": []any{"monday", "friday"}, "start_time": start, "end_time": end},
+					},
+				},
+			},
+		}
+	}
+
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config("18:00", "09:00")), nil); err == nil {
+		t.Error("expected an error for business hours ending before they start")
+	}
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config("09:00", "18:00")), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	delayed := expandServiceDelayedNotifications(schema.TestResourceDataRaw(t, r.Schema, config("09:00", "18:00")))
+	if !delayed.Enabled || delayed.Timezone != "Asia/Kolkata" || len(delayed.BusinessHours) != 1 {
+		t.Fatalf("unexpected delayed notifications %#v", delayed)
+	}
+	if hours := delayed.BusinessHours[0]; len(hours.Days) != 2 || hours.StartTime != "09:00" || hours.EndTime != "18:00" {
+		t.Errorf("unexpected business hours %#v", hours)
+	}
+
+	if delayed := expandServiceDelayedNotifications(schema.TestResourceDataRaw(t, r.Schema, map[string]any{})); delayed.Enabled {
+		t.Error("expected the delayed notifications to be disabled without the block")
+	}
+}
+
+func TestResourceServiceIntelligentAlertGrouping(t *testing.T) {
+	r := resourceService()
+
+	grouping := expandServiceIntelligentAlertGrouping(schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"intelligent_alert_grouping": []any{
+			map[string]any{"time_window": 15},
+		},
+	}))
+	if !grouping.Enabled || grouping.TimeWindow != 15 {
+		t.Errorf("unexpected intelligent alert grouping %#v", grouping)
+	}
+
+	if grouping := expandServiceIntelligentAlertGrouping(schema.TestResourceDataRaw(t, r.Schema, map[string]any{})); grouping.Enabled {
+		t.Error("expected the intelligent alert grouping to be disabled without the block")
+	}
+}
+
+func TestResourceServiceAPTA(t *testing.T) {
+	r := resourceService()
+
+	apta := expandServiceAPTA(schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"auto_pause_transient_alerts": []any{
+			map[string]any{"timeout": 5},
+		},
+	}))
+	if !apta.Enabled || apta.Timeout != 5 {
+		t.Errorf("unexpected auto pause transient alerts %#v", apta)
+	}
+
+	if apta := expandServiceAPTA(schema.TestResourceDataRaw(t, r.Schema, map[string]any{})); apta.Enabled {
+		t.Error("expected the auto pause transient alerts to be disabled without the block")
+	}
+}
+
+func TestResourceServiceMaintainerAndLabels(t *testing.T) {
+	r := resourceService()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"maintainer": []any{
+			map[string]any{"id": "61305a8eb7a2fa0e44cfd0f5", "type": "user"},
+		},
+	})
+
+	service := &api.Service{
+		ID:     "61305a8eb7a2fa0e44cfd0f6",
+		Email:  "test@squadcast.incidents.squadcast.com",
+		Labels: map[string]string{"cost_center": "payments"},
+	}
+	if err := tf.EncodeAndSet(service, d); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(d.Get("maintainer").([]any)); n != 0 {
+		t.Errorf("expected the maintainer removed outside of Terraform to be removed from the state, got %d", n)
+	}
+	if label := d.Get("labels.cost_center"); label != "payments" {
+		t.Errorf("expected the labels to be read, got %v", d.Get("labels"))
+	}
+
+	service.Maintainer = &api.ServiceMaintainer{ID: "61305a8eb7a2fa0e44cfd0f7", Type: "squad"}
+	if err := tf.EncodeAndSet(service, d); err != nil {
+		t.Fatal(err)
+	}
+	if id, typ := d.Get("maintainer.0.id"), d.Get("maintainer.0.type"); id != "61305a8eb7a2fa0e44cfd0f7" || typ != "squad" {
+		t.Errorf("unexpected maintainer %v %v", id, typ)
+	}
+}
+
 func TestAccResourceService(t *testing.T) {
-	serviceName := acctest.RandomWithPrefix("service")
+	serviceName := testAccName("service")
 
 	resourceName := "squadcast_service.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceServiceConfig(serviceName),
@@ -26,8 +132,11 @@ func TestAccResourceService(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "metrics.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					resource.TestCheckResourceAttr(resourceName, "escalation_policy_id", "5f8c4ff09b0ccd917237c04b"),
+					resource.TestCheckResourceAttrSet(resourceName, "team_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "escalation_policy_name"),
 					resource.TestCheckResourceAttr(resourceName, "email_prefix", "testfoo"),
 					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
 					resource.TestCheckResourceAttr(resourceName, "email", "testfoo@squadcast.incidents.squadcast.com"),
@@ -35,6 +144,10 @@ func TestAccResourceService(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "alert_source_endpoints.email", "testfoo@squadcast.incidents.squadcast.com"),
 					resource.TestCheckResourceAttr(resourceName, "alert_sources.0", "APImetrics"),
 					resource.TestCheckResourceAttr(resourceName, "slack_channel_id", "C04AQDEPSH3"),
+					resource.TestCheckResourceAttr(resourceName, "muted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "intelligent_alert_grouping.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auto_pause_transient_alerts.#", "0"),
 				),
 			},
 			{
@@ -46,6 +159,15 @@ func TestAccResourceService(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
 					resource.TestCheckResourceAttr(resourceName, "escalation_policy_id", "61361415c2fc70c3101ca7db"),
 					resource.TestCheckResourceAttr(resourceName, "email_prefix", "foomp2"),
+					resource.TestCheckResourceAttr(resourceName, "muted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "labels.cost_center", "payments"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.0.timezone", "Asia/Kolkata"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.0.business_hours.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "delayed_notifications.0.business_hours.0.days.*", "friday"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.0.business_hours.0.start_time", "09:00"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.0.business_hours.0.end_time", "18:00"),
+					resource.TestCheckResourceAttr(resourceName, "intelligent_alert_grouping.0.time_window", "15"),
+					resource.TestCheckResourceAttr(resourceName, "auto_pause_transient_alerts.0.timeout", "5"),
 					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
 					resource.TestCheckResourceAttr(resourceName, "email", "foomp2@squadcast.incidents.squadcast.com"),
 					resource.TestCheckResourceAttr(resourceName, "dependencies.#", "0"),
@@ -65,9 +187,17 @@ func TestAccResourceService(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "email", "foomp2@squadcast.incidents.squadcast.com"),
 					resource.TestCheckResourceAttr(resourceName, "dependencies.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "dependencies.0", "squadcast_service.test_parent", "id"),
+					resource.TestCheckResourceAttr(resourceName, "delayed_notifications.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "alert_source_endpoints.email", "foomp2@squadcast.incidents.squadcast.com"),
 				),
 			},
+			{
+				Config: testAccResourceServiceConfig_update(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "dependencies.#", "0"),
+				),
+			},
 			{
 				ResourceName:        resourceName,
 				ImportState:         true,
@@ -122,6 +252,29 @@ resource "squadcast_service" "test" {
 	email_prefix = "foomp2"
     alert_sources = ["APImetrics", "Datadog"]
 	slack_channel_id = "C04AQDEPSH3"
+	muted = true
+
+	labels = {
+		cost_center = "payments"
+	}
+
+	delayed_notifications {
+		timezone = "Asia/Kolkata"
+
+		business_hours {
+			days = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+			start_time = "09:00"
+			end_time = "18:00"
+		}
+	}
+
+	intelligent_alert_grouping {
+		time_window = 15
+	}
+
+	auto_pause_transient_alerts {
+		timeout = 5
+	}
 }
 	`, serviceName)
 }
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// slackChannelNameRegexp matches the characters Slack allows in channel names, outside of the placeholders of the template.
+var slackChannelNameRegexp = regexp.MustCompile(`^[a-z0-9_-]*$`)
+
+func resourceSlackIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to configure the [Slack integration](https://support.squadcast.com/integrations/outgoing-integrations-chatops-tools/slack-v2) of a team. " +
+			"The Slack workspace must have been connected to the team from the Squadcast web app first, the API rejects the configuration of a team without one. " +
+			"Destroying this resource resets the configuration, the workspace stays connected.",
+
+		CreateContext: resourceSlackIntegrationCreate,
+		ReadContext:   resourceSlackIntegrationRead,
+		UpdateContext: resourceSlackIntegrationUpdate,
+		DeleteContext: resourceSlackIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSlackIntegrationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"workspace_id": {
+				Description: "Id of the connected Slack workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace_name": {
+				Description: "Name of the connected Slack workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"default_channel_id": {
+				Description:  "Id of the Slack channel the incidents of the team are posted to, e.g. `C0123456789`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[CG][A-Z0-9]{6,}$`), "must be a Slack channel id, e.g. C0123456789"),
+			},
+			"auto_create_incident_channel": {
+				Description: "Whether a dedicated Slack channel is created for every new incident of the team.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"incident_channel_name_template": {
+				Description: "Name of the channels created for the incidents, with placeholders in double curly braces for the details of the incident, e.g. `incident.id`. " +
+					"Outside of the placeholders, only lowercase letters, numbers, hyphens and underscores are allowed. Defaults to the template of Squadcast.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSlackChannelNameTemplate,
+			},
+		},
+	}
+}
+
+// validateSlackChannelNameTemplate makes sure the placeholders of the template are well formed and that the rest of the
+// template only contains characters Slack allows in channel names.
+func validateSlackChannelNameTemplate(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%s must not be empty", k))
+		return warnings, errors
+	}
+
+	placeholders, err := taggingTemplatePlaceholders(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+		return warnings, errors
+	}
+	if len(placeholders) == 0 {
+		errors = append(errors, fmt.Errorf("%s must contain a placeholder, otherwise every incident channel gets the same name", k))
+		return warnings, errors
+	}
+
+	literal := v
+	for {
+		start := strings.Index(literal, "{{")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(literal, "}}")
+		literal = literal[:start] + literal[end+2:]
+	}
+	if !slackChannelNameRegexp.MatchString(literal) {
+		errors = append(errors, fmt.Errorf("%s: only lowercase letters, numbers, hyphens and underscores are allowed outside of the placeholders, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+func resourceSlackIntegrationImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSlackIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Updating slack integration", tf.M{
+		"team_id": teamID,
+	})
+	_, err := client.UpdateSlackIntegration(ctx, teamID, &api.UpdateSlackIntegrationReq{
+		DefaultChannelID:            d.Get("default_channel_id").(string),
+		AutoCreateIncidentChannel:   d.Get("auto_create_incident_channel").(bool),
+		IncidentChannelNameTemplate: d.Get("incident_channel_name_template").(string),
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return diag.Errorf("no Slack workspace is connected to the team %s, connect one from the Squadcast web app first", teamID)
+		}
+		return diagFromErr(err)
+	}
+
+	d.SetId(teamID)
+
+	return readAfterCreate(ctx, d, meta, resourceSlackIntegrationRead)
+}
+
+func resourceSlackIntegrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	tflog.Info(ctx, "Reading slack integration", tf.M{
+		"team_id": teamID,
+	})
+	integration, err := client.GetSlackIntegration(ctx, teamID)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(integration, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSlackIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceSlackIntegrationCreate(ctx, d, meta)
+}
+
+func resourceSlackIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteSlackIntegration(ctx, d.Get("team_id").(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
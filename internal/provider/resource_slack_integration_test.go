@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestValidateSlackChannelNameTemplate(t *testing.T) {
+	cases := map[string]string{
+		"incident-{{incident.id}}":                   "",
+		"inc_{{ incident.id }}-{{incident.service}}": "",
+		"":                         "must not be empty",
+		"incidents":                "must contain a placeholder",
+		"Incident-{{incident.id}}": "only lowercase letters",
+		"incident {{incident.id}}": "only lowercase letters",
+		"incident-{{incident.id":   "unterminated template placeholder",
+		"incident-{{}}":            "empty template placeholder",
+	}
+
+	for template, want := range cases {
+		_, errs := validateSlackChannelNameTemplate(template, "incident_channel_name_template")
+		if want == "" {
+			if len(errs) != 0 {
+				t.Errorf("%q: unexpected errors %v", template, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || !regexp.MustCompile(want).MatchString(errs[0].Error()) {
+			t.Errorf("%q: expected an error matching %q, got %v", template, want, errs)
+		}
+	}
+}
+
+func TestAccResourceSlackIntegration(t *testing.T) {
+	resourceName := "squadcast_slack_integration.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSlackIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSlackIntegrationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrSet(resourceName, "workspace_name"),
+					resource.TestCheckResourceAttr(resourceName, "default_channel_id", "C0123456789"),
+					resource.TestCheckResourceAttr(resourceName, "auto_create_incident_channel", "false"),
+				),
+			},
+			{
+				Config: testAccResourceSlackIntegrationConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_channel_id", "C0123456789"),
+					resource.TestCheckResourceAttr(resourceName, "auto_create_incident_channel", "true"),
+					resource.TestCheckResourceAttr(resourceName, "incident_channel_name_template", "inc-{{incident.id}}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f",
+			},
+		},
+	})
+}
+
+func testAccCheckSlackIntegrationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_slack_integration" {
+			continue
+		}
+
+		integration, err := client.GetSlackIntegration(context.Background(), rs.Primary.Attributes["team_id"])
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if integration.DefaultChannelID != "" {
+			return fmt.Errorf("expected slack integration to be reset, default channel %s found", integration.DefaultChannelID)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceSlackIntegrationConfig() string {
+	return `
+resource "squadcast_slack_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	default_channel_id = "C0123456789"
+}
+	`
+}
+
+func testAccResourceSlackIntegrationConfig_update() string {
+	return `
+resource "squadcast_slack_integration" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	default_channel_id = "C0123456789"
+	auto_create_incident_channel = true
+	incident_channel_name_template = "inc-{{incident.id}}"
+}
+	`
+}
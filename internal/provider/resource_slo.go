@@ -255,12 +255,12 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = Decode(d.Get("notify"), &notify)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ownerID := d.Get("team_id").(string)
@@ -303,12 +303,12 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	slo, err := client.CreateSlo(ctx, client.OrganizationID, ownerID, createSloReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	idStr := strconv.FormatUint(uint64(slo.ID), 10)
 	d.SetId(idStr)
-	return resourceSloRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceSloRead)
 }
 
 func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -331,7 +331,7 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 
 	slo, err := client.GetSlo(ctx, client.OrganizationID, teamID.(string), sloID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	for _, alert := range slo.SloMonitoringChecks {
@@ -339,7 +339,7 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	}
 
 	if err = tf.EncodeAndSet(slo, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -353,12 +353,12 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = Decode(d.Get("notify"), &notify)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	sloID, _ := strconv.ParseInt(d.Id(), 10, 32)
@@ -406,7 +406,7 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	_, err = client.UpdateSlo(ctx, client.OrganizationID, ownerID, id, updateSloReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceSloRead(ctx, d, meta)
@@ -426,7 +426,7 @@ func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	_, err := client.DeleteSlo(ctx, client.OrganizationID, teamID.(string), d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
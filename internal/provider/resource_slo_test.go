@@ -5,20 +5,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 func TestAccResourceSlo(t *testing.T) {
-	sloName := acctest.RandomWithPrefix("terraform-acc-test-slo-")
+	sloName := testAccName("slo")
 
 	resourceName := "squadcast_slo.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSloDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSloDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSloConfig(sloName),
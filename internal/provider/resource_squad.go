@@ -25,30 +25,72 @@ func resourceSquad() *schema.Resource {
 			StateContext: resourceSquadImport,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSquadV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSquadStateUpgradeV0,
+			},
+		},
+		Schema: resourceSquadSchema(),
+	}
+}
+
+func resourceSquadSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "Squad id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description:  "Name of the Squad.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"member_ids": {
+			Description: "ids of the members of the Squad, in any order.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Set: schema.HashString,
+		},
+	}
+}
+
+// resourceSquadV0 is the schema before member_ids was changed from a list to a set. It is a frozen copy of the schema
+// of that version, later changes to the schema must not be made here.
+func resourceSquadV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "Squad id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
-				Description:  "Name of the Squad.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 1000),
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"team_id": {
-				Description:  "Team id.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: tf.ValidateObjectID,
-				ForceNew:     true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"member_ids": {
-				Description: "User ObjectId.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -57,6 +99,10 @@ func resourceSquad() *schema.Resource {
 	}
 }
 
+func resourceSquadStateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	return upgradeListsToSets(rawState, "member_ids"), nil
+}
+
 func parse2PartImportID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
 
@@ -87,16 +133,26 @@ func resourceSquadCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	})
 	squad, err := client.CreateSquad(ctx, &api.CreateSquadReq{
 		Name:      d.Get("name").(string),
-		MemberIDs: tf.ListToSlice[string](d.Get("member_ids")),
+		MemberIDs: tf.ExpandStringSet(d.Get("member_ids").(*schema.Set)),
 		TeamID:    d.Get("team_id").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "squad", d.Get("name").(string), func() (string, error) {
+			existing, err := client.GetSquadByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceSquadUpdate(ctx, d, meta)...)
 	}
 
 	d.SetId(squad.ID)
 
-	return resourceSquadRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceSquadRead)
 }
 
 func resourceSquadRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -119,11 +175,11 @@ func resourceSquadRead(ctx context.Context, d *schema.ResourceData, meta any) di
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(squad, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -134,10 +190,10 @@ func resourceSquadUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	_, err := client.UpdateSquad(ctx, d.Id(), &api.UpdateSquadReq{
 		Name:      d.Get("name").(string),
-		MemberIDs: tf.ListToSlice[string](d.Get("member_ids")),
+		MemberIDs: tf.ExpandStringSet(d.Get("member_ids").(*schema.Set)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceSquadRead(ctx, d, meta)
@@ -152,7 +208,7 @@ func resourceSquadDelete(ctx context.Context, d *schema.ResourceData, meta any)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,27 +5,26 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 func TestAccResourceSquad(t *testing.T) {
-	squadName := acctest.RandomWithPrefix("squad")
+	squadName := testAccName("squad")
 
 	resourceName := "squadcast_squad.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSquadDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSquadDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSquadConfig(squadName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "1"),
-					resource.TestCheckResourceAttr(resourceName, "member_ids.0", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "5f8891527f735f0a6646f3b6"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "name", squadName),
 				),
@@ -34,9 +33,9 @@ func TestAccResourceSquad(t *testing.T) {
 				Config: testAccResourceSquadConfig_updateMembers(squadName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
-					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "2"),
-					resource.TestCheckResourceAttr(resourceName, "member_ids.0", "5f8891527f735f0a6646f3b6"),
-					resource.TestCheckResourceAttr(resourceName, "member_ids.1", "5eb26b36ec9f070550204c85"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "5eb26b36ec9f070550204c85"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "name", squadName),
 				),
@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// ssoConfigurationResource is served by the framework provider.
+type ssoConfigurationResource struct {
+	client *api.Client
+}
+
+var (
+	_ resource.ResourceWithConfigure    = &ssoConfigurationResource{}
+	_ resource.ResourceWithImportState  = &ssoConfigurationResource{}
+	_ resource.ResourceWithUpgradeState = &ssoConfigurationResource{}
+)
+
+func newSSOConfigurationResource() resource.Resource {
+	return &ssoConfigurationResource{}
+}
+
+type ssoConfigurationModel struct {
+	ID                      types.String `tfsdk:"id"`
+	IdPMetadataURL          types.String `tfsdk:"idp_metadata_url"`
+	IdPMetadataXML          types.String `tfsdk:"idp_metadata_xml"`
+	AttributeMapping        types.Object `tfsdk:"attribute_mapping"`
+	Enforced                types.Bool   `tfsdk:"enforced"`
+	AllowOwnerPasswordLogin types.Bool   `tfsdk:"allow_owner_password_login"`
+	AutoProvisionUsers      types.Bool   `tfsdk:"auto_provision_users"`
+	SPEntityID              types.String `tfsdk:"sp_entity_id"`
+	SPACSURL                types.String `tfsdk:"sp_acs_url"`
+	Timeouts                types.Object `tfsdk:"timeouts"`
+}
+
+type ssoAttributeMappingModel struct {
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
+
+var ssoAttributeMappingAttrTypes = map[string]attr.Type{
+	"email":      types.StringType,
+	"first_name": types.StringType,
+	"last_name":  types.StringType,
+}
+
+func (r *ssoConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_configuration"
+}
+
+func (r *ssoConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the SAML single sign-on (SSO) of the organization: the metadata of the identity provider (IdP), the mapping of the attributes of its assertions and whether SSO is enforced. " +
+			"There is a single SSO configuration per organization, destroying this resource disables SSO and the users log in with their password again.",
+		// Version 1 is the first version served by the framework provider, attribute_mapping is an object rather
+		// than a list of one block.
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Organization id.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idp_metadata_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the SAML metadata of the IdP, fetched by Squadcast. Exactly one of idp_metadata_url and idp_metadata_xml must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https://\S+$`), "must be an HTTPS URL"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("idp_metadata_url"), path.MatchRoot("idp_metadata_xml")),
+				},
+			},
+			"idp_metadata_xml": schema.StringAttribute{
+				MarkdownDescription: "SAML metadata of the IdP, e.g. read with the `file` function from the metadata downloaded from the IdP.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\S`), "must not be empty or whitespace"),
+				},
+			},
+			"attribute_mapping": schema.SingleNestedAttribute{
+				MarkdownDescription: "Names of the attributes of the SAML assertions of the IdP holding the attributes of the users. Defaults to the mapping assigned by Squadcast.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						MarkdownDescription: "Attribute holding the email of the user, which identifies the user in Squadcast.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`\S`), "must not be empty or whitespace"),
+						},
+					},
+					"first_name": schema.StringAttribute{
+						MarkdownDescription: "Attribute holding the first name of the user.",
+						Optional:            true,
+					},
+					"last_name": schema.StringAttribute{
+						MarkdownDescription: "Attribute holding the last name of the user.",
+						Optional:            true,
+					},
+				},
+			},
+			"enforced": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users must log in with SSO, their password login is disabled. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaultBool(false),
+				},
+			},
+			"allow_owner_password_login": schema.BoolAttribute{
+				MarkdownDescription: "Whether the account owner can still log in with their password when SSO is enforced, to recover from a misconfigured IdP. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaultBool(true),
+				},
+			},
+			"auto_provision_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users logging in with SSO for the first time are added to the organization, rather than rejected until they are invited. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaultBool(false),
+				},
+			},
+			"sp_entity_id": schema.StringAttribute{
+				MarkdownDescription: "Entity id of Squadcast, the service provider, to set in the IdP.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sp_acs_url": schema.StringAttribute{
+				MarkdownDescription: "Assertion consumer service (ACS) URL of Squadcast, the service provider, to set in the IdP.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": frameworkTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *ssoConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The provider data is not set yet when the configuration is validated.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *api.Client, got %T.", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+// UpgradeState upgrades the states written by the SDKv2 resource, whose attribute_mapping was a list of one block and
+// whose unset strings were empty rather than null.
+func (r *ssoConfigurationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                         schema.StringAttribute{Computed: true},
+					"idp_metadata_url":           schema.StringAttribute{Optional: true},
+					"idp_metadata_xml":           schema.StringAttribute{Optional: true},
+					"enforced":                   schema.BoolAttribute{Optional: true},
+					"allow_owner_password_login": schema.BoolAttribute{Optional: true},
+					"auto_provision_users":       schema.BoolAttribute{Optional: true},
+					"sp_entity_id":               schema.StringAttribute{Computed: true},
+					"sp_acs_url":                 schema.StringAttribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"attribute_mapping": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"email":      schema.StringAttribute{Required: true},
+								"first_name": schema.StringAttribute{Optional: true},
+								"last_name":  schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"timeouts": frameworkTimeoutsBlock(),
+				},
+			},
+			StateUpgrader: upgradeSSOConfigurationStateV0,
+		},
+	}
+}
+
+type ssoConfigurationModelV0 struct {
+	ID                      types.String               `tfsdk:"id"`
+	IdPMetadataURL          types.String               `tfsdk:"idp_metadata_url"`
+	IdPMetadataXML          types.String               `tfsdk:"idp_metadata_xml"`
+	AttributeMapping        []ssoAttributeMappingModel `tfsdk:"attribute_mapping"`
+	Enforced                types.Bool                 `tfsdk:"enforced"`
+	AllowOwnerPasswordLogin types.Bool                 `tfsdk:"allow_owner_password_login"`
+	AutoProvisionUsers      types.Bool                 `tfsdk:"auto_provision_users"`
+	SPEntityID              types.String               `tfsdk:"sp_entity_id"`
+	SPACSURL                types.String               `tfsdk:"sp_acs_url"`
+	Timeouts                types.Object               `tfsdk:"timeouts"`
+}
+
+func upgradeSSOConfigurationStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior ssoConfigurationModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := ssoConfigurationModel{
+		ID:                      prior.ID,
+		IdPMetadataURL:          stringOrNull(prior.IdPMetadataURL.ValueString()),
+		IdPMetadataXML:          stringOrNull(prior.IdPMetadataXML.ValueString()),
+		AttributeMapping:        types.ObjectNull(ssoAttributeMappingAttrTypes),
+		Enforced:                prior.Enforced,
+		AllowOwnerPasswordLogin: prior.AllowOwnerPasswordLogin,
+		AutoProvisionUsers:      prior.AutoProvisionUsers,
+		SPEntityID:              prior.SPEntityID,
+		SPACSURL:                prior.SPACSURL,
+		Timeouts:                prior.Timeouts,
+	}
+	if len(prior.AttributeMapping) > 0 {
+		mapping := prior.AttributeMapping[0]
+		var diags diag.Diagnostics
+		state.AttributeMapping, diags = types.ObjectValueFrom(ctx, ssoAttributeMappingAttrTypes, ssoAttributeMappingModel{
+			Email:     mapping.Email,
+			FirstName: stringOrNull(mapping.FirstName.ValueString()),
+			LastName:  stringOrNull(mapping.LastName.ValueString()),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ssoConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != r.client.OrganizationID {
+		resp.Diagnostics.AddError("Invalid import id", fmt.Sprintf("Invalid import id %q, expected the id of the organization of the provider, %s.", req.ID, r.client.OrganizationID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *ssoConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, plan.Timeouts, "create")
+	defer cancel()
+
+	r.update(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ssoConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ssoConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, state.Timeouts, "read")
+	defer cancel()
+
+	tflog.Info(ctx, "Reading SSO configuration", tf.M{
+		"organization_id": state.ID.ValueString(),
+	})
+	config, err := r.client.GetSSOConfiguration(ctx)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			if r.client.InventoryRecordReads {
+				updateFrameworkInventory(ctx, r.client, &resp.Diagnostics, inventoryEntry{Type: "squadcast_sso_configuration", ID: state.ID.ValueString()}, true)
+			}
+			return
+		}
+		addFrameworkError(&resp.Diagnostics, "Unable to read the SSO configuration", err)
+		return
+	}
+
+	state, diags := flattenSSOConfiguration(ctx, state.ID.ValueString(), config, state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if r.client.InventoryRecordReads {
+		updateFrameworkInventory(ctx, r.client, &resp.Diagnostics, inventoryEntry{Type: "squadcast_sso_configuration", ID: state.ID.ValueString()}, false)
+	}
+}
+
+func (r *ssoConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ssoConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, plan.Timeouts, "update")
+	defer cancel()
+
+	r.update(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ssoConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ssoConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withFrameworkTimeout(ctx, state.Timeouts, "delete")
+	defer cancel()
+
+	_, err := r.client.DeleteSSOConfiguration(ctx)
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		addFrameworkError(&resp.Diagnostics, "Unable to delete the SSO configuration", err)
+		return
+	}
+	updateFrameworkInventory(ctx, r.client, &resp.Diagnostics, inventoryEntry{Type: "squadcast_sso_configuration", ID: state.ID.ValueString()}, true)
+}
+
+// update creates or updates the SSO configuration of the organization, there is a single one per organization.
+func (r *ssoConfigurationResource) update(ctx context.Context, plan ssoConfigurationModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	req, d := expandSSOConfiguration(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating SSO configuration", tf.M{
+		"organization_id": r.client.OrganizationID,
+		"enforced":        req.Enforced,
+	})
+	config, err := r.client.UpdateSSOConfiguration(ctx, req)
+	if err != nil {
+		addFrameworkError(diags, "Unable to update the SSO configuration", err)
+		return
+	}
+
+	flattened, d := flattenSSOConfiguration(ctx, r.client.OrganizationID, config, plan.Timeouts)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	// The mapping is kept as planned when the API does not return it.
+	if config.AttributeMapping == nil && !plan.AttributeMapping.IsUnknown() {
+		flattened.AttributeMapping = plan.AttributeMapping
+	}
+
+	diags.Append(state.Set(ctx, flattened)...)
+	updateFrameworkInventory(ctx, r.client, diags, inventoryEntry{Type: "squadcast_sso_configuration", ID: r.client.OrganizationID}, false)
+}
+
+func expandSSOConfiguration(ctx context.Context, plan ssoConfigurationModel) (*api.UpdateSSOConfigurationReq, diag.Diagnostics) {
+	req := &api.UpdateSSOConfigurationReq{
+		IdPMetadataURL: plan.IdPMetadataURL.ValueString(),
+		IdPMetadataXML: plan.IdPMetadataXML.ValueString(),
+		Enforced:       plan.Enforced.ValueBool(),
+		OwnerBypass:    plan.AllowOwnerPasswordLogin.ValueBool(),
+		AutoProvision:  plan.AutoProvisionUsers.ValueBool(),
+	}
+
+	// An unknown mapping is the mapping assigned by Squadcast, on creation.
+	if plan.AttributeMapping.IsNull() || plan.AttributeMapping.IsUnknown() {
+		return req, nil
+	}
+
+	var mapping ssoAttributeMappingModel
+	diags := plan.AttributeMapping.As(ctx, &mapping, basetypes.ObjectAsOptions{})
+	req.AttributeMapping = &api.SSOAttributeMapping{
+		Email:     mapping.Email.ValueString(),
+		FirstName: mapping.FirstName.ValueString(),
+		LastName:  mapping.LastName.ValueString(),
+	}
+
+	return req, diags
+}
+
+// flattenSSOConfiguration returns the state of the SSO configuration, the empty strings of the optional attributes
+// are null.
+func flattenSSOConfiguration(ctx context.Context, id string, config *api.SSOConfiguration, timeouts types.Object) (ssoConfigurationModel, diag.Diagnostics) {
+	state := ssoConfigurationModel{
+		ID:                      types.StringValue(id),
+		IdPMetadataURL:          stringOrNull(config.IdPMetadataURL),
+		IdPMetadataXML:          stringOrNull(config.IdPMetadataXML),
+		AttributeMapping:        types.ObjectNull(ssoAttributeMappingAttrTypes),
+		Enforced:                types.BoolValue(config.Enforced),
+		AllowOwnerPasswordLogin: types.BoolValue(config.OwnerBypass),
+		AutoProvisionUsers:      types.BoolValue(config.AutoProvision),
+		SPEntityID:              types.StringValue(config.EntityID),
+		SPACSURL:                types.StringValue(config.ACSURL),
+		Timeouts:                timeouts,
+	}
+	if config.AttributeMapping == nil {
+		return state, nil
+	}
+
+	var diags diag.Diagnostics
+	state.AttributeMapping, diags = types.ObjectValueFrom(ctx, ssoAttributeMappingAttrTypes, ssoAttributeMappingModel{
+		Email:     types.StringValue(config.AttributeMapping.Email),
+		FirstName: stringOrNull(config.AttributeMapping.FirstName),
+		LastName:  stringOrNull(config.AttributeMapping.LastName),
+	})
+
+	return state, diags
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestFlattenSSOConfiguration(t *testing.T) {
+	config := &api.SSOConfiguration{
+		IdPMetadataURL:   "https://idp.example.com/metadata",
+		AttributeMapping: &api.SSOAttributeMapping{Email: "mail"},
+		Enforced:         true,
+		OwnerBypass:      true,
+		EntityID:         "https://app.squadcast.com/saml",
+		ACSURL:           "https://auth.squadcast.com/saml/acs",
+	}
+	state, diags := flattenSSOConfiguration(context.Background(), "604592dabc35ea0008bb0584", config, types.ObjectNull(frameworkTimeoutsAttrTypes))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.IdPMetadataURL.ValueString() != "https://idp.example.com/metadata" || !state.IdPMetadataXML.IsNull() {
+		t.Errorf("expected the unset metadata to be null, got %v and %v", state.IdPMetadataURL, state.IdPMetadataXML)
+	}
+	want := types.ObjectValueMust(ssoAttributeMappingAttrTypes, map[string]attr.Value{
+		"email":      types.StringValue("mail"),
+		"first_name": types.StringNull(),
+		"last_name":  types.StringNull(),
+	})
+	if !state.AttributeMapping.Equal(want) {
+		t.Errorf("unexpected attribute mapping %v", state.AttributeMapping)
+	}
+}
+
+func TestSSOConfigurationUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &ssoConfigurationResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	var resp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx).(tftypes.Object)
+	mappingType := priorType.AttributeTypes["attribute_mapping"].(tftypes.List).ElementType
+	timeoutsType := priorType.AttributeTypes["timeouts"]
+	prior := tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"id":                         tftypes.NewValue(tftypes.String, "604592dabc35ea0008bb0584"),
+		"idp_metadata_url":           tftypes.NewValue(tftypes.String, ""),
+		"idp_metadata_xml":           tftypes.NewValue(tftypes.String, "<EntityDescriptor/>"),
+		"enforced":                   tftypes.NewValue(tftypes.Bool, true),
+		"allow_owner_password_login": tftypes.NewValue(tftypes.Bool, true),
+		"auto_provision_users":       tftypes.NewValue(tftypes.Bool, false),
+		"sp_entity_id":               tftypes.NewValue(tftypes.String, "https://app.squadcast.com/saml"),
+		"sp_acs_url":                 tftypes.NewValue(tftypes.String, "https://auth.squadcast.com/saml/acs"),
+		"attribute_mapping": tftypes.NewValue(priorType.AttributeTypes["attribute_mapping"], []tftypes.Value{
+			tftypes.NewValue(mappingType, map[string]tftypes.Value{
+				"email":      tftypes.NewValue(tftypes.String, "mail"),
+				"first_name": tftypes.NewValue(tftypes.String, "givenName"),
+				"last_name":  tftypes.NewValue(tftypes.String, ""),
+			}),
+		}),
+		"timeouts": tftypes.NewValue(timeoutsType, nil),
+	})
+
+	upgradeResp := &fwresource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: resp.Schema},
+	}
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior}}, upgradeResp)
+	if upgradeResp.Diagnostics.HasError() {
+		t.Fatal(upgradeResp.Diagnostics)
+	}
+
+	var state ssoConfigurationModel
+	if diags := upgradeResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !state.IdPMetadataURL.IsNull() || state.IdPMetadataXML.ValueString() != "<EntityDescriptor/>" {
+		t.Errorf("expected the empty metadata URL to be null, got %v and %v", state.IdPMetadataURL, state.IdPMetadataXML)
+	}
+	want := types.ObjectValueMust(ssoAttributeMappingAttrTypes, map[string]attr.Value{
+		"email":      types.StringValue("mail"),
+		"first_name": types.StringValue("givenName"),
+		"last_name":  types.StringNull(),
+	})
+	if !state.AttributeMapping.Equal(want) {
+		t.Errorf("expected the block to become an object, got %v", state.AttributeMapping)
+	}
+	if !state.Enforced.ValueBool() || state.ID.ValueString() != "604592dabc35ea0008bb0584" {
+		t.Errorf("unexpected state %+v", state)
+	}
+}
+
+func TestAccResourceSSOConfiguration(t *testing.T) {
+	resourceName := "squadcast_sso_configuration.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSSOConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSSOConfigurationConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "idp_metadata_url", "https://idp.example.com/metadata"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.email", "mail"),
+					resource.TestCheckResourceAttr(resourceName, "enforced", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_owner_password_login", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "sp_entity_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "sp_acs_url"),
+				),
+			},
+			{
+				Config: testAccResourceSSOConfigurationConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforced", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSSOConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_sso_configuration" {
+			continue
+		}
+
+		_, err := client.GetSSOConfiguration(context.Background())
+		if err == nil {
+			return fmt.Errorf("expected SSO to be disabled, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceSSOConfigurationConfig(enforced bool) string {
+	return fmt.Sprintf(`
+resource "squadcast_sso_configuration" "test" {
+	idp_metadata_url = "https://idp.example.com/metadata"
+
+	attribute_mapping = {
+		email = "mail"
+		first_name = "givenName"
+		last_name = "sn"
+	}
+
+	enforced = %t
+}
+	`, enforced)
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceStakeholderGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Stakeholder groups are lists of users of a team, e.g. the stakeholders of a product, who are kept informed of the incidents of the team. " +
+			"The members of a group can be generated from other data, e.g. an org chart, and are replaced as a whole on every apply.",
+
+		CreateContext: resourceStakeholderGroupCreate,
+		ReadContext:   resourceStakeholderGroupRead,
+		UpdateContext: resourceStakeholderGroupUpdate,
+		DeleteContext: resourceStakeholderGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStakeholderGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Stakeholder group id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the stakeholder group.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description: "Description of the stakeholder group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"member_ids": {
+				Description: "ids of the users who are members of the stakeholder group, in any order.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceStakeholderGroupImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceStakeholderGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating stakeholder group", tf.M{
+		"name": d.Get("name").(string),
+	})
+	group, err := client.CreateStakeholderGroup(ctx, &api.CreateStakeholderGroupReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TeamID:      d.Get("team_id").(string),
+		MemberIDs:   tf.ExpandStringSet(d.Get("member_ids").(*schema.Set)),
+	})
+	if err != nil {
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "stakeholder group", d.Get("name").(string), func() (string, error) {
+			existing, err := client.GetStakeholderGroupByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceStakeholderGroupUpdate(ctx, d, meta)...)
+	}
+
+	d.SetId(group.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceStakeholderGroupRead)
+}
+
+func resourceStakeholderGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading stakeholder group", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	group, err := client.GetStakeholderGroupById(ctx, teamID.(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(group, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStakeholderGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateStakeholderGroup(ctx, d.Id(), &api.UpdateStakeholderGroupReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		MemberIDs:   tf.ExpandStringSet(d.Get("member_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceStakeholderGroupRead(ctx, d, meta)
+}
+
+func resourceStakeholderGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteStakeholderGroup(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
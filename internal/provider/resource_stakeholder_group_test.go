@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestResourceStakeholderGroupRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/stakeholder-groups/62da76c088f407f9ca756ca5" || r.URL.Query().Get("owner_id") != "613611c1eb22db455cfa789f" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"id":"62da76c088f407f9ca756ca5","name":"checkout","description":"Stakeholders of checkout","owner":{"id":"613611c1eb22db455cfa789f","type":"team"},"members":["5f8891527f735f0a6646f3b6","5eb26b36ec9f070550204c85"]}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceStakeholderGroup()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"team_id": "613611c1eb22db455cfa789f"})
+	d.SetId("62da76c088f407f9ca756ca5")
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("name") != "checkout" || d.Get("description") != "Stakeholders of checkout" || d.Get("team_id") != "613611c1eb22db455cfa789f" {
+		t.Errorf("unexpected stakeholder group %v", d.State().Attributes)
+	}
+	if members := d.Get("member_ids").(*schema.Set); members.Len() != 2 || !members.Contains("5eb26b36ec9f070550204c85") {
+		t.Errorf("unexpected members %v", members.List())
+	}
+}
+
+func TestAccResourceStakeholderGroup(t *testing.T) {
+	groupName := testAccName("stakeholder_group")
+
+	resourceName := "squadcast_stakeholder_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckStakeholderGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStakeholderGroupConfig(groupName, `"5f8891527f735f0a6646f3b6"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "5f8891527f735f0a6646f3b6"),
+				),
+			},
+			{
+				Config: testAccResourceStakeholderGroupConfig(groupName, `"5f8891527f735f0a6646f3b6", "5eb26b36ec9f070550204c85"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", "5eb26b36ec9f070550204c85"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "613611c1eb22db455cfa789f:",
+			},
+		},
+	})
+}
+
+func testAccCheckStakeholderGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_stakeholder_group" {
+			continue
+		}
+
+		_, err := client.GetStakeholderGroupById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected stakeholder group to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStakeholderGroupConfig(groupName string, memberIDs string) string {
+	return fmt.Sprintf(`
+resource "squadcast_stakeholder_group" "test" {
+	name = "%s"
+	description = "Stakeholders of the tests"
+	team_id = "613611c1eb22db455cfa789f"
+	member_ids = [%s]
+}
+	`, groupName, memberIDs)
+}
@@ -63,9 +63,11 @@ func resourceStatusPage() *schema.Resource {
 				Optional:    true,
 			},
 			"timezone": {
-				Description: "Timezone for the status page.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:           "Timezone for the status page.",
+				Type:                  schema.TypeString,
+				Required:              true,
+				DiffSuppressFunc:      tf.SuppressEquivalentTimezones,
+				DiffSuppressOnRefresh: true,
 			},
 			"contact_email": {
 				Description: "Contact email.",
@@ -115,22 +117,22 @@ func resourceStatusPage() *schema.Resource {
 				},
 			},
 			"allow_webhook_subscription": {
-				Description: "Determines if webhook subscription is allowed to the status page.",
+				Description: "Determines if webhook subscription is allowed to the status page. When not set, the setting of the status page is left as is, e.g. as enabled from the Squadcast web app.",
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
+				Computed:    true,
 			},
 			"allow_maintenance_subscription": {
-				Description: "Determines if maintenance subscription is allowed to the status page.",
+				Description: "Determines if maintenance subscription is allowed to the status page. When not set, the setting of the status page is left as is, e.g. as enabled from the Squadcast web app.",
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
+				Computed:    true,
 			},
 			"allow_components_subscription": {
-				Description: "Determines if components subscription is allowed to the status page.",
+				Description: "Determines if components subscription is allowed to the status page. When not set, the setting of the status page is left as is, e.g. as enabled from the Squadcast web app.",
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
+				Computed:    true,
 			},
 		},
 	}
@@ -171,27 +173,27 @@ func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 	ownerData, err := tf.ExtractData(d, "owner")
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	createStatusPageReq.OwnerID = ownerData["id"].(string)
 	createStatusPageReq.OwnerType = ownerData["type"].(string)
 
 	themeColor, err := tf.ExtractData(d, "theme_color")
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	createStatusPageReq.ThemeColor.Primary = themeColor["primary"].(string)
 	createStatusPageReq.ThemeColor.Secondary = themeColor["secondary"].(string)
 
 	sp, err := client.CreateStatusPage(ctx, createStatusPageReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id := strconv.FormatUint(uint64(sp.ID), 10)
 	d.SetId(id)
 
-	return resourceStatusPageRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceStatusPageRead)
 }
 
 func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -208,11 +210,11 @@ func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(sp, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -247,21 +249,21 @@ func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	ownerData, err := tf.ExtractData(d, "owner")
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	updateStatusPageReq.OwnerID = ownerData["id"].(string)
 	updateStatusPageReq.OwnerType = ownerData["type"].(string)
 
 	themeColor, err := tf.ExtractData(d, "theme_color")
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	updateStatusPageReq.ThemeColor.Primary = themeColor["primary"].(string)
 	updateStatusPageReq.ThemeColor.Secondary = themeColor["secondary"].(string)
 
 	_, err = client.UpdateStatusPage(ctx, d.Id(), updateStatusPageReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceStatusPageRead(ctx, d, meta)
@@ -276,7 +278,7 @@ func resourceStatusPageDelete(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -77,7 +77,7 @@ func resourceStatusPageComponentCreate(ctx context.Context, d *schema.ResourceDa
 	if d.Get("group_id").(string) != "" {
 		groupId, err := strconv.ParseInt(d.Get("group_id").(string), 10, 64)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		groupID := uint(groupId)
 		createStatusPageComponentReq.GroupID = &groupID
@@ -85,13 +85,13 @@ func resourceStatusPageComponentCreate(ctx context.Context, d *schema.ResourceDa
 
 	spc, err := client.CreateStatusPageComponent(ctx, d.Get("status_page_id").(string), createStatusPageComponentReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id := strconv.FormatUint(uint64(spc.ID), 10)
 	d.SetId(id)
 
-	return resourceStatusPageComponentRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceStatusPageComponentRead)
 }
 
 func resourceStatusPageComponentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -108,11 +108,11 @@ func resourceStatusPageComponentRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(spc, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -132,7 +132,7 @@ func resourceStatusPageComponentUpdate(ctx context.Context, d *schema.ResourceDa
 	} else {
 		groupId, err := strconv.ParseInt(d.Get("group_id").(string), 10, 64)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		flag := true
 		groupID := uint(groupId)
@@ -142,7 +142,7 @@ func resourceStatusPageComponentUpdate(ctx context.Context, d *schema.ResourceDa
 
 	_, err := client.UpdateStatusPageComponent(ctx, d.Get("status_page_id").(string), d.Id(), updateStatusPageReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceStatusPageComponentRead(ctx, d, meta)
@@ -158,7 +158,7 @@ func resourceStatusPageComponentDelete(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,20 +5,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 func TestAccResourceStatusPageComponent(t *testing.T) {
-	statusPageComponentName := acctest.RandomWithPrefix("statusPageComponent")
+	statusPageComponentName := testAccName("statusPageComponent")
 
 	resourceName := "squadcast_status_page_component.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckStatusPageComponentDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageComponentDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceStatusPageComponentConfig(statusPageComponentName),
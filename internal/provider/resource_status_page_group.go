@@ -65,13 +65,13 @@ func resourceStatusPageGroupCreate(ctx context.Context, d *schema.ResourceData,
 
 	spg, err := client.CreateStatusPageGroup(ctx, d.Get("status_page_id").(string), createStatusPageGroupReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id := strconv.FormatUint(uint64(spg.ID), 10)
 	d.SetId(id)
 
-	return resourceStatusPageGroupRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceStatusPageGroupRead)
 }
 
 func resourceStatusPageGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -88,11 +88,11 @@ func resourceStatusPageGroupRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(spg, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -107,7 +107,7 @@ func resourceStatusPageGroupUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err := client.UpdateStatusPageGroup(ctx, d.Get("status_page_id").(string), d.Id(), updateStatusPageGroupReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceStatusPageGroupRead(ctx, d, meta)
@@ -123,7 +123,7 @@ func resourceStatusPageGroupDelete(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
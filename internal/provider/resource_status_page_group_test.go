@@ -5,20 +5,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 func TestAccResourceStatusPageGroup(t *testing.T) {
-	statusPageGroupName := acctest.RandomWithPrefix("statusPageGroup")
+	statusPageGroupName := testAccName("statusPageGroup")
 
 	resourceName := "squadcast_status_page_group.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckStatusPageGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceStatusPageGroupConfig(statusPageGroupName),
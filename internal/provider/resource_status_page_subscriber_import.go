@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// statusPageSubscribersBatchSize is the maximum number of emails sent in a single add or remove request.
+const statusPageSubscribersBatchSize = 500
+
+func resourceStatusPageSubscriberImport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the email subscribers of a status page from a single list of emails. The emails are compared with the subscribers of the status page and only the difference is added or removed, in batches. Subscribers that signed up on the status page themselves are left untouched.",
+
+		CreateContext: resourceStatusPageSubscriberImportCreate,
+		ReadContext:   resourceStatusPageSubscriberImportRead,
+		UpdateContext: resourceStatusPageSubscriberImportUpdate,
+		DeleteContext: resourceStatusPageSubscriberImportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageSubscriberImportImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description: "Id of the status page.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"emails": {
+				Description: "Emails of the subscribers.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v any, key string) ([]string, []error) {
+						if _, err := mail.ParseAddress(v.(string)); err != nil {
+							return nil, []error{fmt.Errorf("%s must be a valid email address, got: %s", key, v)}
+						}
+						return nil, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStatusPageSubscriberImportImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	// All the subscribers of the status page are adopted on import.
+	subscribers, err := client.ListStatusPageSubscribers(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, len(subscribers))
+	for i, subscriber := range subscribers {
+		emails[i] = subscriber.Email
+	}
+	d.Set("status_page_id", d.Id())
+	d.Set("emails", emails)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceStatusPageSubscriberImportCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	pageID := d.Get("status_page_id").(string)
+	if err := updateStatusPageSubscribers(ctx, client, pageID, nil, tf.ExpandStringSet(d.Get("emails").(*schema.Set))); err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(pageID)
+
+	return readAfterCreate(ctx, d, meta, resourceStatusPageSubscriberImportRead)
+}
+
+func resourceStatusPageSubscriberImportRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading status page subscribers", tf.M{
+		"status_page_id": d.Id(),
+	})
+	subscribers, err := client.ListStatusPageSubscribers(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	// Only the managed emails are read back, as configured, so that self-signed-up subscribers do not show up as drift.
+	managed := map[string]string{}
+	for _, email := range tf.ExpandStringSet(d.Get("emails").(*schema.Set)) {
+		managed[strings.ToLower(email)] = email
+	}
+	emails := make([]string, 0, len(managed))
+	for _, subscriber := range subscribers {
+		if email, ok := managed[strings.ToLower(subscriber.Email)]; ok {
+			emails = append(emails, email)
+		}
+	}
+
+	if err = d.Set("status_page_id", d.Id()); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("emails", emails); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageSubscriberImportUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	o, n := d.GetChange("emails")
+	if err := updateStatusPageSubscribers(ctx, client, d.Id(), tf.ExpandStringSet(o.(*schema.Set)), tf.ExpandStringSet(n.(*schema.Set))); err != nil {
+		// Keep the previous emails in the state, the next refresh reads the ones that were applied.
+		d.Partial(true)
+		return diagFromErr(err)
+	}
+
+	return resourceStatusPageSubscriberImportRead(ctx, d, meta)
+}
+
+func resourceStatusPageSubscriberImportDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	err := updateStatusPageSubscribers(ctx, client, d.Id(), tf.ExpandStringSet(d.Get("emails").(*schema.Set)), nil)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// updateStatusPageSubscribers adds and removes subscribers of the status page, in batches, so that the managed
+// emails go from old to new.
+func updateStatusPageSubscribers(ctx context.Context, client *api.Client, pageID string, old, new []string) error {
+	subscribers, err := client.ListStatusPageSubscribers(ctx, pageID)
+	if err != nil {
+		return err
+	}
+
+	current := make([]string, len(subscribers))
+	for i, subscriber := range subscribers {
+		current[i] = subscriber.Email
+	}
+
+	add, remove := diffStatusPageSubscribers(current, old, new)
+	tflog.Info(ctx, "Updating status page subscribers", tf.M{
+		"status_page_id": pageID,
+		"add":            len(add),
+		"remove":         len(remove),
+	})
+
+	for _, batch := range chunkStrings(add, statusPageSubscribersBatchSize) {
+		if _, err := client.AddStatusPageSubscribers(ctx, pageID, &api.StatusPageSubscribersReq{Emails: batch}); err != nil {
+			return err
+		}
+	}
+	for _, batch := range chunkStrings(remove, statusPageSubscribersBatchSize) {
+		if _, err := client.RemoveStatusPageSubscribers(ctx, pageID, &api.StatusPageSubscribersReq{Emails: batch}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffStatusPageSubscribers returns the emails of new that are not subscribed yet, and the emails of old that
+// are no longer in new but still subscribed. Emails are compared case-insensitively.
+func diffStatusPageSubscribers(current, old, new []string) (add, remove []string) {
+	subscribed := emailSet(current)
+	desired := emailSet(new)
+	for _, email := range new {
+		if !subscribed[strings.ToLower(email)] {
+			add = append(add, email)
+		}
+	}
+	for _, email := range old {
+		if !desired[strings.ToLower(email)] && subscribed[strings.ToLower(email)] {
+			remove = append(remove, email)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	return add, remove
+}
+
+func emailSet(emails []string) map[string]bool {
+	set := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		set[strings.ToLower(email)] = true
+	}
+
+	return set
+}
+
+func chunkStrings(s []string, size int) [][]string {
+	var chunks [][]string
+	for len(s) > size {
+		chunks = append(chunks, s[:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+
+	return chunks
+}
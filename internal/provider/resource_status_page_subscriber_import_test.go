@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDiffStatusPageSubscribers(t *testing.T) {
+	current := []string{"a@example.com", "B@example.com", "self@example.com", "c@example.com"}
+	old := []string{"a@example.com", "b@example.com", "c@example.com", "gone@example.com"}
+	new := []string{"b@example.com", "c@example.com", "d@example.com"}
+
+	add, remove := diffStatusPageSubscribers(current, old, new)
+
+	if !reflect.DeepEqual(add, []string{"d@example.com"}) {
+		t.Errorf("expected d@example.com to be added, got %#v", add)
+	}
+	if !reflect.DeepEqual(remove, []string{"a@example.com"}) {
+		t.Errorf("expected a@example.com to be removed, got %#v", remove)
+	}
+}
+
+func TestChunkStrings(t *testing.T) {
+	s := make([]string, 1201)
+	chunks := chunkStrings(s, 500)
+
+	if len(chunks) != 3 || len(chunks[0]) != 500 || len(chunks[1]) != 500 || len(chunks[2]) != 201 {
+		t.Errorf("expected chunks of 500, 500 and 201, got %d chunks", len(chunks))
+	}
+	if chunks := chunkStrings(nil, 500); len(chunks) != 0 {
+		t.Errorf("expected no chunks, got %d", len(chunks))
+	}
+}
+
+func TestAccResourceStatusPageSubscriberImport(t *testing.T) {
+	prefix := acctest.RandString(8)
+
+	resourceName := "squadcast_status_page_subscriber_import.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckStatusPageSubscriberImportDestroy(prefix),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageSubscriberImportConfig(prefix, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", prefix+"-0@example.com"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageSubscriberImportConfig(prefix, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "emails.*", prefix+"-1@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageSubscriberImportDestroy(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "squadcast_status_page_subscriber_import" {
+				continue
+			}
+
+			subscribers, err := client.ListStatusPageSubscribers(context.Background(), rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			for _, subscriber := range subscribers {
+				if strings.HasPrefix(subscriber.Email, prefix) {
+					return fmt.Errorf("expected subscriber to be removed, %s found", subscriber.Email)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceStatusPageSubscriberImportConfig(prefix string, count int) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page_subscriber_import" "test" {
+	status_page_id = "100"
+	emails = [for i in range(%d) : "%s-${i}@example.com"]
+}
+	`, count, prefix)
+}
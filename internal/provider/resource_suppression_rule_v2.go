@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceSuppressionRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "A single [suppression rule](https://support.squadcast.com/docs/alert-suppression) of a service, managed by its id. " +
+			"Unlike `squadcast_suppression_rules`, which owns the whole list of rules, this resource only appends, updates and deletes its own rule, " +
+			"so that several modules or teams can manage their rules on a shared service. Do not use both resources for the same service.",
+
+		CreateContext: resourceSuppressionRuleV2Create,
+		ReadContext:   resourceSuppressionRuleV2Read,
+		UpdateContext: resourceSuppressionRuleV2Update,
+		DeleteContext: resourceSuppressionRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServiceRuleV2,
+		},
+
+		CustomizeDiff: resourceSuppressionRuleV2CustomizeDiff,
+
+		Schema: serviceRuleV2Schema(resourceSuppressionRules()),
+	}
+}
+
+func resourceSuppressionRuleV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for i, timeSlot := range d.Get("timeslots").([]any) {
+		mtimeSlot, ok := timeSlot.(tf.M)
+		if !ok {
+			continue
+		}
+
+		if err := validateSuppressionRuleTimeSlot(mtimeSlot); err != nil {
+			return fmt.Errorf("timeslots.%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeSuppressionRuleV2(d *schema.ResourceData) (*api.SuppressionRule, error) {
+	rules, err := decodeSuppressionRules([]any{serviceRuleV2Fields(resourceSuppressionRules(), d.Get)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rules[0], nil
+}
+
+func resourceSuppressionRuleV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := decodeSuppressionRuleV2(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating suppression_rule_v2", tf.M{
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	suppressionRule, err := client.CreateSuppressionRuleV2(ctx, d.Get("service_id").(string), rule)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(suppressionRule.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceSuppressionRuleV2Read)
+}
+
+func resourceSuppressionRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading suppression_rule_v2", tf.M{
+		"id":         d.Id(),
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	suppressionRule, err := client.GetSuppressionRuleV2(ctx, d.Get("service_id").(string), d.Get("team_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(suppressionRule, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSuppressionRuleV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := decodeSuppressionRuleV2(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = client.UpdateSuppressionRuleV2(ctx, d.Get("service_id").(string), d.Id(), rule)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceSuppressionRuleV2Read(ctx, d, meta)
+}
+
+func resourceSuppressionRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteSuppressionRuleV2(ctx, d.Get("service_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceSuppressionRuleV2(t *testing.T) {
+	resourceName := "squadcast_suppression_rule_v2.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSuppressionRuleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSuppressionRuleV2Config(""),
+				ExpectError: regexp.MustCompile("custom.repeats_on_weekdays must be set when custom.repeats is set to 'week'"),
+			},
+			{
+				Config: testAccResourceSuppressionRuleV2Config("repeats_on_weekdays = [6]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "every saturday 02:00 - 04:00"),
+					resource.TestCheckResourceAttr(resourceName, "is_timebased", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeslots.0.custom.0.repeats_on_weekdays.0", "6"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd:" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSuppressionRuleV2Destroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_suppression_rule_v2" {
+			continue
+		}
+
+		_, err := client.GetSuppressionRuleV2(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected suppression rule to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceSuppressionRuleV2Config(weekdays string) string {
+	return fmt.Sprintf(`
+resource "squadcast_suppression_rule_v2" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	is_basic = false
+	description = "every saturday 02:00 - 04:00"
+	expression = "payload[\"event_id\"] == 40"
+
+	timeslots {
+		time_zone = "Asia/Calcutta"
+		start_time = "2032-06-05T02:00:00Z"
+		end_time = "2032-06-05T04:00:00Z"
+		ends_on = "2032-06-05T04:00:00Z"
+		repetition = "custom"
+		ends_never = true
+
+		custom {
+			repeats = "week"
+			repeats_count = 1
+			%s
+		}
+	}
+}
+	`, weekdays)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,7 +20,7 @@ func resourceSuppressionRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Suppression rules](https://support.squadcast.com/docs/alert-suppression) can help you avoid alert fatigue by suppressing notifications for non-actionable alerts." +
 
-			"Squadcast will suppress the incidents that match any of the Suppression Rules you create for your Services. These incidents will go into the Suppressed state and you will not get any notifications for them",
+			"Squadcast will suppress the incidents that match any of the Suppression Rules you create for your Services. These incidents will go into the Suppressed state and you will not get any notifications for them. This resource manages all the suppression rules of the service: rules created outside of it are removed on its next update. Use `squadcast_suppression_rule_v2` to manage the suppression rules of a shared service from several configurations.",
 
 		CreateContext: resourceSuppressionRulesCreate,
 		ReadContext:   resourceSuppressionRulesRead,
@@ -29,6 +30,8 @@ func resourceSuppressionRules() *schema.Resource {
 			StateContext: resourceSuppressionRulesImport,
 		},
 
+		CustomizeDiff: resourceSuppressionRulesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "id.",
@@ -54,6 +57,7 @@ func resourceSuppressionRules() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"enabled": enabledSchema("Whether the rule suppresses the matching alerts, e.g. disable it to get notified of them during an incident."),
 						"is_basic": {
 							Description: "is_basic will be true when users use the drop down selectors which will have lhs, op & rhs value, whereas it will be false when they use the advanced mode and it would have the expression for it's value",
 							Type:        schema.TypeBool,
@@ -81,31 +85,28 @@ func resourceSuppressionRules() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"time_zone": {
-										Description: "Time zone for the time slot",
-										Type:        schema.TypeString,
-										Required:    true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Description:  "Time zone for the time slot",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: tf.ValidateTimezone,
 									},
 									"start_time": {
-										Description: "Defines the start date of the time slot",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Defines the start date of the time slot",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
 									},
 									"end_time": {
-										Description: "Defines the end date of the time slot",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Defines the end date of the time slot",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
 									},
 									"ends_on": {
-										Description: "Defines the end date of the repetition",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Defines the end date of the repetition",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
 									},
 									"repetition": {
 										Description:  "Defines the repetition of the time slot",
@@ -114,14 +115,16 @@ func resourceSuppressionRules() *schema.Resource {
 										ValidateFunc: validation.StringInSlice([]string{"none", "daily", "weekly", "monthly", "custom"}, false),
 									},
 									"is_allday": {
-										Description: "Defines if the time slot is an all day slot",
+										Description: "Defines if the time slot is an all day slot. Defaults to `false`.",
 										Type:        schema.TypeBool,
 										Optional:    true,
+										Default:     false,
 									},
 									"ends_never": {
-										Description: "Defines whether the time slot ends or not",
+										Description: "Defines whether the time slot ends or not. Defaults to `false`.",
 										Type:        schema.TypeBool,
 										Optional:    true,
+										Default:     false,
 									},
 									"is_custom": {
 										Description: "Defines whether repetition is custom or not",
@@ -132,6 +135,7 @@ func resourceSuppressionRules() *schema.Resource {
 										Description: "Use this field to specify the custom time slots for which this rule should be applied. This field is only applicable when the repetition field is set to custom.",
 										Type:        schema.TypeList,
 										Optional:    true,
+										MaxItems:    1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"repeats": {
@@ -141,9 +145,10 @@ func resourceSuppressionRules() *schema.Resource {
 													ValidateFunc: validation.StringInSlice([]string{"day", "week", "month"}, false),
 												},
 												"repeats_count": {
-													Description: "Number of times to repeat.",
-													Type:        schema.TypeInt,
-													Optional:    true,
+													Description:  "Number of times to repeat.",
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
 												},
 												"repeats_on_month": {
 													Description: "Repeats on month.",
@@ -211,10 +216,97 @@ func resourceSuppressionRulesImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceSuppressionRulesCustomizeDiff validates the recurrence of the time based rules at plan time,
+// so that an invalid timeslot does not surface only once the rules are sent to the API.
+func resourceSuppressionRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return validateSuppressionRulesTimeSlots("rules", d.Get("rules").([]any))
+}
+
+func validateSuppressionRulesTimeSlots(key string, mrules []any) error {
+	for i, rule := range mrules {
+		mrule, ok := rule.(tf.M)
+		if !ok {
+			continue
+		}
+
+		for j, timeSlot := range mrule["timeslots"].([]any) {
+			mtimeSlot, ok := timeSlot.(tf.M)
+			if !ok {
+				continue
+			}
+
+			if err := validateSuppressionRuleTimeSlot(mtimeSlot); err != nil {
+				return fmt.Errorf("%s.%d.timeslots.%d: %w", key, i, j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSuppressionRuleTimeSlot(mtimeSlot tf.M) error {
+	repetition, _ := mtimeSlot["repetition"].(string)
+	mcustom, _ := mtimeSlot["custom"].([]any)
+
+	if repetition == "custom" {
+		if len(mcustom) == 0 || mcustom[0] == nil {
+			return fmt.Errorf("custom cannot be empty when repetition is set to 'custom'")
+		}
+
+		custom := mcustom[0].(tf.M)
+		repeats, _ := custom["repeats"].(string)
+		weekdays, _ := custom["repeats_on_weekdays"].([]any)
+
+		switch {
+		case repeats == "week" && len(weekdays) == 0:
+			return fmt.Errorf("custom.repeats_on_weekdays must be set when custom.repeats is set to 'week'")
+		case repeats != "" && repeats != "week" && len(weekdays) != 0:
+			return fmt.Errorf("custom.repeats_on_weekdays cannot be set when custom.repeats is not set to 'week'")
+		}
+	} else if len(mcustom) != 0 && repetition != "" {
+		return fmt.Errorf("custom can only be set when repetition is set to 'custom'")
+	}
+
+	// Unknown values are empty strings at plan time, skip the ordering checks until they are known.
+	startTime, err := parseOptionalRFC3339(mtimeSlot["start_time"])
+	if err != nil || startTime == nil {
+		return nil
+	}
+
+	endTime, err := parseOptionalRFC3339(mtimeSlot["end_time"])
+	if err == nil && endTime != nil && !endTime.After(*startTime) {
+		return fmt.Errorf("end_time must be after start_time")
+	}
+
+	endsNever, _ := mtimeSlot["ends_never"].(bool)
+	endsOn, err := parseOptionalRFC3339(mtimeSlot["ends_on"])
+	if !endsNever && repetition != "none" && err == nil && endsOn != nil && endsOn.Before(*startTime) {
+		return fmt.Errorf("ends_on must not be before start_time")
+	}
+
+	return nil
+}
+
+func parseOptionalRFC3339(v any) (*time.Time, error) {
+	s, _ := v.(string)
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Decode decodes the state into output with mapstructure. Prefer tf.Decode, which reports type mismatches with the path of the offending value.
 func Decode(input any, output any) error {
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		Result:               output,
 		TagName:              tf.EncoderStructTag,
+		DecodeHook:           decodeEnabledHook,
 		ZeroFields:           true,
 		IgnoreUntaggedFields: true,
 	})
@@ -230,11 +322,9 @@ func Decode(input any, output any) error {
 	return nil
 }
 
-func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
-
+// decodeSuppressionRules converts the rules from the state into API rules, expanding the custom repetition of the timeslots.
+func decodeSuppressionRules(mrules []any) ([]api.SuppressionRule, error) {
 	var rules []api.SuppressionRule
-	mrules := d.Get("rules").([]interface{})
 	// if repetition is custom, convert timeslots.custom in each rule from list to map
 	for i, rule := range mrules {
 		mrule := rule.(map[string]interface{})
@@ -262,7 +352,7 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 						]
 				****************************************************/
 				if len(mtimeSlot["custom"].([]interface{})) == 0 {
-					return diag.Errorf("timeslots.custom cannot be empty when timeslots.repetition is set to 'custom'")
+					return nil, fmt.Errorf("timeslots.custom cannot be empty when timeslots.repetition is set to 'custom'")
 				}
 				mcustom := mtimeSlot["custom"].([]interface{})[0].(map[string]interface{})
 				mrepeats := mcustom["repeats"].(string)
@@ -284,7 +374,7 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 					repeatsOnMonth = "date-occurrence"
 				default:
 					if len(mrepeatOnWeekdays) != 0 {
-						return diag.Errorf("timeslots.custom.repeats_on_weekdays cannot be set when timeslots.custom.repeats is not set to 'week'")
+						return nil, fmt.Errorf("timeslots.custom.repeats_on_weekdays cannot be set when timeslots.custom.repeats is not set to 'week'")
 					}
 					repeatOnWeekdays = nil
 				}
@@ -301,7 +391,7 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 			var timeslots []*api.TimeSlot
 			err := Decode(mtimeSlots, &timeslots)
 			if err != nil {
-				return diag.FromErr(err)
+				return nil, err
 			}
 			mrules[i].(map[string]interface{})["is_timebased"] = true
 			mrules[i].(map[string]interface{})["timeslots"] = timeslots
@@ -310,7 +400,18 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 
 	err := Decode(mrules, &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rules, err := decodeSuppressionRules(d.Get("rules").([]any))
+	if err != nil {
+		return diagFromErr(err)
 	}
 	tflog.Info(ctx, "Creating suppression_rules", tf.M{
 		"team_id":    d.Get("team_id").(string),
@@ -319,12 +420,12 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = client.UpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateSuppressionRulesReq{Rules: rules})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(suppressionRulesID)
 
-	return resourceSuppressionRulesRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceSuppressionRulesRead)
 }
 
 func resourceSuppressionRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -347,11 +448,11 @@ func resourceSuppressionRulesRead(ctx context.Context, d *schema.ResourceData, m
 	})
 	suppressionRules, err := client.GetSuppressionRules(ctx, serviceID.(string), teamID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(suppressionRules, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -360,71 +461,24 @@ func resourceSuppressionRulesRead(ctx context.Context, d *schema.ResourceData, m
 func resourceSuppressionRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var rules []api.SuppressionRule
-	mrules := d.Get("rules").([]interface{})
-	for i, rule := range mrules {
-		mrule := rule.(map[string]interface{})
-		mtimeSlots := mrule["timeslots"].([]interface{})
-		if len(mtimeSlots) != 0 {
-			for _, mtimeSlot := range mtimeSlots {
-				mtimeSlot := mtimeSlot.(map[string]interface{})
-				if mtimeSlot["repetition"] != "custom" {
-					mtimeSlot["custom"] = nil
-					continue
-				}
-				if len(mtimeSlot["custom"].([]interface{})) == 0 {
-					return diag.Errorf("timeslots.custom cannot be empty when timeslots.repetition is set to 'custom'")
-				}
-				mcustom := mtimeSlot["custom"].([]interface{})[0].(map[string]interface{})
-				mrepeats := mcustom["repeats"].(string)
-				mrepeatOnWeekdays := mcustom["repeats_on_weekdays"].([]interface{})
-				repeatOnWeekdays := make([]int, len(mrepeatOnWeekdays))
-				repeatsOnMonth := ""
-
-				switch mrepeats {
-				case "week":
-					for i, v := range mrepeatOnWeekdays {
-						repeatOnWeekdays[i] = v.(int)
-					}
-				case "month":
-					repeatsOnMonth = "date-occurrence"
-				default:
-					if len(mrepeatOnWeekdays) != 0 {
-						return diag.Errorf("timeslots.custom.repeats_on_weekdays cannot be set when timeslots.custom.repeats is not set to 'week'")
-					}
-					repeatOnWeekdays = nil
-				}
-
-				mtimeSlot["custom"] = api.CustomTime{
-					RepeatsOnMonth:    repeatsOnMonth,
-					RepeatsOnWeekdays: repeatOnWeekdays,
-					RepeatsCount:      mcustom["repeats_count"].(int),
-					Repeats:           mrepeats,
-				}
-				mtimeSlot["is_custom"] = true
-			}
-			var timeslots []*api.TimeSlot
-			err := Decode(mtimeSlots, &timeslots)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			mrules[i].(map[string]interface{})["is_timebased"] = true
-			mrules[i].(map[string]interface{})["timeslots"] = timeslots
-		}
-	}
-
-	err := Decode(mrules, &rules)
+	rules, err := decodeSuppressionRules(d.Get("rules").([]any))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	tflog.Info(ctx, "Creating suppression_rules", tf.M{
 		"team_id":    d.Get("team_id").(string),
 		"service_id": d.Get("service_id").(string),
 	})
 
-	_, err = client.UpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateSuppressionRulesReq{Rules: rules})
+	oldRules, _ := d.GetChange("rules")
+	base, err := decodeSuppressionRules(oldRules.([]any))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceSuppressionRulesRead(ctx, d, meta)
@@ -433,9 +487,14 @@ func resourceSuppressionRulesUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceSuppressionRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	_, err := client.UpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateSuppressionRulesReq{Rules: []api.SuppressionRule{}})
+	base, err := decodeSuppressionRules(d.Get("rules").([]any))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, []api.SuppressionRule{})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
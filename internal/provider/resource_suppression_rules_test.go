@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,9 +14,9 @@ import (
 func TestAccResourceSuppressionRules(t *testing.T) {
 	resourceName := "squadcast_suppression_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckSuppressionRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSuppressionRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceSuppressionRulesConfig(),
@@ -59,6 +60,43 @@ func TestAccResourceSuppressionRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceSuppressionRulesTimeSlots(t *testing.T) {
+	resourceName := "squadcast_suppression_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSuppressionRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSuppressionRulesConfig_timeSlots(""),
+				ExpectError: regexp.MustCompile("custom.repeats_on_weekdays must be set when custom.repeats is set to 'week'"),
+			},
+			{
+				Config: testAccResourceSuppressionRulesConfig_timeSlots("repeats_on_weekdays = [6]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.is_timebased", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.time_zone", "Asia/Calcutta"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.start_time", "2032-06-05T02:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.end_time", "2032-06-05T04:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.repetition", "custom"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.is_custom", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.custom.0.repeats", "week"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.custom.0.repeats_on_weekdays.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.timeslots.0.custom.0.repeats_on_weekdays.0", "6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd",
+			},
+		},
+	})
+}
+
 func testAccCheckSuppressionRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -120,3 +158,33 @@ resource "squadcast_suppression_rules" "test" {
 }
 	`)
 }
+
+func testAccResourceSuppressionRulesConfig_timeSlots(weekdays string) string {
+	return fmt.Sprintf(`
+resource "squadcast_suppression_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		description = "every saturday 02:00 - 04:00"
+		expression = "payload[\"event_id\"] == 40"
+
+		timeslots {
+			time_zone = "Asia/Calcutta"
+			start_time = "2032-06-05T02:00:00Z"
+			end_time = "2032-06-05T04:00:00Z"
+			ends_on = "2032-06-05T04:00:00Z"
+			repetition = "custom"
+			ends_never = true
+
+			custom {
+				repeats = "week"
+				repeats_count = 1
+				%s
+			}
+		}
+	}
+}
+	`, weekdays)
+}
@@ -2,19 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 const taggingRulesID = "tagging_rules"
 
+var tagColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
 func resourceTaggingRules() *schema.Resource {
 	return &schema.Resource{
-		Description: "[Tagging](https://support.squadcast.com/docs/event-tagging) is a rule-based, auto-tagging system with which you can define customised tags based on incident payloads, that get automatically assigned to incidents when they are triggered.",
+		Description: "[Tagging](https://support.squadcast.com/docs/event-tagging) is a rule-based, auto-tagging system with which you can define customised tags based on incident payloads, that get automatically assigned to incidents when they are triggered. This resource manages all the tagging rules of the service: rules created outside of it are removed on its next update.",
 
 		CreateContext: resourceTaggingRulesCreate,
 		ReadContext:   resourceTaggingRulesRead,
@@ -24,6 +30,8 @@ func resourceTaggingRules() *schema.Resource {
 			StateContext: resourceTaggingRulesImport,
 		},
 
+		CustomizeDiff: resourceTaggingRulesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "id.",
@@ -44,11 +52,20 @@ func resourceTaggingRules() *schema.Resource {
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
+			"payload_fields": {
+				Description: "Payload fields of the alert sources of the service, e.g. from the `payload_fields` of `squadcast_alert_sources`. When set, the placeholders of the templated tag values are validated against them at plan time.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"rules": {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"enabled": enabledSchema("Whether the rule tags the matching alerts."),
 						"is_basic": {
 							Description: "is_basic will be true when users use the drop down selectors which will have lhs, op & rhs value, whereas it will be false when they use the advanced mode and it would have the expression for it's value",
 							Type:        schema.TypeBool,
@@ -59,6 +76,12 @@ func resourceTaggingRules() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 						},
+						"overwrite": {
+							Description: "When true, the tags of this rule replace any existing tags with the same key on the incident. When false, the values are appended to the existing tags.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
 						"basic_expressions": {
 							Description: "The basic expression which needs to be evaluated to be true for this rule to apply.",
 							Type:        schema.TypeList,
@@ -96,14 +119,16 @@ func resourceTaggingRules() *schema.Resource {
 										Required:    true,
 									},
 									"value": {
-										Description: "value",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Tag value. Can be templated with alert payload fields by wrapping the field path in double curly braces, as shown in the example.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateTaggingRuleTagValue,
 									},
 									"color": {
-										Description: "Tag color, hex values",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Tag color, hex value (e.g. `#ababab` or `#abc`).",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(tagColorRegexp, "must be a hex color code, e.g. #ababab"),
 									},
 								},
 							},
@@ -128,20 +153,113 @@ func resourceTaggingRulesImport(ctx context.Context, d *schema.ResourceData, met
 	return []*schema.ResourceData{d}, nil
 }
 
-// func decodeTaggingRules(input []any, output *[]api.TaggingRule) error {}
+// taggingTemplatePlaceholders returns the trimmed content of every `{{ }}` placeholder in a templated tag value.
+func taggingTemplatePlaceholders(v string) ([]string, error) {
+	var placeholders []string
+
+	rest := v
+	for {
+		start := strings.Index(rest, "{{")
+		end := strings.Index(rest, "}}")
+		if start == -1 {
+			if end != -1 {
+				return nil, fmt.Errorf("unexpected \"}}\" in %q", v)
+			}
+			return placeholders, nil
+		}
+		if end == -1 || end < start {
+			return nil, fmt.Errorf("unterminated template placeholder in %q", v)
+		}
 
-func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+		placeholder := rest[start+2 : end]
+		if strings.Contains(placeholder, "{{") {
+			return nil, fmt.Errorf("nested template placeholders are not supported in %q", v)
+		}
+		placeholder = strings.TrimSpace(placeholder)
+		if placeholder == "" {
+			return nil, fmt.Errorf("empty template placeholder in %q", v)
+		}
+		placeholders = append(placeholders, placeholder)
+
+		rest = rest[end+2:]
+	}
+}
+
+// validateTaggingRuleTagValue makes sure every `{{` placeholder in a templated tag value is closed and non-empty.
+func validateTaggingRuleTagValue(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := taggingTemplatePlaceholders(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return warnings, errors
+}
+
+// payloadFieldKnown reports whether a placeholder such as `payload.details.region` refers to one of the
+// payload fields, or to an object containing or contained in one of them.
+func payloadFieldKnown(placeholder string, fields []string) bool {
+	path := strings.TrimPrefix(placeholder, "payload.")
+	for _, field := range fields {
+		if path == field || strings.HasPrefix(path, field+".") || strings.HasPrefix(field, path+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceTaggingRulesCustomizeDiff validates the placeholders of the templated tag values against the
+// payload fields of the alert sources, when they are provided.
+func resourceTaggingRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("payload_fields") {
+		return nil
+	}
+
+	fields := tf.ExpandStringSet(d.Get("payload_fields").(*schema.Set))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for i, rule := range d.Get("rules").([]any) {
+		mrule, ok := rule.(tf.M)
+		if !ok {
+			continue
+		}
+
+		for j, tag := range mrule["tags"].([]any) {
+			mtag, ok := tag.(tf.M)
+			if !ok {
+				continue
+			}
+
+			placeholders, err := taggingTemplatePlaceholders(mtag["value"].(string))
+			if err != nil {
+				continue
+			}
+			for _, placeholder := range placeholders {
+				if !payloadFieldKnown(placeholder, fields) {
+					return fmt.Errorf("rules.%d.tags.%d.value: %q does not match any field of the alert source payloads", i, j, placeholder)
+				}
+			}
+		}
+	}
 
-	mrules := d.Get("rules").([]any)
+	return nil
+}
+
+func decodeTaggingRules(mrules []any) ([]api.TaggingRule, error) {
 	var rules []api.TaggingRule
 	err := Decode(mrules, &rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	for i, mrule := range mrules {
-
 		mtags := mrule.(tf.M)["tags"].([]any)
 
 		tags := make(map[string]api.TaggingRuleTagValue, len(mtags))
@@ -150,7 +268,7 @@ func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 			var tagvalue api.TaggingRuleTagValue
 			err := Decode(mtag, &tagvalue)
 			if err != nil {
-				return diag.FromErr(err)
+				return nil, err
 			}
 
 			key := mtag.(tf.M)["key"].(string)
@@ -161,6 +279,17 @@ func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 		rules[i].Tags = tags
 	}
 
+	return rules, nil
+}
+
+func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rules, err := decodeTaggingRules(d.Get("rules").([]any))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
 	tflog.Info(ctx, "Creating tagging_rules", tf.M{
 		"team_id":    d.Get("team_id").(string),
 		"service_id": d.Get("service_id").(string),
@@ -168,12 +297,12 @@ func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 
 	_, err = client.UpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateTaggingRulesReq{Rules: rules})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(taggingRulesID)
 
-	return resourceTaggingRulesRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceTaggingRulesRead)
 }
 
 func resourceTaggingRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -196,11 +325,11 @@ func resourceTaggingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 	taggingRules, err := client.GetTaggingRules(ctx, serviceID.(string), teamID.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(taggingRules, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -209,37 +338,20 @@ func resourceTaggingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceTaggingRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	mrules := d.Get("rules").([]any)
-	var rules []api.TaggingRule
-	err := Decode(mrules, &rules)
+	rules, err := decodeTaggingRules(d.Get("rules").([]any))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	for i, mrule := range mrules {
-
-		mtags := mrule.(tf.M)["tags"].([]any)
-
-		tags := make(map[string]api.TaggingRuleTagValue, len(mtags))
-
-		for _, mtag := range mtags {
-			var tagvalue api.TaggingRuleTagValue
-			err := Decode(mtag, &tagvalue)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			key := mtag.(tf.M)["key"].(string)
-
-			tags[key] = tagvalue
-		}
-
-		rules[i].Tags = tags
+	oldRules, _ := d.GetChange("rules")
+	base, err := decodeTaggingRules(oldRules.([]any))
+	if err != nil {
+		return diagFromErr(err)
 	}
 
-	_, err = client.UpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateTaggingRulesReq{Rules: rules})
+	err = client.MergeUpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, rules)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceTaggingRulesRead(ctx, d, meta)
@@ -248,9 +360,14 @@ func resourceTaggingRulesUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceTaggingRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	_, err := client.UpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateTaggingRulesReq{Rules: []api.TaggingRule{}})
+	base, err := decodeTaggingRules(d.Get("rules").([]any))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = client.MergeUpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), base, []api.TaggingRule{})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -3,9 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -14,18 +14,18 @@ import (
 )
 
 func TestAccResourceTaggingRules(t *testing.T) {
-	teamName := acctest.RandomWithPrefix("test-team")
+	teamName := testAccName("test-team")
 	user := testdata.RandomUser()
-	epName := acctest.RandomWithPrefix("test-ep")
-	serviceName := acctest.RandomWithPrefix("test-service")
+	epName := testAccName("test-ep")
+	serviceName := testAccName("test-service")
 
 	teamResourceName := "squadcast_team.test"
 	serviceResourceName := "squadcast_service.test"
 	resourceName := "squadcast_tagging_rules.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTaggingRulesDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTaggingRulesDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTaggingRulesConfig(teamName, user, epName, serviceName),
@@ -49,6 +49,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.is_basic", "false"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.overwrite", "false"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.basic_expressions.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.0.key", "MyTag"),
@@ -56,6 +57,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.0.color", "#ababab"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.is_basic", "true"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.expression", ""),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.overwrite", "true"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.lhs", "payload[\"foo\"]"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.op", "is"),
@@ -65,7 +67,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.0.value", "foo"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.0.color", "#ababab"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.key", "MyTag2"),
-					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.value", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.value", "{{payload.foo}}"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.color", "#f0f0f0"),
 					resource.TestCheckResourceAttrPair(resourceName, "team_id", teamResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
@@ -94,6 +96,29 @@ func TestAccResourceTaggingRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceTaggingRulesPayloadFields(t *testing.T) {
+	resourceName := "squadcast_tagging_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTaggingRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceTaggingRulesConfig_payloadFields("{{payload.labels.severty}}"),
+				ExpectError: regexp.MustCompile(`"payload.labels.severty" does not match any field of the alert source payloads`),
+			},
+			{
+				Config: testAccResourceTaggingRulesConfig_payloadFields("{{payload.labels.severity}}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "payload_fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.0.value", "{{payload.labels.severity}}"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTaggingRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -238,6 +263,7 @@ resource "squadcast_tagging_rules" "test" {
 
 	rules {
 		is_basic = true
+		overwrite = true
 
 		basic_expressions {
 			lhs = "payload[\"foo\"]"
@@ -253,10 +279,32 @@ resource "squadcast_tagging_rules" "test" {
 
 		tags {
 			key = "MyTag2"
-			value = "bar"
+			value = "{{payload.foo}}"
 			color = "#f0f0f0"
 		}
 	}
 }
 	`, teamName, user.FirstName, user.LastName, user.Email, epName, serviceName)
 }
+
+func testAccResourceTaggingRulesConfig_payloadFields(value string) string {
+	return fmt.Sprintf(`
+resource "squadcast_tagging_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	payload_fields = ["labels.severity", "labels.alertname"]
+
+	rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+
+		tags {
+			key = "severity"
+			value = "%s"
+			color = "#ababab"
+		}
+	}
+}
+	`, value)
+}
@@ -83,12 +83,12 @@ func resourceTeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta
 		RoleIDs: tf.ListToSlice[string](d.Get("role_ids")),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(teamMember.UserID)
 
-	return resourceTeamMemberRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceTeamMemberRead)
 }
 
 func resourceTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -100,11 +100,11 @@ func resourceTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(teamMember, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -117,7 +117,7 @@ func resourceTeamMemberUpdate(ctx context.Context, d *schema.ResourceData, meta
 		RoleIDs: tf.ListToSlice[string](d.Get("role_ids")),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceTeamMemberRead(ctx, d, meta)
@@ -128,7 +128,7 @@ func resourceTeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	_, err := client.DeleteTeamMember(ctx, d.Get("team_id").(string), d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -14,16 +13,16 @@ import (
 )
 
 func TestAccResourceTeamMember(t *testing.T) {
-	teamName := acctest.RandomWithPrefix("test-team")
+	teamName := testAccName("test-team")
 	user := testdata.RandomUser()
 
 	teamResourceName := "squadcast_team.test"
 	userResourceName := "squadcast_user.test"
 	resourceName := "squadcast_team_member.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamMemberDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamMemberDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamMemberConfig(teamName, user),
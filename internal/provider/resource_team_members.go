@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "`squadcast_team_members` manages the full membership of a Team authoritatively: members that are not listed are removed from the team. Changes are applied as batched additions, role updates and removals. Use `squadcast_team_member` instead to manage single members and leave the others untouched; do not use both for the same team.",
+
+		CreateContext: resourceTeamMembersCreate,
+		ReadContext:   resourceTeamMembersRead,
+		UpdateContext: resourceTeamMembersUpdate,
+		DeleteContext: resourceTeamMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamMembersImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"members": {
+				Description: "Team members and their roles. The authenticated user is removed last, so the creator of the team can be left out of the list.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        teamMemberSchema(),
+			},
+		},
+	}
+}
+
+func resourceTeamMembersImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("team_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTeamMembersCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	if err := updateTeamMembers(ctx, client, teamID, d.Get("members").(*schema.Set)); err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(teamID)
+
+	return readAfterCreate(ctx, d, meta, resourceTeamMembersRead)
+}
+
+func resourceTeamMembersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading team members", tf.M{
+		"team_id": d.Id(),
+	})
+	team, err := client.GetTeamMetaById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = d.Set("team_id", team.ID); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("members", flattenTeamMembers(team.Members, d.Get("members").(*schema.Set), team.DefaultRoleIDs())); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTeamMembersUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	if err := updateTeamMembers(ctx, client, d.Id(), d.Get("members").(*schema.Set)); err != nil {
+		// Keep the previous members in the state, the next refresh reads the ones that were applied.
+		d.Partial(true)
+		return diagFromErr(err)
+	}
+
+	return resourceTeamMembersRead(ctx, d, meta)
+}
+
+func resourceTeamMembersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	err := removeTeamMembers(ctx, client, d.Id(), d.Get("members").(*schema.Set))
+	if err != nil && !api.IsResourceNotFoundError(err) {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestAccResourceTeamMembers_plural(t *testing.T) {
+	teamName := testAccName("test-team")
+	user1 := testdata.RandomUser()
+	user2 := testdata.RandomUser()
+
+	resourceName := "squadcast_team_members.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamMembersPluralConfig(teamName, user1, user2, "user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_team.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "team_id", "squadcast_team.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*.user_id", "squadcast_user.test1", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*.user_id", "squadcast_user.test2", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"roles.#": "1",
+						"roles.0": "user",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceTeamMembersPluralConfig(teamName, user1, user2, "observer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"roles.#": "1",
+						"roles.0": "observer",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTeamMembersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_team_members" {
+			continue
+		}
+
+		team, err := client.GetTeamMetaById(context.Background(), rs.Primary.ID)
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if len(team.Members) > 1 {
+			return fmt.Errorf("expected team members to be removed, %d found", len(team.Members))
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceTeamMembersPluralConfig(teamName string, user1, user2 testdata.User, role string) string {
+	return fmt.Sprintf(`
+resource "squadcast_team" "test" {
+	name = "%s"
+}
+
+resource "squadcast_user" "test1" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user" "test2" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_team_members" "test" {
+	team_id = squadcast_team.test.id
+
+	members {
+		user_id = squadcast_user.test1.id
+		roles = ["%s"]
+	}
+
+	members {
+		user_id = squadcast_user.test2.id
+		roles = ["%s"]
+	}
+}
+	`, teamName, user1.FirstName, user1.LastName, user1.Email, user2.FirstName, user2.LastName, user2.Email, role, role)
+}
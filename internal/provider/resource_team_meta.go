@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +23,9 @@ func resourceTeam() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTeamImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -53,6 +57,12 @@ func resourceTeam() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"members": {
+				Description: "Team members and their role ids. When set, the membership of the team is managed authoritatively: changes are applied as batched additions, role updates and removals. The authenticated user is removed last, so the creator of the team can be left out of the list. Removing the attribute stops managing the membership, the members of the team are left as they are. Do not use together with `squadcast_team_member` or `squadcast_team_members` for the same team.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        teamMemberSchema(),
+			},
 		},
 	}
 }
@@ -80,12 +90,18 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		Description: d.Get("description").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(team.ID)
 
-	return resourceTeamRead(ctx, d, meta)
+	if v, ok := d.GetOk("members"); ok {
+		if err = updateTeamMembers(ctx, client, team.ID, v.(*schema.Set)); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceTeamRead)
 }
 
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -101,11 +117,17 @@ func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(team, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if _, ok := d.GetOk("members"); ok {
+		if err = d.Set("members", flattenTeamMembers(team.Members, d.Get("members").(*schema.Set), team.DefaultRoleIDs())); err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	return nil
@@ -119,12 +141,31 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		Description: d.Get("description").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if d.HasChange("members") && teamMembersConfigured(d) {
+		if err = updateTeamMembers(ctx, client, d.Id(), d.Get("members").(*schema.Set)); err != nil {
+			// Keep the previous members in the state, the next refresh reads the ones that were applied.
+			d.Partial(true)
+			return diagFromErr(err)
+		}
 	}
 
 	return resourceTeamRead(ctx, d, meta)
 }
 
+// teamMembersConfigured reports whether the members of the team are set in the configuration. Removing the attribute
+// stops managing the membership rather than removing every member of the team.
+func teamMembersConfigured(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("members") {
+		return false
+	}
+
+	return !config.GetAttr("members").IsNull()
+}
+
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -135,7 +176,15 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	// The objects of the team are deleted asynchronously, the team is found until they all are.
+	err = api.WaitForNotFound(ctx, d.Timeout(schema.TimeoutDelete), func(ctx context.Context) (*api.TeamMeta, error) {
+		return client.GetTeamMetaById(ctx, d.Id())
+	})
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -3,22 +3,26 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
 )
 
 func TestAccResourceTeam(t *testing.T) {
-	teamName := acctest.RandomWithPrefix("team")
+	teamName := testAccName("team")
 
 	resourceName := "squadcast_team.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamConfig(teamName),
@@ -53,6 +57,42 @@ func TestAccResourceTeam(t *testing.T) {
 	})
 }
 
+func TestAccResourceTeamMembers(t *testing.T) {
+	teamName := testAccName("team")
+	user := testdata.RandomUser()
+
+	resourceName := "squadcast_team.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamMembersConfig(teamName, user, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "members.*.user_id", "squadcast_user.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"roles.#": "1",
+						"roles.0": "admin",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceTeamMembersConfig(teamName, user, "observer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"roles.#": "1",
+						"roles.0": "observer",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTeamDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -91,3 +131,104 @@ resource "squadcast_team" "test" {
 }
 	`, teamName, teamName)
 }
+
+func testAccResourceTeamMembersConfig(teamName string, user testdata.User, role string) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_team" "test" {
+	name = "%s"
+
+	members {
+		user_id = squadcast_user.test.id
+		roles = ["%s"]
+	}
+}
+	`, user.FirstName, user.LastName, user.Email, teamName, role)
+}
+
+func TestResourceTeamUpdate_membersRemovedFromConfig(t *testing.T) {
+	var memberUpdates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/v3/teams/613611c1eb22db455cfa789f/meta":
+			w.Write([]byte(`{"data":{"id":"613611c1eb22db455cfa789f","name":"sre"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/teams/613611c1eb22db455cfa789f":
+			w.Write([]byte(`{"data":{"id":"613611c1eb22db455cfa789f","name":"sre","members":[{"user_id":"5f8891527f735f0a6646f3b7","role_ids":["61305a8eb7a2fa0e44cfd0f5"]}],"roles":[{"id":"61305a8eb7a2fa0e44cfd0f5","name":"Member","slug":"member","default":true}]}}`))
+		default:
+			memberUpdates++
+			w.Write([]byte(`{"data":{}}`))
+		}
+	}))
+	defer server.Close()
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+
+	r := resourceTeam()
+	state := &terraform.InstanceState{ID: "613611c1eb22db455cfa789f", Attributes: map[string]string{
+		"id":                   "613611c1eb22db455cfa789f",
+		"name":                 "sre",
+		"members.#":            "1",
+		"members.0.user_id":    "5f8891527f735f0a6646f3b7",
+		"members.0.role_ids.#": "1",
+		"members.0.role_ids.0": "61305a8eb7a2fa0e44cfd0f5",
+	}}
+	config := map[string]any{"name": "sre", "description": "Site reliability"}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The raw configuration is set by Terraform, without the members.
+	attributes := map[string]cty.Value{}
+	for name, typ := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attributes[name] = cty.NullVal(typ)
+	}
+	attributes["name"] = cty.StringVal("sre")
+	attributes["description"] = cty.StringVal("Site reliability")
+	diff.RawConfig = cty.ObjectVal(attributes)
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if memberUpdates != 0 {
+		t.Errorf("expected the members to be left unchanged when removed from the configuration, got %d requests", memberUpdates)
+	}
+}
+
+func TestResourceTeamDelete_waitsForDeletion(t *testing.T) {
+	deleted, reads := false, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			reads++
+			// The team is still found right after its deletion.
+			if !deleted || reads < 2 {
+				w.Write([]byte(`{"data":{"id":"613611c1eb22db455cfa789f","name":"sre"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"meta":{"status":404,"error_message":"team not found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceTeam()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{"name": "sre"})
+	d.SetId("613611c1eb22db455cfa789f")
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !deleted || reads != 2 {
+		t.Errorf("expected the deletion to be waited for, got %d reads", reads)
+	}
+}
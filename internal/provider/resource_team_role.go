@@ -89,12 +89,22 @@ func resourceTeamRoleCreate(ctx context.Context, d *schema.ResourceData, meta an
 		Abilities: tf.ExpandStringSet(d.Get("abilities").(*schema.Set)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "team role", d.Get("name").(string), func() (string, error) {
+			existing, err := client.GetTeamRoleByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+			if err != nil {
+				return "", err
+			}
+			return existing.ID, nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceTeamRoleUpdate(ctx, d, meta)...)
 	}
 
 	d.SetId(teamRole.ID)
 
-	return resourceTeamRoleRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceTeamRoleRead)
 }
 
 func resourceTeamRoleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -117,11 +127,11 @@ func resourceTeamRoleRead(ctx context.Context, d *schema.ResourceData, meta any)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(teamRole, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -135,7 +145,7 @@ func resourceTeamRoleUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		Abilities: tf.ExpandStringSet(d.Get("abilities").(*schema.Set)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceTeamRoleRead(ctx, d, meta)
@@ -150,7 +160,7 @@ func resourceTeamRoleDelete(ctx context.Context, d *schema.ResourceData, meta an
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -13,15 +12,15 @@ import (
 )
 
 func TestAccResourceTeamRole(t *testing.T) {
-	teamRoleName := acctest.RandomWithPrefix("test-teamrole")
-	teamName := acctest.RandomWithPrefix("test-team")
+	teamRoleName := testAccName("test-teamrole")
+	teamName := testAccName("test-team")
 
 	teamResourceName := "squadcast_team.test"
 	resourceName := "squadcast_team_role.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckTeamRoleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTeamRoleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceTeamRoleConfig(teamName, teamRoleName),
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceTestIncident() *schema.Resource {
+	return &schema.Resource{
+		Description: "Test incidents are triggered on a service when they are created and resolved when they are destroyed, " +
+			"e.g. to validate the escalation path of a service end to end after an infrastructure change. " +
+			"A test incident escalates like any other incident of the service. Change `triggers` to trigger a new test incident.",
+
+		CreateContext: resourceTestIncidentCreate,
+		ReadContext:   resourceTestIncidentRead,
+		UpdateContext: resourceTestIncidentUpdate,
+		DeleteContext: resourceTestIncidentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Incident id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "id of the service the incident is triggered on.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"message": {
+				Description:  "Message of the incident.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+				ForceNew:     true,
+			},
+			"description": {
+				Description: "Description of the incident.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tags": {
+				Description: "Incident tags.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"triggers": {
+				Description: "Arbitrary values, a new test incident is triggered when they change, e.g. the id of the escalation policy of the service.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resolution_reason": {
+				Description: "Reason the incident is resolved with when it is destroyed, it can be changed without triggering a new test incident.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Resolved by Terraform",
+			},
+			"status": {
+				Description: "Status of the incident.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Creation time of the incident.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceTestIncidentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req := &api.CreateTestIncidentReq{
+		TeamID:      d.Get("team_id").(string),
+		ServiceID:   d.Get("service_id").(string),
+		Message:     d.Get("message").(string),
+		Description: d.Get("description").(string),
+		Tags:        tf.ExpandStringMap(d.Get("tags")),
+	}
+
+	tflog.Info(ctx, "Creating test incident", tf.M{
+		"service_id": req.ServiceID,
+		"message":    req.Message,
+	})
+	incident, err := client.CreateTestIncident(ctx, req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(incident.ID)
+
+	return readAfterCreate(ctx, d, meta, resourceTestIncidentRead)
+}
+
+func resourceTestIncidentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading test incident", tf.M{
+		"id": d.Id(),
+	})
+	incident, err := client.GetIncidentById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	d.Set("status", incident.Status)
+	d.Set("created_at", incident.CreatedAt)
+
+	return nil
+}
+
+// resourceTestIncidentUpdate only updates the resolution_reason, which is used when the incident is destroyed.
+func resourceTestIncidentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceTestIncidentRead(ctx, d, meta)
+}
+
+func resourceTestIncidentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	incident, err := client.GetIncidentById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+	if incident.Status == "resolved" {
+		return nil
+	}
+
+	tflog.Info(ctx, "Resolving test incident", tf.M{
+		"id": d.Id(),
+	})
+	_, err = client.ResolveIncident(ctx, d.Id(), &api.ResolveIncidentReq{
+		ResolutionReason: d.Get("resolution_reason").(string),
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestTestIncidentLifecycle(t *testing.T) {
+	status := "triggered"
+	resolves := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/incidents":
+			w.Write([]byte(`{"data":{"id":"64a5c1e8b2a1f0a1b2c3d4e5","status":"triggered","is_test":true}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/incidents/64a5c1e8b2a1f0a1b2c3d4e5":
+			w.Write([]byte(fmt.Sprintf(`{"data":{"id":"64a5c1e8b2a1f0a1b2c3d4e5","status":%q,"created_at":"2023-07-05T19:00:00Z"}}`, status)))
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/incidents/64a5c1e8b2a1f0a1b2c3d4e5/resolve":
+			resolves++
+			status = "resolved"
+			w.Write([]byte(`{"data":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceTestIncident()
+	d := r.TestResourceData()
+	d.Set("team_id", "613611c1eb22db455cfa789f")
+	d.Set("service_id", "61305a8eb7a2fa0e44cfd0f5")
+	d.Set("message", "Synthetic escalation check")
+	d.Set("resolution_reason", "Resolved by Terraform")
+
+	if diags := resourceTestIncidentCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if d.Id() != "64a5c1e8b2a1f0a1b2c3d4e5" || d.Get("status") != "triggered" || d.Get("created_at") != "2023-07-05T19:00:00Z" {
+		t.Errorf("unexpected state after create: id %q, status %q", d.Id(), d.Get("status"))
+	}
+
+	for i := 0; i < 2; i++ {
+		if diags := resourceTestIncidentDelete(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("delete: %v", diags)
+		}
+	}
+	if resolves != 1 {
+		t.Errorf("expected the incident to be resolved once, got %d resolves", resolves)
+	}
+}
+
+func TestAccResourceTestIncident(t *testing.T) {
+	resourceName := "squadcast_test_incident.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTestIncidentResolved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTestIncidentConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "61305a8eb7a2fa0e44cfd0f5"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Synthetic escalation check"),
+					resource.TestCheckResourceAttr(resourceName, "tags.synthetic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "triggered"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccResourceTestIncidentConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "triggered"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTestIncidentResolved(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_test_incident" {
+			continue
+		}
+
+		incident, err := client.GetIncidentById(context.Background(), rs.Primary.ID)
+		if err != nil {
+			if api.IsResourceNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if incident.Status != "resolved" {
+			return fmt.Errorf("expected test incident %s to be resolved, got %s", rs.Primary.ID, incident.Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceTestIncidentConfig(run string) string {
+	return fmt.Sprintf(`
+resource "squadcast_test_incident" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61305a8eb7a2fa0e44cfd0f5"
+	message = "Synthetic escalation check"
+	tags = {
+		synthetic = "true"
+	}
+	triggers = {
+		run = "%s"
+	}
+}
+	`, run)
+}
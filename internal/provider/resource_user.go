@@ -97,7 +97,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		Role:      role,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(user.ID)
 
@@ -107,11 +107,11 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 			Abilities: abilities,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceUserRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceUserRead)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -128,11 +128,11 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = tf.EncodeAndSet(user, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -157,7 +157,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			Role: role,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -167,7 +167,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			Abilities: abilities,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -183,7 +183,7 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
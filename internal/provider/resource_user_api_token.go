@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var userAPITokenScopes = []string{
+	"incidents:read", "incidents:write",
+	"services:read", "services:write",
+	"escalation_policies:read", "escalation_policies:write",
+	"schedules:read", "schedules:write",
+	"squads:read", "squads:write",
+	"teams:read", "teams:write",
+	"users:read", "users:write",
+	"analytics:read",
+}
+
+func resourceUserAPIToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to provision an API token on behalf of a user, e.g. of the service account of the automation of a team, where the organization lets admins provision per-user tokens. " +
+			"The token is revoked when the resource is destroyed. It is replaced when its settings change or once it enters its rotation window, so that tokens are rotated uniformly by `terraform apply`. " +
+			"Use the `create_before_destroy` lifecycle setting for the new token to be created before the previous one is revoked.",
+
+		CreateContext: resourceUserAPITokenCreate,
+		ReadContext:   resourceUserAPITokenRead,
+		UpdateContext: resourceUserAPITokenUpdate,
+		DeleteContext: resourceUserAPITokenDelete,
+		CustomizeDiff: resourceUserAPITokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description:  "id of the user the token acts on behalf of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the token.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				ForceNew:     true,
+			},
+			"scopes": {
+				Description: "Scopes of the token, e.g. `incidents:read`. The token is further limited to the permissions of the user. Allowed values: " + strings.Join(userAPITokenScopes, ", ") + ".",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(userAPITokenScopes, false),
+				},
+			},
+			"expires_in_days": {
+				Description:  "Number of days the token is valid for, between 1 and 365. Defaults to `90`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntBetween(1, 365),
+				ForceNew:     true,
+			},
+			"rotate_before_days": {
+				Description:  "Number of days before its expiry from which the token is replaced by the next apply. 0 disables the rotation, the token is then only replaced once it expired. Defaults to `14`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      14,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"token": {
+				Description: "The token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "Creation time of the token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "Expiry time of the token.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ready_for_rotation": {
+				Description: "Whether the token is in its rotation window and is replaced by the next apply.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// userAPITokenReadyForRotation returns whether the token expires in less than rotateBeforeDays days, or expired.
+func userAPITokenReadyForRotation(expiresAt string, rotateBeforeDays int, now time.Time) bool {
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(expiry.AddDate(0, 0, -rotateBeforeDays))
+}
+
+// resourceUserAPITokenCustomizeDiff replaces the token once it is ready for rotation.
+func resourceUserAPITokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !userAPITokenReadyForRotation(d.Get("expires_at").(string), d.Get("rotate_before_days").(int), time.Now()) {
+		return nil
+	}
+
+	if err := d.SetNew("ready_for_rotation", true); err != nil {
+		return err
+	}
+	return d.ForceNew("ready_for_rotation")
+}
+
+func resourceUserAPITokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating user api token", tf.M{
+		"user_id": d.Get("user_id").(string),
+		"name":    d.Get("name").(string),
+	})
+	token, err := client.CreateUserAPIToken(ctx, d.Get("user_id").(string), &api.CreateUserAPITokenReq{
+		Name:          d.Get("name").(string),
+		Scopes:        tf.ExpandStringSet(d.Get("scopes").(*schema.Set)),
+		ExpiresInDays: d.Get("expires_in_days").(int),
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(token.ID)
+	if err = d.Set("token", token.Token); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("ready_for_rotation", false); err != nil {
+		return diagFromErr(err)
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceUserAPITokenRead)
+}
+
+func resourceUserAPITokenRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading user api token", tf.M{
+		"id":      d.Id(),
+		"user_id": d.Get("user_id").(string),
+	})
+	token, err := client.GetUserAPITokenByID(ctx, d.Get("user_id").(string), d.Id())
+	if err != nil {
+		// Expired and revoked tokens are not found, they are created again.
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(token, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// resourceUserAPITokenUpdate only applies `rotate_before_days`, the other settings replace the token.
+func resourceUserAPITokenUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceUserAPITokenRead(ctx, d, meta)
+}
+
+func resourceUserAPITokenDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteUserAPIToken(ctx, d.Get("user_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
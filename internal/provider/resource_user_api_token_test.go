@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUserAPITokenReadyForRotation(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expiresAt        string
+		rotateBeforeDays int
+		want             bool
+	}{
+		{expiresAt: "2024-05-30T12:00:00Z", rotateBeforeDays: 14, want: false},
+		{expiresAt: "2024-03-15T12:00:01Z", rotateBeforeDays: 14, want: false},
+		{expiresAt: "2024-03-15T12:00:00Z", rotateBeforeDays: 14, want: true},
+		{expiresAt: "2024-03-02T12:00:00Z", rotateBeforeDays: 0, want: false},
+		{expiresAt: "2024-03-01T11:59:59Z", rotateBeforeDays: 0, want: true},
+		{expiresAt: "", rotateBeforeDays: 14, want: false},
+	}
+	for _, test := range tests {
+		if got := userAPITokenReadyForRotation(test.expiresAt, test.rotateBeforeDays, now); got != test.want {
+			t.Errorf("expected %v for a token expiring at %q rotated %d days before, got %v", test.want, test.expiresAt, test.rotateBeforeDays, got)
+		}
+	}
+}
+
+func TestAccResourceUserAPIToken(t *testing.T) {
+	tokenName := testAccName("token")
+	resourceName := "squadcast_user_api_token.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserAPITokenConfig(tokenName, 30, 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "name", tokenName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "expires_in_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_rotation", "false"),
+				),
+			},
+			{
+				// A token valid for 30 days is in a rotation window of 30 days, it is replaced.
+				Config:             testAccResourceUserAPITokenConfig(tokenName, 30, 30),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUserAPITokenConfig(tokenName string, expiresInDays int, rotateBeforeDays int) string {
+	return fmt.Sprintf(`
+resource "squadcast_user_api_token" "test" {
+	user_id = "5f8891527f735f0a6646f3b6"
+	name = "%s"
+	scopes = ["incidents:read", "incidents:write"]
+	expires_in_days = %d
+	rotate_before_days = %d
+}
+	`, tokenName, expiresInDays, rotateBeforeDays)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceUserNotificationRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to manage the personal notification rules of a user, i.e. the channels the user is notified with and after which delay when an incident is assigned to them, for high and low priority incidents. " +
+			"The notification rules are reset to the defaults of Squadcast when the resource is destroyed.",
+
+		CreateContext: resourceUserNotificationRulesCreate,
+		ReadContext:   resourceUserNotificationRulesRead,
+		UpdateContext: resourceUserNotificationRulesUpdate,
+		DeleteContext: resourceUserNotificationRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserNotificationRulesImport,
+		},
+		CustomizeDiff: userNotificationRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description:  "User id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"high_priority": {
+				Description: "Notification rules of the high priority incidents, in the order of their delays.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        userNotificationRuleResource(),
+			},
+			"low_priority": {
+				Description: "Notification rules of the low priority incidents, in the order of their delays.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        userNotificationRuleResource(),
+			},
+		},
+	}
+}
+
+func userNotificationRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"channel": {
+				Description:  "Notification channel. (SMS, Phone, Email or Push)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SMS", "Phone", "Email", "Push"}, false),
+			},
+			"delay_minutes": {
+				Description:  "Minutes after the incident was assigned to the user before notifying them with the channel, between 0 and 60.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+		},
+	}
+}
+
+// userNotificationRulesCustomizeDiff checks that the notification rules are ordered by delay, as the API returns them.
+func userNotificationRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, priority := range []string{"high_priority", "low_priority"} {
+		previous := 0
+		for i, r := range d.Get(priority).([]any) {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			delay := rule["delay_minutes"].(int)
+			if delay < previous {
+				return fmt.Errorf("%s.%d.delay_minutes: the rules must be ordered by delay, %d is less than the delay of the previous rule, %d", priority, i, delay, previous)
+			}
+			previous = delay
+		}
+	}
+
+	return nil
+}
+
+func resourceUserNotificationRulesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("user_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeUserNotificationRules(d *schema.ResourceData) (*api.UpdateUserNotificationRulesReq, error) {
+	req := &api.UpdateUserNotificationRulesReq{}
+	if err := tf.DecodeAt("high_priority", d.Get("high_priority").([]any), &req.HighPriority); err != nil {
+		return nil, err
+	}
+	if err := tf.DecodeAt("low_priority", d.Get("low_priority").([]any), &req.LowPriority); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func resourceUserNotificationRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	userID := d.Get("user_id").(string)
+	req, err := decodeUserNotificationRules(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating user notification rules", tf.M{
+		"user_id": userID,
+	})
+	_, err = client.UpdateUserNotificationRules(ctx, userID, req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(userID)
+
+	return readAfterCreate(ctx, d, meta, resourceUserNotificationRulesRead)
+}
+
+func resourceUserNotificationRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading user notification rules", tf.M{
+		"user_id": d.Id(),
+	})
+	rules, err := client.GetUserNotificationRules(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(rules, d); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserNotificationRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeUserNotificationRules(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	tflog.Info(ctx, "Updating user notification rules", tf.M{
+		"user_id": d.Id(),
+	})
+	_, err = client.UpdateUserNotificationRules(ctx, d.Id(), req)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceUserNotificationRulesRead(ctx, d, meta)
+}
+
+func resourceUserNotificationRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteUserNotificationRules(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestResourceUserNotificationRulesRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/users/62d2fe23a57381088224d726/notification-rules" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data":{"user_id":"62d2fe23a57381088224d726","high_priority":[{"type":"Push","time":0},{"type":"Phone","time":10}],"low_priority":[{"type":"Email","time":0}]}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceUserNotificationRules()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{})
+	d.SetId("62d2fe23a57381088224d726")
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("user_id") != "62d2fe23a57381088224d726" || d.Get("high_priority.#") != 2 || d.Get("low_priority.#") != 1 {
+		t.Errorf("unexpected notification rules %v", d.State().Attributes)
+	}
+	if d.Get("high_priority.1.channel") != "Phone" || d.Get("high_priority.1.delay_minutes") != 10 {
+		t.Errorf("unexpected second high priority rule %v", d.Get("high_priority.1"))
+	}
+}
+
+func TestUserNotificationRulesCustomizeDiff(t *testing.T) {
+	r := resourceUserNotificationRules()
+	config := func(delays ...int) map[string]any {
+		var rules []any
+		for _, delay := range delays {
+			rules = append(rules, map[string]any{"channel": "Push", "delay_minutes": delay})
+		}
+		return map[string]any{
+			"user_id":       "62d2fe23a57381088224d726",
+			"high_priority": rules,
+			"low_priority":  []any{map[string]any{"channel": "Email"}},
+		}
+	}
+
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config(0, 10, 5)), nil); err == nil {
+		t.Error("expected an error for rules not ordered by delay")
+	}
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config(0, 5, 5, 10)), nil); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestAccResourceUserNotificationRules(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resourceName := "squadcast_user_notification_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserNotificationRulesConfig(user, "Phone", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.0.channel", "Push"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.0.delay_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.1.channel", "Phone"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.1.delay_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "low_priority.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "low_priority.0.channel", "Email"),
+				),
+			},
+			{
+				Config: testAccResourceUserNotificationRulesConfig(user, "SMS", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "high_priority.1.channel", "SMS"),
+					resource.TestCheckResourceAttr(resourceName, "high_priority.1.delay_minutes", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUserNotificationRulesConfig(user testdata.User, channel string, delay int) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_notification_rules" "test" {
+	user_id = squadcast_user.test.id
+
+	high_priority {
+		channel = "Push"
+	}
+	high_priority {
+		channel = "%s"
+		delay_minutes = %d
+	}
+
+	low_priority {
+		channel = "Email"
+	}
+}
+	`, user.FirstName, user.LastName, user.Email, channel, delay)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceUserPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to manage the abilities explicitly granted to a user, on top of the ones that come with the user's role. " +
+			"Do not use this resource together with the `abilities` attribute of `squadcast_user` for the same user.",
+
+		CreateContext: resourceUserPermissionsCreate,
+		ReadContext:   resourceUserPermissionsRead,
+		UpdateContext: resourceUserPermissionsUpdate,
+		DeleteContext: resourceUserPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserPermissionsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description:  "User id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"abilities": {
+				Description: "Abilities explicitly granted to the user.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"role": {
+				Description: "User role.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"role_abilities": {
+				Description: "Abilities the user gets from their role.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"effective_abilities": {
+				Description: "Abilities the user effectively has, i.e. the role abilities plus the explicit grants.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceUserPermissionsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("user_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceUserPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	userID := d.Get("user_id").(string)
+
+	tflog.Info(ctx, "Creating user_permissions", tf.M{
+		"user_id": userID,
+	})
+	diags := updateUserPermissions(ctx, client, userID, tf.ExpandStringSet(d.Get("abilities").(*schema.Set)))
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(userID)
+
+	return append(diags, readAfterCreate(ctx, d, meta, resourceUserPermissionsRead)...)
+}
+
+func resourceUserPermissionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading user_permissions", tf.M{
+		"id": d.Id(),
+	})
+	user, err := client.GetUserById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	roleAbilities := make(map[string]bool)
+	effectiveAbilities := make(map[string]bool, len(user.Abilities))
+	for _, ability := range user.Abilities {
+		effectiveAbilities[ability.Slug] = true
+		if ability.Default {
+			roleAbilities[ability.Slug] = true
+		}
+	}
+
+	// Grants that are also covered by the role are kept in state as long as the user still has them,
+	// otherwise every role change would show up as a diff on `abilities`.
+	granted := make(map[string]bool)
+	for _, ability := range tf.ExpandStringSet(d.Get("abilities").(*schema.Set)) {
+		if effectiveAbilities[ability] {
+			granted[ability] = true
+		}
+	}
+	for ability := range effectiveAbilities {
+		if !roleAbilities[ability] {
+			granted[ability] = true
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, ability := range sortedKeys(granted) {
+		if roleAbilities[ability] {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Redundant ability grant",
+				Detail:        fmt.Sprintf("The ability %q is already granted to the user by the role %q, the explicit grant has no effect.", ability, user.Role),
+				AttributePath: cty.GetAttrPath("abilities"),
+			})
+		}
+	}
+
+	permissions := &api.UserPermissions{
+		ID:                 user.ID,
+		UserID:             user.ID,
+		Role:               user.Role,
+		Abilities:          sortedKeys(granted),
+		RoleAbilities:      sortedKeys(roleAbilities),
+		EffectiveAbilities: sortedKeys(effectiveAbilities),
+	}
+
+	if err = tf.EncodeAndSet(permissions, d); err != nil {
+		return append(diags, diagFromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceUserPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	diags := updateUserPermissions(ctx, client, d.Id(), tf.ExpandStringSet(d.Get("abilities").(*schema.Set)))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceUserPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceUserPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateUserAbilities(ctx, &api.UpdateUserAbilitiesReq{
+		UserID:    d.Id(),
+		Abilities: []string{},
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func updateUserPermissions(ctx context.Context, client *api.Client, userID string, abilities []string) diag.Diagnostics {
+	user, err := client.GetUserById(ctx, userID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if user.Role == "stakeholder" && len(abilities) != 0 {
+		return diag.Errorf("stakeholders cannot have special abilities")
+	}
+
+	_, err = client.UpdateUserAbilities(ctx, &api.UpdateUserAbilitiesReq{
+		UserID:    userID,
+		Abilities: abilities,
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
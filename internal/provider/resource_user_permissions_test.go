@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestAccResourceUserPermissions(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resourceName := "squadcast_user_permissions.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserPermissionsConfig(user, `"manage-billing"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "role", "user"),
+					resource.TestCheckResourceAttr(resourceName, "abilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "abilities.*", "manage-billing"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "effective_abilities.*", "manage-billing"),
+				),
+			},
+			{
+				Config: testAccResourceUserPermissionsConfig(user, `"manage-billing", "manage-api-tokens"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "abilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "abilities.*", "manage-billing"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "abilities.*", "manage-api-tokens"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "effective_abilities.*", "manage-api-tokens"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUserPermissionsConfig(user testdata.User, abilities string) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_permissions" "test" {
+	user_id = squadcast_user.test.id
+	abilities = [%s]
+}
+	`, user.FirstName, user.LastName, user.Email, abilities)
+}
@@ -17,9 +17,9 @@ func TestAccResourceUserNoAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_noabilities(user),
@@ -47,9 +47,9 @@ func TestAccResourceUserAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_abilities(user),
@@ -78,9 +78,9 @@ func TestAccResourceUserStakeholderNoAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_stakeholder_noabilities(user),
@@ -107,9 +107,9 @@ func TestAccResourceUserStakeholderAbilities(t *testing.T) {
 	user := testdata.RandomUser()
 
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccResourceUserConfig_stakeholder_abilities(user),
@@ -124,9 +124,9 @@ func TestAccResourceUserNoAbilitiesToStakeholderNOAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_noabilities(user),
@@ -159,9 +159,9 @@ func TestAccResourceUserNoAbilitiesToStakeholderAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_noabilities(user),
@@ -187,9 +187,9 @@ func TestAccResourceUserAbilitiesToStakeholderNoAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_abilities(user),
@@ -223,9 +223,9 @@ func TestAccResourceUserAbilitiesToStakeholderAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_user_abilities(user),
@@ -252,9 +252,9 @@ func TestAccResourceUserStakeholderNoAbilitiesToUserNoAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_stakeholder_noabilities(user),
@@ -287,9 +287,9 @@ func TestAccResourceUserStakeholderNoAbilitiesToUserAbilities(t *testing.T) {
 
 	resourceName := "squadcast_user.test"
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckUserDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceUserConfig_stakeholder_noabilities(user),
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -23,165 +26,475 @@ func resourceWebform() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceWebformImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			validateEntityRefs("owner"),
+			validateReferences(webformReferences),
+			resourceWebformCustomizeDiff,
+		),
+
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceWebformV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceWebformStateUpgradeV0,
+			},
+			{
+				Version: 1,
+				Type:    resourceWebformV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceWebformStateUpgradeV1,
+			},
+		},
+		Schema: resourceWebformSchema(),
+	}
+}
 
+func resourceWebformSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "Webform id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the Webform.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"custom_domain_name": {
+			Description: "Custom domain name (URL).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"public_url": {
+			Description: "Public URL of the Webform.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"domain_verified": {
+			Description: "Whether the ownership of the custom domain is verified and the Webform is served on it.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"dns_records": {
+			Description: "DNS records to create for the custom domain: the TXT record verifying its ownership and the CNAME record pointing it to the Webform. Empty without a custom domain. Use `squadcast_webform_domain_verification` to wait for the verification once they are created.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "Record type (TXT, CNAME).",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "Fully qualified name of the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Value of the record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"mttr": {
+			Description: "Mean time to resolve the incidents created through the Webform over the last 30 days, in seconds. Not refreshed when `skip_analytics_refresh` is set on the provider.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"incident_count": {
+			Description: "Number of incidents created through the Webform over the last 30 days. Not refreshed when `skip_analytics_refresh` is set on the provider.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"owner": {
+			Description: "Form owner.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: entityRefSchema("Form owner", []string{"user", "squad", "team"}, nil),
+			},
+		},
+		"header": {
+			Description: "Webform header.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"title": {
+			Description: "Webform title (public).",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "Description of the Webform.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"footer_text": {
+			Description: "Footer text.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"footer_link": {
+			Description: "Footer link.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"email_on": {
+			Description: "Defines when to send email to the reporter (triggered, acknowledged, resolved).",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"triggered", "acknowledged", "resolved"}, false),
+			},
+			Set: schema.HashString,
+		},
+		"tags": {
+			Description: "Webform Tags.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"services": {
+			Description: "Services added to Webform, in the order they are listed in the dropdown of the public form unless a `weight` is set.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service_id": {
+						Description: "Service ID.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"name": {
+						Description: "Service name.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"alias": {
+						Description: "Service alias.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"weight": {
+						Description:  "Position of the service in the dropdown of the public form. Services with a lower weight are listed first, services with the same weight are listed in the configured order.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 1000),
+					},
+					"group": {
+						Description: "Heading under which the service is grouped in the dropdown of the public form.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"default": {
+						Description: "Whether the service is selected by default in the dropdown of the public form. At most one service can be the default.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"severity": {
+			Description: "Severity of the incident.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Deprecated:  "Use `input_field` instead of `severity`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "Severity type.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"description": {
+						Description: "Severity description.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"input_field": {
+			Description:   "Input Fields added to Webforms. Added as tags to incident based on selection.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      10,
+			ConflictsWith: []string{"severity"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"label": {
+						Description: "Input field Label.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"options": {
+						Description: "Input field options.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    10,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"success_page": {
+			Description: "What the reporter is shown once the form is submitted. Defaults to the default thank-you message of Squadcast.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"message": {
+						Description:  "Thank-you message shown after the submission.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"redirect_url": {
+						Description:  "URL the reporter is redirected to after the submission, instead of being shown the message.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+					"show_incident_id": {
+						Description: "Whether the id of the created incident is shown to the reporter, e.g. for them to quote it when following up. Defaults to `false`.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceWebformV0 is the schema of the states written before the services were normalized, the attributes are
+// those of version 1 but the services of these states may miss the attributes added since.
+func resourceWebformV0() *schema.Resource {
+	return resourceWebformV1()
+}
+
+func resourceWebformStateUpgradeV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	services, _ := rawState["services"].([]any)
+
+	normalized := make([]any, 0, len(services))
+	for _, s := range services {
+		service, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		for k, v := range map[string]any{"name": "", "alias": "", "weight": 0, "group": "", "default": false} {
+			if service[k] == nil {
+				service[k] = v
+			}
+		}
+		normalized = append(normalized, service)
+	}
+	rawState["services"] = normalized
+
+	return rawState, nil
+}
+
+// resourceWebformV1 is the schema before email_on was changed from a list to a set. It is a frozen copy of the schema
+// of that version, later changes to the schema must not be made here.
+func resourceWebformV1() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "Webform id.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"name": {
-				Description: "Name of the Webform.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"team_id": {
-				Description:  "Team id.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: tf.ValidateObjectID,
-				ForceNew:     true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"custom_domain_name": {
-				Description: "Custom domain name (URL).",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"public_url": {
-				Description: "Public URL of the Webform.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dns_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mttr": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"incident_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
 			},
 			"owner": {
-				Description: "Form owner.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MaxItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Description: "Form owner type (user, team, squad).",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"id": {
-							Description: "Form owner id.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
 						},
 						"name": {
-							Description: "Form owner name.",
-							Type:        schema.TypeString,
-							Computed:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
 						},
 					},
 				},
 			},
 			"header": {
-				Description: "Webform header.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"title": {
-				Description: "Webform title (public).",
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"description": {
-				Description: "Description of the Webform.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"footer_text": {
-				Description: "Footer text.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"footer_link": {
-				Description: "Footer link.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"email_on": {
-				Description: "Defines when to send email to the reporter (triggered, acknowledged, resolved).",
-				Type:        schema.TypeList,
-				Optional:    true,
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"triggered", "acknowledged", "resolved"}, false),
+					Type: schema.TypeString,
 				},
 			},
 			"tags": {
-				Description: "Webform Tags.",
-				Type:        schema.TypeMap,
-				Optional:    true,
+				Type:     schema.TypeMap,
+				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 			"services": {
-				Description: "Services added to Webform.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"service_id": {
-							Description: "Service ID.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"name": {
-							Description: "Service name.",
-							Type:        schema.TypeString,
-							Computed:    true,
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 						"alias": {
-							Description: "Service alias.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Optional: true,
 						},
 					},
 				},
 			},
 			"severity": {
-				Description: "Severity of the incident.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Deprecated:  "Use `input_field` instead of `severity`.",
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Description: "Severity type.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Type:     schema.TypeString,
+							Required: true,
 						},
 						"description": {
-							Description: "Severity description.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 					},
 				},
 			},
 			"input_field": {
-				Description:   "Input Fields added to Webforms. Added as tags to incident based on selection.",
-				Type:          schema.TypeList,
-				Optional:      true,
-				MaxItems:      10,
-				ConflictsWith: []string{"severity"},
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"label": {
-							Description: "Input field Label.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 						"options": {
-							Description: "Input field options.",
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    10,
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
@@ -189,10 +502,149 @@ func resourceWebform() *schema.Resource {
 					},
 				},
 			},
+			"success_page": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"redirect_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"show_incident_id": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func resourceWebformStateUpgradeV1(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	return upgradeListsToSets(rawState, "email_on"), nil
+}
+
+// expandWebformSuccessPage returns the `success_page` block, or nil for the default success page.
+func expandWebformSuccessPage(d *schema.ResourceData) *api.WFSuccessPage {
+	msuccessPage := d.Get("success_page").([]any)
+	if len(msuccessPage) == 0 || msuccessPage[0] == nil {
+		return nil
+	}
+
+	successPage := msuccessPage[0].(map[string]any)
+	return &api.WFSuccessPage{
+		Message:        successPage["message"].(string),
+		RedirectURL:    successPage["redirect_url"].(string),
+		ShowIncidentID: successPage["show_incident_id"].(bool),
+	}
+}
+
+// setWebformAnalytics reads the analytics of a webform into `mttr` and `incident_count`, unless the provider skips the analytics refresh.
+func setWebformAnalytics(ctx context.Context, client *api.Client, d *schema.ResourceData, teamID, webformID string) diag.Diagnostics {
+	if client.SkipAnalyticsRefresh {
+		return nil
+	}
+
+	analytics, err := client.GetWebformAnalytics(ctx, teamID, webformID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if err = d.Set("mttr", analytics.MTTR); err != nil {
+		return diagFromErr(err)
+	}
+	if err = d.Set("incident_count", analytics.IncidentCount); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// setWebformDomainStatus reads the DNS records and the verification status of the custom domain of a webform into
+// `dns_records` and `domain_verified`.
+func setWebformDomainStatus(ctx context.Context, client *api.Client, d *schema.ResourceData, teamID, webformID string) diag.Diagnostics {
+	records := []any{}
+	verified := false
+	if d.Get("custom_domain_name").(string) != "" {
+		status, err := client.GetWebformDomainStatus(ctx, teamID, webformID)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if records, err = tf.EncodeSlice(status.DNSRecords); err != nil {
+			return diagFromErr(err)
+		}
+		verified = status.Verified
+	}
+
+	if err := d.Set("dns_records", records); err != nil {
+		return diagFromErr(err)
+	}
+	if err := d.Set("domain_verified", verified); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// resourceWebformCustomizeDiff rejects more than one default service, and marks the DNS records as unknown when the
+// custom domain changes.
+func resourceWebformCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && d.HasChange("custom_domain_name") {
+		if err := d.SetNewComputed("dns_records"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("domain_verified"); err != nil {
+			return err
+		}
+	}
+
+	return validateWebformDefaultService(d.Get("services").([]any))
+}
+
+func validateWebformDefaultService(services []any) error {
+	defaultIndex := -1
+	for i, s := range services {
+		service, ok := s.(map[string]any)
+		if !ok || !service["default"].(bool) {
+			continue
+		}
+		if defaultIndex >= 0 {
+			return fmt.Errorf("services.%d.default: only one service can be the default, services.%d is already the default", i, defaultIndex)
+		}
+		defaultIndex = i
+	}
+
+	return nil
+}
+
+// webformReferences returns the team, the owner and the services of the webform.
+func webformReferences(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error) {
+	teamID := d.Get("team_id").(string)
+	if !d.NewValueKnown("team_id") {
+		teamID = ""
+	}
+
+	refs := []reference{{Attribute: "team_id", Type: "team", ID: teamID}}
+	refs = append(refs, ownerReferences("owner", d.Get("owner").([]any), teamID)...)
+	if teamID == "" {
+		return refs, nil
+	}
+	for i, s := range d.Get("services").([]any) {
+		if service, ok := s.(map[string]any); ok {
+			refs = append(refs, reference{Attribute: fmt.Sprintf("services.%d.service_id", i), Type: "service", ID: service["service_id"].(string), TeamID: teamID})
+		}
+	}
+
+	return refs, nil
+}
+
 func resourceWebformImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*api.Client)
 	teamID, webformName, err := parse2PartImportID(d.Id())
@@ -226,7 +678,11 @@ func resourceWebformCreate(ctx context.Context, d *schema.ResourceData, meta any
 		"name": d.Get("name").(string),
 	})
 
-	webformOwner := d.Get("owner").([]interface{})[0].(map[string]interface{})
+	mowner := d.Get("owner").([]interface{})
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"owner": mowner}, "owner"); err != nil {
+		return diagFromErr(err)
+	}
+	webformOwner := mowner[0].(map[string]interface{})
 
 	webformCreateReq := api.WebformReq{
 		Name:          d.Get("name").(string),
@@ -245,35 +701,31 @@ func resourceWebformCreate(ctx context.Context, d *schema.ResourceData, meta any
 		webformCreateReq.IsCname = true
 	}
 
-	memailon := d.Get("email_on").([]interface{})
-	emailon := make([]string, len(memailon))
-	for i, v := range memailon {
-		emailon[i] = v.(string)
-	}
+	emailon := tf.ExpandStringSet(d.Get("email_on").(*schema.Set))
 	webformCreateReq.EmailOn = emailon
 
 	mservices := d.Get("services").([]interface{})
 
 	var services []api.WFService
-	err := Decode(mservices, &services)
+	err := tf.DecodeAt("services", mservices, &services)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformCreateReq.Services = services
 
 	mseverity := d.Get("severity").([]interface{})
 	var severity []api.WFSeverity
-	err = Decode(mseverity, &severity)
+	err = tf.DecodeAt("severity", mseverity, &severity)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformCreateReq.Severity = severity
 
 	minputField := d.Get("input_field").([]interface{})
 	var inputField []api.WFInputField
-	err = Decode(minputField, &inputField)
+	err = tf.DecodeAt("input_field", minputField, &inputField)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformCreateReq.InputField = inputField
 
@@ -284,17 +736,38 @@ func resourceWebformCreate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	webformCreateReq.Tags = tags
+	webformCreateReq.SuccessPage = expandWebformSuccessPage(d)
 
 	webformRes, err := client.CreateWebform(ctx, d.Get("team_id").(string), &webformCreateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		adopted, diags := adoptExistingOnConflict(ctx, client, d, err, "webform", d.Get("name").(string), func() (string, error) {
+			existing, err := client.GetWebformByName(ctx, d.Get("team_id").(string), d.Get("name").(string))
+			if err != nil {
+				return "", err
+			}
+			return strconv.FormatUint(uint64(existing.ID), 10), nil
+		})
+		if !adopted {
+			return diags
+		}
+		return append(diags, resourceWebformUpdate(ctx, d, meta)...)
 	}
 	webform := webformRes.WebFormRes
 
 	webformId := strconv.FormatUint(uint64(webform.ID), 10)
 	d.SetId(webformId)
 
-	return resourceWebformRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		// The custom domain of a webform is provisioned asynchronously, the webform is not found until it is.
+		_, err := api.WaitForFound(ctx, d.Timeout(schema.TimeoutCreate), func(ctx context.Context) (*api.Webform, error) {
+			return client.GetWebformById(ctx, d.Get("team_id").(string), webformId)
+		})
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		return resourceWebformRead(ctx, d, meta)
+	})
 }
 
 func resourceWebformRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -313,28 +786,70 @@ func resourceWebformRead(ctx context.Context, d *schema.ResourceData, meta any)
 	})
 
 	webform, err := client.GetWebformById(ctx, teamID.(string), id)
+	if err != nil && api.IsResourceNotFoundError(err) && d.Get("custom_domain_name").(string) != "" {
+		// The webforms with a custom domain are not found by id while the domain is provisioned, they are listed by name.
+		webform, err = findWebformByName(ctx, client, teamID.(string), d.Get("name").(string), id, err)
+	}
 	if err != nil {
 		if api.IsResourceNotFoundError(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(webform, d); err != nil {
-		return diag.FromErr(err)
+	m, err := webform.Encode()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	preserveEntityRefNames(d, m, "owner")
+	if err = tf.SetState(d, m); err != nil {
+		return diagFromErr(err)
+	}
+
+	if diags := setWebformDomainStatus(ctx, client, d, webform.TeamID, strconv.FormatUint(uint64(webform.ID), 10)); diags.HasError() {
+		return diags
+	}
+
+	if diags := setWebformAnalytics(ctx, client, d, webform.TeamID, strconv.FormatUint(uint64(webform.ID), 10)); diags.HasError() {
+		return diags
 	}
 
 	return nil
 }
 
+// findWebformByName looks a webform with the given id up by name, it returns notFoundErr when the webform with the
+// name has another id or there is none.
+func findWebformByName(ctx context.Context, client *api.Client, teamID, name, id string, notFoundErr error) (*api.Webform, error) {
+	tflog.Info(ctx, "Looking up webform by name", tf.M{
+		"id":   id,
+		"name": name,
+	})
+	webform, err := client.GetWebformByName(ctx, teamID, name)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil, notFoundErr
+		}
+		return nil, err
+	}
+	if strconv.FormatUint(uint64(webform.ID), 10) != id {
+		return nil, notFoundErr
+	}
+
+	return webform, nil
+}
+
 func resourceWebformUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	tflog.Info(ctx, "Creating webform", tf.M{
 		"name": d.Get("name").(string),
 	})
-	webformOwner := d.Get("owner").([]interface{})[0].(map[string]interface{})
+	mowner := d.Get("owner").([]interface{})
+	if err := resolveEntityRefs(ctx, client, d, d.Get("team_id").(string), tf.M{"owner": mowner}, "owner"); err != nil {
+		return diagFromErr(err)
+	}
+	webformOwner := mowner[0].(map[string]interface{})
 
 	webformUpdateReq := api.WebformReq{
 		Name:          d.Get("name").(string),
@@ -353,35 +868,31 @@ func resourceWebformUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		webformUpdateReq.IsCname = true
 	}
 
-	memailon := d.Get("email_on").([]interface{})
-	emailon := make([]string, len(memailon))
-	for i, v := range memailon {
-		emailon[i] = v.(string)
-	}
+	emailon := tf.ExpandStringSet(d.Get("email_on").(*schema.Set))
 	webformUpdateReq.EmailOn = emailon
 
 	mservices := d.Get("services").([]interface{})
 
 	var services []api.WFService
-	err := Decode(mservices, &services)
+	err := tf.DecodeAt("services", mservices, &services)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformUpdateReq.Services = services
 
 	mseverity := d.Get("severity").([]interface{})
 	var severity []api.WFSeverity
-	err = Decode(mseverity, &severity)
+	err = tf.DecodeAt("severity", mseverity, &severity)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformUpdateReq.Severity = severity
 
 	minputField := d.Get("input_field").([]interface{})
 	var inputField []api.WFInputField
-	err = Decode(minputField, &inputField)
+	err = tf.DecodeAt("input_field", minputField, &inputField)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	webformUpdateReq.InputField = inputField
 
@@ -392,10 +903,11 @@ func resourceWebformUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	webformUpdateReq.Tags = tags
+	webformUpdateReq.SuccessPage = expandWebformSuccessPage(d)
 
 	_, err = client.UpdateWebform(ctx, d.Get("team_id").(string), d.Id(), &webformUpdateReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceWebformRead(ctx, d, meta)
 }
@@ -413,7 +925,7 @@ func resourceWebformDelete(ctx context.Context, d *schema.ResourceData, meta any
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceWebformDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to wait until the custom domain of a webform is verified. It does not manage anything in Squadcast, it lets the DNS records exported by `squadcast_webform` (`dns_records`) be created in the same apply, e.g. with `aws_route53_record`, and the resources served on the custom domain depend on its verification. " +
+			"Destroying this resource has no effect on the webform.",
+
+		CreateContext: resourceWebformDomainVerificationCreate,
+		ReadContext:   resourceWebformDomainVerificationRead,
+		DeleteContext: resourceWebformDomainVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"webform_id": {
+				Description: "Webform id.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"custom_domain_name": {
+				Description: "Verified custom domain name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWebformDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Waiting for the verification of the custom domain of webform", tf.M{
+		"webform_id": d.Get("webform_id").(string),
+	})
+	status, err := client.WaitForWebformDomainVerification(ctx, d.Get("team_id").(string), d.Get("webform_id").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(d.Get("webform_id").(string))
+	if err = d.Set("custom_domain_name", status.HostName); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWebformDomainVerificationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading custom domain status of webform", tf.M{
+		"webform_id": d.Id(),
+	})
+	status, err := client.GetWebformDomainStatus(ctx, d.Get("team_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	// A domain that is no longer verified, e.g. after its records were removed, is waited for again.
+	if !status.Verified {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("custom_domain_name", status.HostName); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWebformDomainVerificationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestWebformDomainVerification(t *testing.T) {
+	verified := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/webform/42/domain-status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if verified {
+			w.Write([]byte(`{"data":{"host_name":"support.example.com","is_verified":true}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"host_name":"support.example.com","is_verified":false,"dns_records":[{"type":"TXT","name":"_squadcast.support.example.com","value":"token"},{"type":"CNAME","name":"support.example.com","value":"webforms.squadcast.com"}]}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	r := resourceWebformDomainVerification()
+
+	// An unverified domain fails once the timeout is reached.
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"team_id":    "61305a9e127c63c6d2c8f76d",
+		"webform_id": "42",
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	diags := r.CreateContext(ctx, d, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "is not verified yet") {
+		t.Fatalf("expected the wait to time out, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no id before the verification, got %q", d.Id())
+	}
+
+	verified = true
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "42" || d.Get("custom_domain_name") != "support.example.com" {
+		t.Errorf("expected the verified domain of the webform, got %q and %q", d.Id(), d.Get("custom_domain_name"))
+	}
+
+	// A domain that is no longer verified is removed from the state to be waited for again.
+	verified = false
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the unverified domain to be removed from the state, got %q", d.Id())
+	}
+}
+
+func TestWaitForWebformDomainVerification_canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"host_name":"support.example.com","is_verified":false}}`))
+	}))
+	defer server.Close()
+
+	client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token"}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.WaitForWebformDomainVerification(ctx, "61305a9e127c63c6d2c8f76d", "42", time.Minute); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the wait to stop with the context, got %v", err)
+	}
+}
@@ -3,22 +3,26 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 func TestAccResourceWebform(t *testing.T) {
-	webformName := "webform"
+	webformName := testAccName("webform")
 	resourceName := "squadcast_webform.test"
 
 	resource.UnitTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckWebformDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWebformDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceWebformConfigWithInputFields(webformName),
@@ -26,6 +30,10 @@ func TestAccResourceWebform(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "61305a9e127c63c6d2c8f76d"),
 					resource.TestCheckResourceAttr(resourceName, "name", webformName),
+					resource.TestCheckResourceAttrSet(resourceName, "incident_count"),
+					resource.TestCheckResourceAttrSet(resourceName, "mttr"),
+					resource.TestCheckResourceAttr(resourceName, "domain_verified", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dns_records.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "owner.0.id", "61305a9e127c63c6d2c8f76d"),
 					resource.TestCheckResourceAttr(resourceName, "owner.0.type", "team"),
 					resource.TestCheckResourceAttr(resourceName, "owner.0.name", "Default Team"),
@@ -78,7 +86,8 @@ func TestAccResourceWebform(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "input_field.0.options.0", "critical"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.service_id", "6389ba2ec31b7df1caecd579"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.name", "Test"),
-					resource.TestCheckResourceAttr(resourceName, "email_on.0", "triggered"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "email_on.*", "triggered"),
+					resource.TestCheckResourceAttr(resourceName, "success_page.#", "0"),
 				),
 			},
 			{
@@ -99,8 +108,11 @@ func TestAccResourceWebform(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "input_field.0.options.0", "critical"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.service_id", "6389ba2ec31b7df1caecd579"),
 					resource.TestCheckResourceAttr(resourceName, "services.0.name", "Test"),
-					resource.TestCheckResourceAttr(resourceName, "email_on.0", "triggered"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "email_on.*", "triggered"),
 					resource.TestCheckResourceAttr(resourceName, "tags.testKey", "testVal"),
+					resource.TestCheckResourceAttr(resourceName, "success_page.0.message", "Thanks, we are on it."),
+					resource.TestCheckResourceAttr(resourceName, "success_page.0.redirect_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "success_page.0.show_incident_id", "true"),
 				),
 			},
 			{
@@ -120,6 +132,98 @@ func TestAccResourceWebform(t *testing.T) {
 	})
 }
 
+func TestValidateWebformDefaultService(t *testing.T) {
+	services := []any{
+		map[string]any{"service_id": "a", "default": false},
+		map[string]any{"service_id": "b", "default": true},
+	}
+	if err := validateWebformDefaultService(services); err != nil {
+		t.Errorf("expected a single default service to be valid, got %s", err)
+	}
+
+	services = append(services, map[string]any{"service_id": "c", "default": true})
+	err := validateWebformDefaultService(services)
+	if err == nil || err.Error() != "services.2.default: only one service can be the default, services.1 is already the default" {
+		t.Errorf("expected two default services to be rejected, got %v", err)
+	}
+}
+
+func TestResourceWebformStateUpgradeV0(t *testing.T) {
+	rawState := map[string]any{
+		"id": "42",
+		"services": []any{
+			map[string]any{"service_id": "6389ba2ec31b7df1caecd579", "name": "api", "alias": ""},
+			nil,
+			map[string]any{"service_id": "6389ba2ec31b7df1caecd57a", "name": "web", "alias": "Website", "weight": float64(1), "default": true},
+		},
+	}
+
+	actual, err := resourceWebformStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	services := actual["services"].([]any)
+	if len(services) != 2 {
+		t.Fatalf("expected the empty service to be dropped, got %#v", services)
+	}
+	first := services[0].(map[string]any)
+	if first["weight"] != 0 || first["group"] != "" || first["default"] != false {
+		t.Errorf("expected the missing attributes to be set, got %#v", first)
+	}
+	second := services[1].(map[string]any)
+	if second["weight"] != float64(1) || second["default"] != true || second["alias"] != "Website" {
+		t.Errorf("expected the attributes to be kept, got %#v", second)
+	}
+}
+
+func TestWebformEncode_successPage(t *testing.T) {
+	for _, tc := range []struct {
+		successPage *api.WFSuccessPage
+		want        int
+	}{
+		{nil, 0},
+		{&api.WFSuccessPage{}, 0},
+		{&api.WFSuccessPage{RedirectURL: "https://status.example.com"}, 1},
+	} {
+		m, err := (&api.Webform{SuccessPage: tc.successPage}).Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(m["success_page"].([]tf.M)); got != tc.want {
+			t.Errorf("expected %d success_page blocks for %#v, got %d", tc.want, tc.successPage, got)
+		}
+	}
+}
+
+func TestAccResourceWebform_serviceOrdering(t *testing.T) {
+	webformName := testAccName("webform")
+	resourceName := "squadcast_webform.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWebformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceWebformConfigServiceOrdering(webformName, true),
+				ExpectError: regexp.MustCompile("only one service can be the default"),
+			},
+			{
+				Config: testAccResourceWebformConfigServiceOrdering(webformName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "services.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.weight", "10"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.group", "Payments"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "services.1.weight", "0"),
+					resource.TestCheckResourceAttr(resourceName, "services.1.default", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckWebformDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -250,6 +354,80 @@ func testAccResourceWebformConfig_tags(webformName string) string {
 			tags = {
 				"testKey" = "testVal"
 			}
+			success_page {
+				message = "Thanks, we are on it."
+				show_incident_id = true
+			}
 		}
 	`, webformName)
 }
+
+func testAccResourceWebformConfigServiceOrdering(webformName string, twoDefaults bool) string {
+	return fmt.Sprintf(`
+		resource "squadcast_webform" "test" {
+			name = "%s"
+			team_id = "61305a9e127c63c6d2c8f76d"
+			owner {
+				id = "61305a9e127c63c6d2c8f76d"
+				type = "team"
+			}
+			header = "test header"
+			title = "test title"
+			input_field {
+				label = "severity"
+				options = ["critical"]
+			}
+			services {
+				service_id = "6389ba2ec31b7df1caecd579"
+				weight = 10
+				group = "Payments"
+				default = %t
+			}
+			services {
+				service_id = "63ad5e9fd3d8d5f4ac0a5b2f"
+				default = true
+			}
+		}
+	`, webformName, twoDefaults)
+}
+
+func TestResourceWebformRead_pendingCustomDomain(t *testing.T) {
+	for name, byName := range map[string]string{
+		"listed by name":    `{"data":{"id":12,"owner_id":"613611c1eb22db455cfa789f","name":"support","host_name":"support.example.com","form_owner_type":"user","form_owner_id":"5f8891527f735f0a6646f3b6"}}`,
+		"other id":          `{"data":{"id":13,"owner_id":"613611c1eb22db455cfa789f","name":"support","host_name":"support.example.com","form_owner_type":"user","form_owner_id":"5f8891527f735f0a6646f3b6"}}`,
+		"not listed either": "",
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v3/webform/by-name":
+				if byName != "" {
+					w.Write([]byte(byName))
+					return
+				}
+			case "/v3/webform/12/domain-status":
+				w.Write([]byte(`{"data":{"host_name":"support.example.com","is_verified":false}}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"meta":{"status":404,"error_message":"webform not found"}}`))
+		}))
+
+		client := &api.Client{BaseURLV3: server.URL + "/v3", ServiceAccountToken: "token", SkipAnalyticsRefresh: true}
+		r := resourceWebform()
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+			"team_id":            "613611c1eb22db455cfa789f",
+			"name":               "support",
+			"custom_domain_name": "support.example.com",
+		})
+		d.SetId("12")
+
+		if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Errorf("%s: %v", name, diags)
+		}
+		if found := d.Id() == "12"; found != (name == "listed by name") {
+			t.Errorf("%s: unexpected id %q", name, d.Id())
+		}
+
+		server.Close()
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// upgradeListsToSets drops the duplicate elements of the given attributes of a state, which were lists in the prior
+// version of the schema and are sets now. Lists and sets are stored alike otherwise.
+func upgradeListsToSets(rawState map[string]any, attributes ...string) map[string]any {
+	for _, attribute := range attributes {
+		list, ok := rawState[attribute].([]any)
+		if !ok {
+			continue
+		}
+
+		seen := make(map[string]bool, len(list))
+		unique := make([]any, 0, len(list))
+		for _, e := range list {
+			k := fmt.Sprintf("%#v", e)
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			unique = append(unique, e)
+		}
+		rawState[attribute] = unique
+	}
+
+	return rawState
+}
+
+// hashTag hashes the tags of the sets of tags by their key and value, their computed attributes being left out.
+func hashTag(v any) int {
+	tag := v.(map[string]any)
+
+	return schema.HashString(fmt.Sprintf("%s=%s", tag["key"], tag["value"]))
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSquadStateUpgradeV0(t *testing.T) {
+	rawState := map[string]any{
+		"id":         "61305a8eb7a2fa0e44cfd0f5",
+		"member_ids": []any{"5f8891527f735f0a6646f3b6", "5eb26b36ec9f070550204c85", "5f8891527f735f0a6646f3b6"},
+	}
+
+	actual, err := resourceSquadStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	expected := []any{"5f8891527f735f0a6646f3b6", "5eb26b36ec9f070550204c85"}
+	if !reflect.DeepEqual(actual["member_ids"], expected) {
+		t.Errorf("expected the duplicate member to be dropped, got %#v", actual["member_ids"])
+	}
+}
+
+func TestResourceServiceStateUpgradeV0(t *testing.T) {
+	rawState := map[string]any{
+		"id": "6389ba2ec31b7df1caecd579",
+		"tags": []any{
+			map[string]any{"key": "env", "value": "prod"},
+			map[string]any{"key": "team", "value": "sre"},
+			map[string]any{"key": "env", "value": "prod"},
+		},
+	}
+
+	actual, err := resourceServiceStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if tags := actual["tags"].([]any); len(tags) != 2 {
+		t.Errorf("expected the duplicate tag to be dropped, got %#v", tags)
+	}
+}
+
+func TestHashTag(t *testing.T) {
+	configured := map[string]any{"key": "env", "value": "prod"}
+	read := map[string]any{"key": "env", "value": "prod", "color": "#0f61dd"}
+	if hashTag(configured) != hashTag(read) {
+		t.Error("expected the computed color to be left out of the hash")
+	}
+	if hashTag(configured) == hashTag(map[string]any{"key": "env", "value": "staging"}) {
+		t.Error("expected tags with different values to have different hashes")
+	}
+}
+
+func TestPriorSchemasHaveLists(t *testing.T) {
+	for name, c := range map[string]struct {
+		r         *schema.Resource
+		attribute string
+	}{
+		"squad":       {resourceSquadV0(), "member_ids"},
+		"service":     {resourceServiceV0(), "tags"},
+		"schedule_v2": {resourceScheduleV2V0(), "tags"},
+		"webform":     {resourceWebformV1(), "email_on"},
+	} {
+		if ty := c.r.CoreConfigSchema().ImpliedType().AttributeType(c.attribute); !ty.IsListType() {
+			t.Errorf("%s: expected %s to be a list in the prior schema, got %#v", name, c.attribute, ty)
+		}
+	}
+}
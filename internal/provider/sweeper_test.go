@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// The sweepers delete the objects named with testAccResourcePrefix that failed acceptance test runs left behind,
+// in all the teams of the organization, e.g. `go test ./internal/provider -v -sweep=us`.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("squadcast_webform", &resource.Sweeper{
+		Name: "squadcast_webform",
+		F:    sweepWebforms,
+	})
+	resource.AddTestSweepers("squadcast_service", &resource.Sweeper{
+		Name:         "squadcast_service",
+		Dependencies: []string{"squadcast_webform"},
+		F:            sweepServices,
+	})
+	resource.AddTestSweepers("squadcast_escalation_policy", &resource.Sweeper{
+		Name:         "squadcast_escalation_policy",
+		Dependencies: []string{"squadcast_service"},
+		F:            sweepEscalationPolicies,
+	})
+	resource.AddTestSweepers("squadcast_escalation_policy_template", &resource.Sweeper{
+		Name: "squadcast_escalation_policy_template",
+		F:    sweepEscalationPolicyTemplates,
+	})
+	resource.AddTestSweepers("squadcast_postmortem_template", &resource.Sweeper{
+		Name: "squadcast_postmortem_template",
+		F:    sweepPostmortemTemplates,
+	})
+	resource.AddTestSweepers("squadcast_schedule_rotation_v2", &resource.Sweeper{
+		Name:         "squadcast_schedule_rotation_v2",
+		Dependencies: []string{"squadcast_escalation_policy"},
+		F:            sweepScheduleRotationsV2,
+	})
+	resource.AddTestSweepers("squadcast_schedule_v2", &resource.Sweeper{
+		Name:         "squadcast_schedule_v2",
+		Dependencies: []string{"squadcast_escalation_policy", "squadcast_schedule_rotation_v2"},
+		F:            sweepSchedulesV2,
+	})
+	resource.AddTestSweepers("squadcast_squad", &resource.Sweeper{
+		Name:         "squadcast_squad",
+		Dependencies: []string{"squadcast_escalation_policy", "squadcast_schedule_v2"},
+		F:            sweepSquads,
+	})
+}
+
+func sweeperClient(region string) (*api.Client, error) {
+	p := New("dev")()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{"region": region})); diags.HasError() {
+		return nil, fmt.Errorf("configuring the provider: %s", diags[0].Summary)
+	}
+
+	return p.Meta().(*api.Client), nil
+}
+
+// sweepTeams calls sweep for each team of the organization, the errors are collected so that one team does not
+// prevent the others from being swept.
+func sweepTeams(region string, sweep func(ctx context.Context, client *api.Client, teamID string) error) error {
+	client, err := sweeperClient(region)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	teams, err := client.ListTeams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, team := range teams {
+		if err := sweep(ctx, client, team.ID); err != nil {
+			errs = append(errs, fmt.Sprintf("team %s: %s", team.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func sweepWebforms(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		webforms, err := client.ListWebforms(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, webform := range api.FilterByNamePrefix(webforms, testAccResourcePrefix, func(w *api.Webform) string { return w.Name }) {
+			log.Printf("[INFO] Deleting webform %s (%d)", webform.Name, webform.ID)
+			if _, err := client.DeleteWebform(ctx, teamID, strconv.FormatUint(uint64(webform.ID), 10)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepServices(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		services, err := client.ListServices(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, service := range api.FilterByNamePrefix(services, testAccResourcePrefix, func(s *api.Service) string { return s.Name }) {
+			log.Printf("[INFO] Deleting service %s (%s)", service.Name, service.ID)
+			if _, err := client.DeleteService(ctx, service.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepEscalationPolicies(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		policies, err := client.ListEscalationPolicies(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range api.FilterByNamePrefix(policies, testAccResourcePrefix, func(p *api.EscalationPolicy) string { return p.Name }) {
+			log.Printf("[INFO] Deleting escalation policy %s (%s)", policy.Name, policy.ID)
+			if _, err := client.DeleteEscalationPolicy(ctx, policy.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepEscalationPolicyTemplates(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		templates, err := client.ListEscalationPolicyTemplates(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range api.FilterByNamePrefix(templates, testAccResourcePrefix, func(t *api.EscalationPolicyTemplate) string { return t.Name }) {
+			log.Printf("[INFO] Deleting escalation policy template %s (%s)", template.Name, template.ID)
+			if _, err := client.DeleteEscalationPolicyTemplate(ctx, template.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepPostmortemTemplates(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		templates, err := client.ListPostmortemTemplates(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range api.FilterByNamePrefix(templates, testAccResourcePrefix, func(t *api.PostmortemTemplate) string { return t.Name }) {
+			log.Printf("[INFO] Deleting postmortem template %s (%s)", template.Name, template.ID)
+			if _, err := client.DeletePostmortemTemplate(ctx, template.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// sweepScheduleRotationsV2 deletes the test rotations of the schedules that are not swept themselves.
+func sweepScheduleRotationsV2(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		schedules, err := client.ListSchedulesV2(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range schedules {
+			if strings.HasPrefix(schedule.Name, testAccResourcePrefix) {
+				continue
+			}
+			for _, rotation := range api.FilterByNamePrefix(schedule.Rotations, testAccResourcePrefix, func(r api.NewRotation) string { return r.Name }) {
+				log.Printf("[INFO] Deleting rotation %s (%d) of schedule %s", rotation.Name, rotation.ID, schedule.Name)
+				if _, err := client.DeleteScheduleRotationByID(ctx, strconv.Itoa(rotation.ID)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepSchedulesV2(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		schedules, err := client.ListSchedulesV2(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range api.FilterByNamePrefix(schedules, testAccResourcePrefix, func(s *api.NewSchedule) string { return s.Name }) {
+			log.Printf("[INFO] Deleting schedule %s (%d)", schedule.Name, schedule.ID)
+			if _, err := client.DeleteScheduleV2ByID(ctx, strconv.Itoa(schedule.ID)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func sweepSquads(region string) error {
+	return sweepTeams(region, func(ctx context.Context, client *api.Client, teamID string) error {
+		squads, err := client.ListSquads(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		for _, squad := range api.FilterByNamePrefix(squads, testAccResourcePrefix, func(s *api.Squad) string { return s.Name }) {
+			log.Printf("[INFO] Deleting squad %s (%s)", squad.Name, squad.ID)
+			if _, err := client.DeleteSquad(ctx, squad.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// teamMembersBatchSize is the maximum number of membership changes sent in a single request.
+const teamMembersBatchSize = 100
+
+// teamMembersDelta holds the changes needed to go from one membership of a team to another.
+type teamMembersDelta struct {
+	Add    []*api.CreateTeamMemberReq
+	Update []*api.CreateTeamMemberReq
+	Remove []string
+}
+
+func (delta *teamMembersDelta) empty() bool {
+	return len(delta.Add) == 0 && len(delta.Update) == 0 && len(delta.Remove) == 0
+}
+
+// teamDefaultRoles are the keys of the default roles of a team, they can be used in place of role ids.
+var teamDefaultRoles = []string{"manage_team", "admin", "user", "observer"}
+
+func teamMemberSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description:  "user id (ObjectId).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"roles": {
+				Description: "Default roles of the team, by key. Allowed values: " + strings.Join(teamDefaultRoles, ", ") + ".",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(teamDefaultRoles, false),
+				},
+			},
+			"role_ids": {
+				Description: "role ids, e.g. of custom roles. At least one of roles and role_ids must be set.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+		},
+	}
+}
+
+// expandTeamMembers converts the members set of the state into team members, resolving the default roles
+// into role ids and rejecting users set more than once.
+func expandTeamMembers(set *schema.Set, defaultRoleIDs map[string]string) ([]*api.CreateTeamMemberReq, error) {
+	members := make([]*api.CreateTeamMemberReq, 0, set.Len())
+	users := make(map[string]bool, set.Len())
+	for _, item := range set.List() {
+		m := item.(map[string]any)
+		userID := m["user_id"].(string)
+		if users[userID] {
+			return nil, fmt.Errorf("members: user %s is set more than once", userID)
+		}
+		users[userID] = true
+
+		roleIDs := tf.ExpandStringSet(m["role_ids"].(*schema.Set))
+		for _, role := range tf.ExpandStringSet(m["roles"].(*schema.Set)) {
+			roleID, ok := defaultRoleIDs[role]
+			if !ok {
+				return nil, fmt.Errorf("members: the team has no default role %s", role)
+			}
+			roleIDs = append(roleIDs, roleID)
+		}
+		if len(roleIDs) == 0 {
+			return nil, fmt.Errorf("members: user %s must have at least one of roles and role_ids", userID)
+		}
+
+		members = append(members, &api.CreateTeamMemberReq{
+			UserID:  userID,
+			RoleIDs: roleIDs,
+		})
+	}
+
+	return members, nil
+}
+
+// flattenTeamMembers converts team members into the members set of the state. A role id is kept in role_ids
+// when it was configured there, otherwise default roles are set by key in roles.
+func flattenTeamMembers(members []*api.DataTeamMember, prior *schema.Set, defaultRoleIDs map[string]string) []any {
+	priorRoleIDs := map[string]map[string]bool{}
+	for _, item := range prior.List() {
+		m := item.(map[string]any)
+		roleIDs := map[string]bool{}
+		for _, roleID := range tf.ExpandStringSet(m["role_ids"].(*schema.Set)) {
+			roleIDs[roleID] = true
+		}
+		priorRoleIDs[m["user_id"].(string)] = roleIDs
+	}
+
+	defaultRoles := make(map[string]string, len(defaultRoleIDs))
+	for role, roleID := range defaultRoleIDs {
+		defaultRoles[roleID] = role
+	}
+
+	items := make([]any, len(members))
+	for i, member := range members {
+		roles := []any{}
+		roleIDs := []any{}
+		for _, roleID := range member.RoleIDs {
+			if role, ok := defaultRoles[roleID]; ok && !priorRoleIDs[member.UserID][roleID] {
+				roles = append(roles, role)
+			} else {
+				roleIDs = append(roleIDs, roleID)
+			}
+		}
+		items[i] = map[string]any{
+			"user_id":  member.UserID,
+			"roles":    roles,
+			"role_ids": roleIDs,
+		}
+	}
+
+	return items
+}
+
+// diffTeamMembers computes the members to add, the members whose roles changed and the members to remove.
+// The result is sorted by user id so that the requests are deterministic.
+func diffTeamMembers(old, new []*api.CreateTeamMemberReq) *teamMembersDelta {
+	oldRoles := make(map[string][]string, len(old))
+	for _, member := range old {
+		oldRoles[member.UserID] = member.RoleIDs
+	}
+
+	delta := &teamMembersDelta{}
+	newUsers := make(map[string]bool, len(new))
+	for _, member := range new {
+		newUsers[member.UserID] = true
+
+		roleIDs, ok := oldRoles[member.UserID]
+		switch {
+		case !ok:
+			delta.Add = append(delta.Add, member)
+		case !sameRoleIDs(roleIDs, member.RoleIDs):
+			delta.Update = append(delta.Update, member)
+		}
+	}
+	for _, member := range old {
+		if !newUsers[member.UserID] {
+			delta.Remove = append(delta.Remove, member.UserID)
+		}
+	}
+
+	byUserID := func(members []*api.CreateTeamMemberReq) func(i, j int) bool {
+		return func(i, j int) bool { return members[i].UserID < members[j].UserID }
+	}
+	sort.Slice(delta.Add, byUserID(delta.Add))
+	sort.Slice(delta.Update, byUserID(delta.Update))
+	sort.Strings(delta.Remove)
+
+	return delta
+}
+
+func sameRoleIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	roles := make(map[string]bool, len(a))
+	for _, roleID := range a {
+		roles[roleID] = true
+	}
+	for _, roleID := range b {
+		if !roles[roleID] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// batchTeamMembersDelta splits the delta into requests of at most size changes. Members are added and updated
+// before any member is removed, and the removal of selfID is sent on its own in the last request, so that
+// removing the creator of a team does not revoke the permissions needed to apply the remaining changes.
+func batchTeamMembersDelta(delta *teamMembersDelta, selfID string, size int) []*api.UpdateTeamMembersReq {
+	var batches []*api.UpdateTeamMembersReq
+	var batch *api.UpdateTeamMembersReq
+	count := 0
+	next := func() *api.UpdateTeamMembersReq {
+		if batch == nil || count == size {
+			batch = &api.UpdateTeamMembersReq{}
+			batches = append(batches, batch)
+			count = 0
+		}
+		count++
+		return batch
+	}
+
+	for _, member := range delta.Add {
+		b := next()
+		b.Add = append(b.Add, member)
+	}
+	for _, member := range delta.Update {
+		b := next()
+		b.Update = append(b.Update, member)
+	}
+
+	removeSelf := false
+	for _, userID := range delta.Remove {
+		if userID == selfID {
+			removeSelf = true
+			continue
+		}
+		b := next()
+		b.Remove = append(b.Remove, userID)
+	}
+	if removeSelf {
+		batches = append(batches, &api.UpdateTeamMembersReq{Remove: []string{selfID}})
+	}
+
+	return batches
+}
+
+// applyTeamMembersDelta sends the delta to the API in batches. If a batch fails, the batches sent before it
+// stay applied, the next refresh reads the actual membership and the next apply only sends what is left.
+func applyTeamMembersDelta(ctx context.Context, client *api.Client, teamID string, delta *teamMembersDelta) error {
+	if delta.empty() {
+		return nil
+	}
+
+	selfID := ""
+	if len(delta.Remove) > 0 {
+		user, err := client.GetCurrentUser(ctx)
+		if err != nil {
+			return err
+		}
+		selfID = user.ID
+	}
+
+	tflog.Info(ctx, "Updating team members", tf.M{
+		"team_id": teamID,
+		"add":     len(delta.Add),
+		"update":  len(delta.Update),
+		"remove":  len(delta.Remove),
+	})
+	for _, batch := range batchTeamMembersDelta(delta, selfID, teamMembersBatchSize) {
+		if _, err := client.UpdateTeamMembers(ctx, teamID, batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func teamMembersToReqs(members []*api.DataTeamMember) []*api.CreateTeamMemberReq {
+	reqs := make([]*api.CreateTeamMemberReq, len(members))
+	for i, member := range members {
+		reqs[i] = &api.CreateTeamMemberReq{UserID: member.UserID, RoleIDs: member.RoleIDs}
+	}
+
+	return reqs
+}
+
+// updateTeamMembers makes the members of the team match the members set. The delta is computed against the
+// actual members, which include the creator of a new team and the changes of an earlier apply that failed midway.
+func updateTeamMembers(ctx context.Context, client *api.Client, teamID string, set *schema.Set) error {
+	team, err := client.GetTeamMetaById(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	members, err := expandTeamMembers(set, team.DefaultRoleIDs())
+	if err != nil {
+		return err
+	}
+
+	return applyTeamMembersDelta(ctx, client, teamID, diffTeamMembers(teamMembersToReqs(team.Members), members))
+}
+
+// removeTeamMembers removes the members of the set from the team. The authenticated user stays a member,
+// so that the team is not left without anyone to manage it.
+func removeTeamMembers(ctx context.Context, client *api.Client, teamID string, set *schema.Set) error {
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	delta := &teamMembersDelta{}
+	for _, item := range set.List() {
+		userID := item.(map[string]any)["user_id"].(string)
+		if userID != user.ID {
+			delta.Remove = append(delta.Remove, userID)
+		}
+	}
+	sort.Strings(delta.Remove)
+
+	return applyTeamMembersDelta(ctx, client, teamID, delta)
+}
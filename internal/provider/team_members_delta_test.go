@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestDiffTeamMembers(t *testing.T) {
+	old := []*api.CreateTeamMemberReq{
+		{UserID: "a", RoleIDs: []string{"admin"}},
+		{UserID: "b", RoleIDs: []string{"user", "observer"}},
+		{UserID: "c", RoleIDs: []string{"user"}},
+	}
+	new := []*api.CreateTeamMemberReq{
+		{UserID: "d", RoleIDs: []string{"user"}},
+		{UserID: "b", RoleIDs: []string{"observer", "user"}},
+		{UserID: "c", RoleIDs: []string{"admin"}},
+	}
+
+	delta := diffTeamMembers(old, new)
+
+	if !reflect.DeepEqual(delta.Add, []*api.CreateTeamMemberReq{new[0]}) {
+		t.Errorf("expected d to be added, got %#v", delta.Add)
+	}
+	if !reflect.DeepEqual(delta.Update, []*api.CreateTeamMemberReq{new[2]}) {
+		t.Errorf("expected the roles of c to be updated, got %#v", delta.Update)
+	}
+	if !reflect.DeepEqual(delta.Remove, []string{"a"}) {
+		t.Errorf("expected a to be removed, got %#v", delta.Remove)
+	}
+
+	if delta := diffTeamMembers(old, old); !delta.empty() {
+		t.Errorf("expected no changes, got %#v", delta)
+	}
+}
+
+func TestBatchTeamMembersDelta(t *testing.T) {
+	delta := &teamMembersDelta{}
+	for i := 0; i < 250; i++ {
+		delta.Add = append(delta.Add, &api.CreateTeamMemberReq{UserID: fmt.Sprintf("add%d", i), RoleIDs: []string{"user"}})
+	}
+	delta.Update = []*api.CreateTeamMemberReq{{UserID: "update", RoleIDs: []string{"admin"}}}
+	delta.Remove = []string{"creator", "remove"}
+
+	batches := batchTeamMembersDelta(delta, "creator", 100)
+
+	if len(batches) != 4 {
+		t.Fatalf("expected 4 batches, got %d", len(batches))
+	}
+	for i, size := range []int{100, 100, 52} {
+		if n := len(batches[i].Add) + len(batches[i].Update) + len(batches[i].Remove); n != size {
+			t.Errorf("expected batch %d to have %d changes, got %d", i, size, n)
+		}
+	}
+	if len(batches[2].Update) != 1 || !reflect.DeepEqual(batches[2].Remove, []string{"remove"}) {
+		t.Errorf("expected the update and the removal in batch 2, got %#v", batches[2])
+	}
+	if !reflect.DeepEqual(batches[3], &api.UpdateTeamMembersReq{Remove: []string{"creator"}}) {
+		t.Errorf("expected the creator to be removed on its own last, got %#v", batches[3])
+	}
+
+	if batches := batchTeamMembersDelta(&teamMembersDelta{}, "creator", 100); len(batches) != 0 {
+		t.Errorf("expected no batches, got %d", len(batches))
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultResourceTimeout is the timeout of the operations of the resources that do not set their own, the same as the
+// default of the SDK.
+const defaultResourceTimeout = 20 * time.Minute
+
+// withTimeouts lets the timeout of every operation of a resource be configured in a `timeouts` block. The SDK runs
+// the operations with a context whose deadline is their timeout, and the context is passed down to the requests to
+// the API. Timeouts set by the resource, e.g. for a wait after its creation, are kept as defaults.
+func withTimeouts(r *schema.Resource) *schema.Resource {
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{}
+	}
+
+	timeout := func(current *time.Duration, defined bool) *time.Duration {
+		if current != nil || !defined {
+			return current
+		}
+		return schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	r.Timeouts.Create = timeout(r.Timeouts.Create, r.CreateContext != nil)
+	r.Timeouts.Read = timeout(r.Timeouts.Read, r.ReadContext != nil)
+	r.Timeouts.Update = timeout(r.Timeouts.Update, r.UpdateContext != nil)
+	r.Timeouts.Delete = timeout(r.Timeouts.Delete, r.DeleteContext != nil)
+
+	return r
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestReadTokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("  secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := readTokenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "secret" {
+		t.Errorf("expected token secret, got %q", token)
+	}
+
+	if err := os.WriteFile(path, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readTokenFile(path); err == nil {
+		t.Error("expected an error for an empty token file")
+	}
+
+	if _, err := readTokenFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing token file")
+	}
+}
+
+func TestRunTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the commands of this test need a POSIX shell")
+	}
+
+	token, err := runTokenCommand(context.Background(), "echo secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "secret" {
+		t.Errorf("expected token secret, got %q", token)
+	}
+
+	if _, err := runTokenCommand(context.Background(), "echo denied >&2; exit 1"); err == nil {
+		t.Error("expected an error for a failing command")
+	}
+
+	if _, err := runTokenCommand(context.Background(), "true"); err == nil {
+		t.Error("expected an error for an empty output")
+	}
+}
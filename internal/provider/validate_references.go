@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// reference is an id of another object referenced by a resource, verified during plan when validate_references is enabled.
+type reference struct {
+	// Attribute is the path of the id in the configuration, e.g. participant_groups.0.participants.0.id.
+	Attribute string
+	// Type is one of team, user, squad or service.
+	Type string
+	ID   string
+	// TeamID is the team of squads and services.
+	TeamID string
+}
+
+// validateReferences returns a CustomizeDiff function that verifies that the references of the resource exist,
+// when the provider is configured with validate_references. Ids that are not known yet during plan are skipped.
+func validateReferences(references func(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([]reference, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		client, ok := meta.(*api.Client)
+		if !ok || !client.ValidateReferences {
+			return nil
+		}
+
+		refs, err := references(ctx, client, d)
+		if err != nil {
+			return err
+		}
+
+		verified := map[string]bool{}
+		for _, ref := range refs {
+			if ref.ID == "" || !d.NewValueKnown(ref.Attribute) || verified[ref.Type+"/"+ref.ID] {
+				continue
+			}
+
+			tflog.Info(ctx, "Validating reference", tf.M{
+				"attribute": ref.Attribute,
+				"type":      ref.Type,
+				"id":        ref.ID,
+			})
+			if err := lookupReference(ctx, client, ref); err != nil {
+				return ref.error(err)
+			}
+			verified[ref.Type+"/"+ref.ID] = true
+		}
+
+		return nil
+	}
+}
+
+func (ref reference) error(err error) error {
+	return fmt.Errorf("%s: %s %s does not exist or can not be accessed: %w", ref.Attribute, ref.Type, ref.ID, err)
+}
+
+func lookupReference(ctx context.Context, client *api.Client, ref reference) error {
+	var err error
+	switch ref.Type {
+	case "team":
+		_, err = client.GetTeamById(ctx, ref.ID)
+	case "user":
+		_, err = client.GetUserById(ctx, ref.ID)
+	case "squad":
+		_, err = client.GetSquadById(ctx, ref.TeamID, ref.ID)
+	case "service":
+		_, err = client.GetServiceById(ctx, ref.TeamID, ref.ID)
+	default:
+		return fmt.Errorf("unsupported reference type %s", ref.Type)
+	}
+
+	return err
+}
+
+// ownerReferences returns the references of the participants, or owners, in the list at the given path, each
+// with a type and an id. Squads are skipped when their team is not known.
+func ownerReferences(path string, owners []any, teamID string) []reference {
+	var refs []reference
+	for i, o := range owners {
+		owner, ok := o.(map[string]any)
+		if !ok {
+			continue
+		}
+		ref := reference{
+			Attribute: fmt.Sprintf("%s.%d.id", path, i),
+			Type:      owner["type"].(string),
+			ID:        owner["id"].(string),
+			TeamID:    teamID,
+		}
+		switch {
+		case ref.Type != "user" && ref.Type != "squad" && ref.Type != "team":
+			continue
+		case ref.Type == "squad" && teamID == "":
+			continue
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs
+}
+
+// rotationReferences returns the references of the participants of the participant groups of a rotation, at the given path.
+func rotationReferences(path string, rotation map[string]any, teamID string) []reference {
+	var refs []reference
+	groups, _ := rotation["participant_groups"].([]any)
+	for i, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+		participants, _ := group["participants"].([]any)
+		refs = append(refs, ownerReferences(fmt.Sprintf("%s.%d.participants", joinAttributePath(path, "participant_groups"), i), participants, teamID)...)
+	}
+
+	return refs
+}
+
+// serviceMappingReferences returns the references of the services mapped by the extension mappings in the list at the
+// given path. Mappings without a service, the defaults of the team, are skipped.
+func serviceMappingReferences(path string, mappings []any, teamID string) []reference {
+	var refs []reference
+	for i, m := range mappings {
+		mapping, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		refs = append(refs, reference{Attribute: fmt.Sprintf("%s.%d.service_id", path, i), Type: "service", ID: mapping["service_id"].(string), TeamID: teamID})
+	}
+
+	return refs
+}
+
+func joinAttributePath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
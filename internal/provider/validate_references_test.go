@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRotationReferences(t *testing.T) {
+	rotation := map[string]any{
+		"participant_groups": []any{
+			map[string]any{
+				"participants": []any{
+					map[string]any{"type": "user", "id": "user1"},
+					map[string]any{"type": "squad", "id": "squad1"},
+				},
+			},
+			map[string]any{
+				"participants": []any{
+					map[string]any{"type": "team", "id": "team1"},
+				},
+			},
+		},
+	}
+
+	refs := rotationReferences("rotations.1", rotation, "team0")
+	expected := []reference{
+		{Attribute: "rotations.1.participant_groups.0.participants.0.id", Type: "user", ID: "user1", TeamID: "team0"},
+		{Attribute: "rotations.1.participant_groups.0.participants.1.id", Type: "squad", ID: "squad1", TeamID: "team0"},
+		{Attribute: "rotations.1.participant_groups.1.participants.0.id", Type: "team", ID: "team1", TeamID: "team0"},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected %#v, got %#v", expected, refs)
+	}
+
+	// Squads can not be looked up without their team.
+	refs = rotationReferences("", rotation, "")
+	if len(refs) != 2 || refs[0].Attribute != "participant_groups.0.participants.0.id" || refs[1].Type != "team" {
+		t.Errorf("expected the user and the team only, got %#v", refs)
+	}
+}
@@ -47,6 +47,18 @@ func ExpandStringSet(configured *schema.Set) []string {
 	return ExpandStringList(configured.List())
 }
 
+func ExpandStringMap(configured any) map[string]string {
+	m, _ := configured.(map[string]interface{})
+	vs := make(map[string]string, len(m))
+	for k, v := range m {
+		val, ok := v.(string)
+		if ok {
+			vs[k] = val
+		}
+	}
+	return vs
+}
+
 func ExtractData(d *schema.ResourceData, key string) (map[string]interface{}, error) {
 	data := d.Get(key)
 	dataList, ok := data.([]interface{})
@@ -0,0 +1,191 @@
+package tf
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DecodeError is returned when a value of the state does not match the type of the field it is decoded into.
+type DecodeError struct {
+	// Path of the value in the state, e.g. services.0.service_id.
+	Path    string
+	Message string
+}
+
+func (err *DecodeError) Error() string {
+	if err.Path == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s: %s", err.Path, err.Message)
+}
+
+// Decode decodes a value of the state, as returned by schema.ResourceData.Get, into output, using the tf struct
+// tags as attribute names. Unlike a weakly typed decoding, a value of the wrong type, e.g. a string decoded into
+// an int, is an error instead of being converted or dropped. Attributes without a matching field are ignored.
+func Decode(input any, output any) error {
+	return DecodeAt("", input, output)
+}
+
+// DecodeAt is like Decode, the paths reported in the errors are relative to the given attribute path.
+func DecodeAt(path string, input any, output any) error {
+	v := reflect.ValueOf(output)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("output must be a non-nil pointer, got %T", output)
+	}
+
+	return decodeValue(path, input, v.Elem())
+}
+
+func decodeValue(path string, input any, out reflect.Value) error {
+	if set, ok := input.(*schema.Set); ok {
+		input = set.List()
+	}
+
+	if input == nil {
+		out.Set(reflect.Zero(out.Type()))
+		return nil
+	}
+
+	in := reflect.ValueOf(input)
+
+	switch out.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(out.Type().Elem())
+		if err := decodeValue(path, input, elem.Elem()); err != nil {
+			return err
+		}
+		out.Set(elem)
+
+	case reflect.Interface:
+		if !in.Type().AssignableTo(out.Type()) {
+			return decodeTypeError(path, out.Type().String(), input)
+		}
+		out.Set(in)
+
+	case reflect.Struct:
+		m, ok := input.(map[string]any)
+		if !ok {
+			return decodeTypeError(path, "object", input)
+		}
+
+		out.Set(reflect.Zero(out.Type()))
+		for i := 0; i < out.NumField(); i++ {
+			field := out.Type().Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get(EncoderStructTag), ",")
+			if name == "" || name == "-" || !field.IsExported() {
+				continue
+			}
+
+			value, ok := m[name]
+			if !ok {
+				continue
+			}
+			if err := decodeValue(joinPath(path, name), value, out.Field(i)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice:
+		if in.Kind() != reflect.Slice {
+			return decodeTypeError(path, "list", input)
+		}
+
+		slice := reflect.MakeSlice(out.Type(), in.Len(), in.Len())
+		for i := 0; i < in.Len(); i++ {
+			if err := decodeValue(joinPath(path, strconv.Itoa(i)), in.Index(i).Interface(), slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		out.Set(slice)
+
+	case reflect.Map:
+		if in.Kind() != reflect.Map || in.Type().Key().Kind() != reflect.String || out.Type().Key().Kind() != reflect.String {
+			return decodeTypeError(path, "map", input)
+		}
+
+		m := reflect.MakeMapWithSize(out.Type(), in.Len())
+		iter := in.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			elem := reflect.New(out.Type().Elem()).Elem()
+			if err := decodeValue(joinPath(path, key), iter.Value().Interface(), elem); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(out.Type().Key()), elem)
+		}
+		out.Set(m)
+
+	case reflect.String:
+		if in.Kind() != reflect.String {
+			return decodeTypeError(path, "string", input)
+		}
+		out.SetString(in.String())
+
+	case reflect.Bool:
+		if in.Kind() != reflect.Bool {
+			return decodeTypeError(path, "bool", input)
+		}
+		out.SetBool(in.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch in.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = in.Int()
+		default:
+			return decodeTypeError(path, "int", input)
+		}
+		if out.OverflowInt(n) {
+			return &DecodeError{Path: path, Message: fmt.Sprintf("%d overflows %s", n, out.Type())}
+		}
+		out.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		switch in.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if in.Int() < 0 {
+				return &DecodeError{Path: path, Message: fmt.Sprintf("expected a non-negative number, got %d", in.Int())}
+			}
+			n = uint64(in.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n = in.Uint()
+		default:
+			return decodeTypeError(path, "uint", input)
+		}
+		if out.OverflowUint(n) {
+			return &DecodeError{Path: path, Message: fmt.Sprintf("%d overflows %s", n, out.Type())}
+		}
+		out.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		switch in.Kind() {
+		case reflect.Float32, reflect.Float64:
+			out.SetFloat(in.Float())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			out.SetFloat(float64(in.Int()))
+		default:
+			return decodeTypeError(path, "float", input)
+		}
+
+	default:
+		return &DecodeError{Path: path, Message: fmt.Sprintf("unsupported field type %s", out.Type())}
+	}
+
+	return nil
+}
+
+func decodeTypeError(path string, expected string, input any) error {
+	return &DecodeError{Path: path, Message: fmt.Sprintf("expected %s, got %T", expected, input)}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,119 @@
+package tf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type decodeTestService struct {
+	ID      string            `tf:"id"`
+	Weight  int               `tf:"weight"`
+	Ratio   float64           `tf:"ratio"`
+	Enabled *bool             `tf:"enabled"`
+	Tags    map[string]string `tf:"tags"`
+	Options []string          `tf:"options"`
+	Ignored string            `tf:"-"`
+}
+
+type decodeTestWebform struct {
+	PageID   uint                 `tf:"page_id"`
+	Services []*decodeTestService `tf:"services"`
+}
+
+func TestDecode(t *testing.T) {
+	input := map[string]any{
+		"page_id": 42,
+		"services": []any{
+			map[string]any{
+				"id":      "a",
+				"weight":  2,
+				"ratio":   1,
+				"enabled": true,
+				"tags":    map[string]any{"env": "prod"},
+				"options": schema.NewSet(schema.HashString, []any{"x"}),
+				"unknown": "ignored",
+			},
+		},
+	}
+
+	var webform decodeTestWebform
+	if err := Decode(input, &webform); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	enabled := true
+	expected := decodeTestWebform{
+		PageID: 42,
+		Services: []*decodeTestService{
+			{ID: "a", Weight: 2, Ratio: 1, Enabled: &enabled, Tags: map[string]string{"env": "prod"}, Options: []string{"x"}},
+		},
+	}
+	if !reflect.DeepEqual(webform, expected) {
+		t.Errorf("expected %#v, got %#v", expected, webform)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    map[string]any
+		expected string
+	}{
+		{
+			name:     "string into int",
+			input:    map[string]any{"services": []any{map[string]any{"weight": "2"}}},
+			expected: "services.0.weight: expected int, got string",
+		},
+		{
+			name:     "int into string",
+			input:    map[string]any{"services": []any{map[string]any{"id": 1}}},
+			expected: "services.0.id: expected string, got int",
+		},
+		{
+			name:     "negative uint",
+			input:    map[string]any{"page_id": -1},
+			expected: "page_id: expected a non-negative number, got -1",
+		},
+		{
+			name:     "object expected",
+			input:    map[string]any{"services": []any{"a"}},
+			expected: "services.0: expected object, got string",
+		},
+		{
+			name:     "map value",
+			input:    map[string]any{"services": []any{map[string]any{"tags": map[string]any{"env": true}}}},
+			expected: "services.0.tags.env: expected string, got bool",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var webform decodeTestWebform
+			err := Decode(c.input, &webform)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Error() != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestDecodeAt(t *testing.T) {
+	var services []decodeTestService
+	err := DecodeAt("rules.1.services", []any{map[string]any{"weight": 1.5}}, &services)
+	if err == nil || err.Error() != "rules.1.services.0.weight: expected int, got float64" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := DecodeAt("services", nil, &services); err != nil || services != nil {
+		t.Errorf("expected nil to decode into a nil slice, got %#v, %v", services, err)
+	}
+
+	if err := Decode(map[string]any{}, services); err == nil {
+		t.Error("expected an error for a non-pointer output")
+	}
+}
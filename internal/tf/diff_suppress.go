@@ -0,0 +1,84 @@
+package tf
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SuppressCaseDifferences suppresses the diff between values differing only by case, e.g. a weekday returned
+// capitalized by the API.
+func SuppressCaseDifferences(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// timezoneAliases maps the deprecated names of the IANA timezone database, which the API may return, to the
+// current ones.
+var timezoneAliases = map[string]string{
+	"Asia/Calcutta":                    "Asia/Kolkata",
+	"Asia/Saigon":                      "Asia/Ho_Chi_Minh",
+	"Asia/Katmandu":                    "Asia/Kathmandu",
+	"Asia/Rangoon":                     "Asia/Yangon",
+	"Asia/Dacca":                       "Asia/Dhaka",
+	"Asia/Ulan_Bator":                  "Asia/Ulaanbaatar",
+	"Asia/Istanbul":                    "Europe/Istanbul",
+	"Europe/Kiev":                      "Europe/Kyiv",
+	"America/Buenos_Aires":             "America/Argentina/Buenos_Aires",
+	"America/Indianapolis":             "America/Indiana/Indianapolis",
+	"America/Godthab":                  "America/Nuuk",
+	"Atlantic/Faeroe":                  "Atlantic/Faroe",
+	"Pacific/Ponape":                   "Pacific/Pohnpei",
+	"Pacific/Truk":                     "Pacific/Chuuk",
+	"Australia/ACT":                    "Australia/Sydney",
+	"Australia/NSW":                    "Australia/Sydney",
+	"Australia/Canberra":               "Australia/Sydney",
+	"Australia/Victoria":               "Australia/Melbourne",
+	"Australia/Queensland":             "Australia/Brisbane",
+	"Australia/West":                   "Australia/Perth",
+	"Australia/South":                  "Australia/Adelaide",
+	"Australia/North":                  "Australia/Darwin",
+	"Australia/Tasmania":               "Australia/Hobart",
+	"Brazil/East":                      "America/Sao_Paulo",
+	"Canada/Eastern":                   "America/Toronto",
+	"Canada/Central":                   "America/Winnipeg",
+	"Canada/Mountain":                  "America/Edmonton",
+	"Canada/Pacific":                   "America/Vancouver",
+	"US/Eastern":                       "America/New_York",
+	"US/Central":                       "America/Chicago",
+	"US/Mountain":                      "America/Denver",
+	"US/Arizona":                       "America/Phoenix",
+	"US/Pacific":                       "America/Los_Angeles",
+	"US/Alaska":                        "America/Anchorage",
+	"US/Hawaii":                        "Pacific/Honolulu",
+	"GB":                               "Europe/London",
+	"Japan":                            "Asia/Tokyo",
+	"Singapore":                        "Asia/Singapore",
+	"Hongkong":                         "Asia/Hong_Kong",
+	"PRC":                              "Asia/Shanghai",
+	"ROK":                              "Asia/Seoul",
+	"Israel":                           "Asia/Jerusalem",
+	"NZ":                               "Pacific/Auckland",
+	"America/Argentina/ComodRivadavia": "America/Argentina/Catamarca",
+	"Etc/UTC":                          "UTC",
+	"Etc/UCT":                          "UTC",
+	"Etc/Universal":                    "UTC",
+	"Etc/Zulu":                         "UTC",
+	"UCT":                              "UTC",
+	"Universal":                        "UTC",
+	"Zulu":                             "UTC",
+}
+
+// CanonicalTimezone returns the current name of a timezone, e.g. Asia/Kolkata for Asia/Calcutta.
+func CanonicalTimezone(name string) string {
+	if canonical, ok := timezoneAliases[name]; ok {
+		return canonical
+	}
+
+	return name
+}
+
+// SuppressEquivalentTimezones suppresses the diff between two names of the same timezone, e.g. Asia/Kolkata and
+// Asia/Calcutta.
+func SuppressEquivalentTimezones(k, old, new string, d *schema.ResourceData) bool {
+	return CanonicalTimezone(old) == CanonicalTimezone(new)
+}
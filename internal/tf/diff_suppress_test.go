@@ -0,0 +1,21 @@
+package tf
+
+import "testing"
+
+func TestSuppressEquivalentTimezones(t *testing.T) {
+	for _, c := range []struct {
+		old, new   string
+		suppressed bool
+	}{
+		{"Asia/Calcutta", "Asia/Kolkata", true},
+		{"Asia/Kolkata", "Asia/Calcutta", true},
+		{"Etc/UTC", "UTC", true},
+		{"US/Pacific", "America/Los_Angeles", true},
+		{"Europe/Paris", "Europe/Berlin", false},
+		{"Asia/Kolkata", "", false},
+	} {
+		if got := SuppressEquivalentTimezones("timezone", c.old, c.new, nil); got != c.suppressed {
+			t.Errorf("%s -> %s: expected suppressed to be %v", c.old, c.new, c.suppressed)
+		}
+	}
+}
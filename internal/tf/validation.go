@@ -1,5 +1,23 @@
 package tf
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var ValidateObjectID = validation.StringLenBetween(24, 24)
+
+// ValidateTimezone validates the name of a timezone of the IANA timezone database, e.g. Asia/Kolkata.
+func ValidateTimezone(v any, k string) (warns []string, errs []error) {
+	name, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		return nil, []error{fmt.Errorf("expected %s to be a timezone of the IANA timezone database, e.g. Asia/Kolkata, got %q", k, name)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,16 @@
+package tf
+
+import "testing"
+
+func TestValidateTimezone(t *testing.T) {
+	for _, name := range []string{"Asia/Kolkata", "Asia/Calcutta", "UTC"} {
+		if _, errs := ValidateTimezone(name, "timezone"); len(errs) > 0 {
+			t.Errorf("%s: unexpected errors %v", name, errs)
+		}
+	}
+	for _, name := range []string{"", "Local", "Asia/Bangalore", "+05:30"} {
+		if _, errs := ValidateTimezone(name, "timezone"); len(errs) == 0 {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/squadcast/terraform-provider-squadcast/internal/provider"
 )
@@ -34,7 +35,14 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.New(version)}
+	server, err := provider.NewProviderServer(context.Background(), version, provider.New(version)())
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	opts := &plugin.ServeOpts{
+		GRPCProviderV6Func: func() tfprotov6.ProviderServer { return server },
+	}
 
 	if debugMode {
 		// TODO: update this string with the full name of your provider as used in your configs
@@ -0,0 +1,142 @@
+package providertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+type mockResponse struct {
+	status int
+	data   any
+}
+
+// MockServer is a minimal Squadcast API serving canned responses.
+// It authenticates every request and serves an organization by default.
+type MockServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	routes  map[string]mockResponse
+	graphql map[string]any
+}
+
+// NewMockServer starts a MockServer, which is closed at the end of the test.
+func NewMockServer(t testing.TB) *MockServer {
+	t.Helper()
+
+	m := &MockServer{
+		routes:  make(map[string]mockResponse),
+		graphql: make(map[string]any),
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Close)
+
+	m.Handle(http.MethodGet, "/v3/oauth/access-token", http.StatusOK, map[string]any{
+		"type":         "Bearer",
+		"access_token": "test",
+	})
+	m.Handle(http.MethodGet, "/v3/organization", http.StatusOK, map[string]any{
+		"id":   "000000000000000000000000",
+		"name": "test",
+	})
+
+	return m
+}
+
+// Options returns the client options pointing the test provider to the server.
+func (m *MockServer) Options() []ClientOption {
+	return []ClientOption{
+		WithEndpoint(m.URL),
+		WithServiceAccountToken("test"),
+	}
+}
+
+// Handle serves data with the given status to the requests matching method and path, e.g. "/v3/teams/by-name?name=sre".
+// The path matches the requests with the same query, or any query if it has none.
+// Data is wrapped like the responses of the API, for error statuses a string data is the error message.
+func (m *MockServer) Handle(method, path string, status int, data any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.routes[method+" "+path] = mockResponse{status: status, data: data}
+}
+
+// HandleGraphQL serves data to the graphql operations selecting the given field, e.g. "schedule".
+func (m *MockServer) HandleGraphQL(field string, data any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.graphql[field] = data
+}
+
+func (m *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/v3/graphql" {
+		m.serveGraphQL(w, r)
+		return
+	}
+
+	response, ok := m.routes[r.Method+" "+r.URL.RequestURI()]
+	if !ok {
+		response, ok = m.routes[r.Method+" "+r.URL.Path]
+	}
+	if !ok {
+		response = mockResponse{status: http.StatusNotFound, data: fmt.Sprintf("no mock response for %s %s", r.Method, r.URL.RequestURI())}
+	}
+
+	writeMockResponse(w, response)
+}
+
+func (m *MockServer) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Query string `json:"query"`
+	}
+	body, _ := io.ReadAll(r.Body)
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeMockResponse(w, mockResponse{status: http.StatusBadRequest, data: err.Error()})
+		return
+	}
+
+	for field, data := range m.graphql {
+		if regexp.MustCompile(`\{\s*` + regexp.QuoteMeta(field) + `\b`).MatchString(request.Query) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{field: data},
+			})
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{"message": "[404] no mock response for the graphql operation"}},
+	})
+}
+
+func writeMockResponse(w http.ResponseWriter, response mockResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.status)
+
+	if response.status > 299 {
+		message, _ := response.data.(string)
+		json.NewEncoder(w).Encode(map[string]any{
+			"meta": map[string]any{
+				"status":        response.status,
+				"error_message": message,
+			},
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": response.data,
+	})
+}
@@ -0,0 +1,112 @@
+// Package providertest helps module authors to test their configurations against a mock Squadcast API,
+// with `terraform test` or with the acceptance test framework of the plugin SDK, without a Squadcast organization.
+package providertest
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/provider"
+)
+
+// ProviderAddress is the address of the provider, as used in the required_providers of the configurations.
+const ProviderAddress = "registry.terraform.io/SquadcastHub/squadcast"
+
+// ClientOption customizes the API client of the test provider.
+type ClientOption = api.ClientOption
+
+var (
+	// WithEndpoint points the API client to the given URL, e.g. the URL of a MockServer.
+	WithEndpoint = api.WithEndpoint
+	// WithServiceAccountToken authenticates the API client with the given token.
+	WithServiceAccountToken = api.WithServiceAccountToken
+	// WithSkipAnalyticsRefresh sets whether the computed analytics fields are read.
+	WithSkipAnalyticsRefresh = api.WithSkipAnalyticsRefresh
+	// WithValidateReferences sets whether the referenced ids are verified during plan.
+	WithValidateReferences = api.WithValidateReferences
+	// WithAdoptExistingOnConflict sets whether the existing objects are adopted when a create conflicts with them.
+	WithAdoptExistingOnConflict = api.WithAdoptExistingOnConflict
+)
+
+// NewTestProvider returns a provider whose API client is built from the given options, the provider configuration is ignored.
+func NewTestProvider(opts ...ClientOption) *schema.Provider {
+	return provider.NewTestProvider(opts...)
+}
+
+// ProtoV6ProviderFactories returns the provider factories of the test provider, for the ProtoV6ProviderFactories
+// of a resource.TestCase.
+func ProtoV6ProviderFactories(opts ...ClientOption) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"squadcast": func() (tfprotov6.ProviderServer, error) {
+			return provider.NewTestProviderServer(opts...)
+		},
+	}
+}
+
+// ProviderFactories returns the provider factories of the test provider, for the ProviderFactories of a resource.TestCase.
+//
+// Deprecated: the resources migrated to terraform-plugin-framework are not served by the SDKv2 provider, use
+// ProtoV6ProviderFactories instead.
+func ProviderFactories(opts ...ClientOption) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"squadcast": func() (*schema.Provider, error) {
+			return NewTestProvider(opts...), nil
+		},
+	}
+}
+
+// Reattach serves the test provider in-process for the duration of the test and sets TF_REATTACH_PROVIDERS,
+// so that the terraform commands run by the test, e.g. `terraform test`, use it instead of the released provider.
+func Reattach(t testing.TB, opts ...ClientOption) {
+	t.Helper()
+
+	server, err := provider.NewTestProviderServer(opts...)
+	if err != nil {
+		t.Fatalf("failed to create the test provider: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config, closeCh, err := plugin.DebugServe(ctx, &plugin.ServeOpts{
+		GRPCProviderV6Func: func() tfprotov6.ProviderServer { return server },
+	})
+	if err != nil {
+		cancel()
+		t.Fatalf("failed to serve the test provider: %s", err)
+	}
+	t.Cleanup(func() {
+		cancel()
+		<-closeCh
+	})
+
+	reattach, err := json.Marshal(map[string]plugin.ReattachConfig{
+		ProviderAddress: config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TF_REATTACH_PROVIDERS", string(reattach))
+}
+
+// LoadFixture decodes the JSON file at path, e.g. to register it as the response of a MockServer.
+func LoadFixture(t testing.TB, path string) any {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %s", path, err)
+	}
+
+	var fixture any
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		t.Fatalf("failed to decode fixture %s: %s", path, err)
+	}
+
+	return fixture
+}
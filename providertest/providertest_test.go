@@ -0,0 +1,58 @@
+package providertest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestNewTestProvider(t *testing.T) {
+	server := NewMockServer(t)
+	server.Handle(http.MethodGet, "/v3/teams/by-name?name=sre", http.StatusOK, map[string]any{
+		"id":          "613611c1eb22db455cfa789f",
+		"name":        "sre",
+		"description": "Site reliability engineering",
+	})
+	server.HandleGraphQL("schedule", map[string]any{
+		"ID":       100,
+		"name":     "primary",
+		"timeZone": "UTC",
+		"teamID":   "613611c1eb22db455cfa789f",
+		"owner": map[string]any{
+			"type": "team",
+			"ID":   "613611c1eb22db455cfa789f",
+		},
+	})
+
+	p := NewTestProvider(server.Options()...)
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatalf("failed to configure the test provider: %v", diags)
+	}
+
+	team := p.DataSourcesMap["squadcast_team"]
+	d := schema.TestResourceDataRaw(t, team.Schema, map[string]any{"name": "sre"})
+	if diags := team.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("failed to read the team: %v", diags)
+	}
+	if d.Id() != "613611c1eb22db455cfa789f" || d.Get("description") != "Site reliability engineering" {
+		t.Errorf("unexpected team %s: %v", d.Id(), d.Get("description"))
+	}
+
+	schedule := p.ResourcesMap["squadcast_schedule_v2"]
+	d = schema.TestResourceDataRaw(t, schedule.Schema, map[string]any{})
+	d.SetId("100")
+	if diags := schedule.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("failed to read the schedule: %v", diags)
+	}
+	if d.Get("name") != "primary" {
+		t.Errorf("expected the schedule primary, got %v", d.Get("name"))
+	}
+
+	d = schema.TestResourceDataRaw(t, team.Schema, map[string]any{"name": "unknown"})
+	if diags := team.ReadContext(context.Background(), d, p.Meta()); !diags.HasError() {
+		t.Error("expected an error for a team without mock response")
+	}
+}